@@ -29,9 +29,6 @@ import (
 	globalfeekeeper "github.com/CosmosContracts/juno/v26/x/globalfee/keeper"
 )
 
-// Lower back to 1 mil after https://github.com/cosmos/relayer/issues/1255
-const maxBypassMinFeeMsgGasUsage = 2_000_000
-
 // HandlerOptions extends the SDK's AnteHandler options by requiring the IBC
 // channel keeper and a BankKeeper with an added method for fee sharing.
 type HandlerOptions struct {
@@ -46,8 +43,6 @@ type HandlerOptions struct {
 	WasmConfig        wasmtypes.WasmConfig
 	Cdc               codec.BinaryCodec
 
-	BypassMinFeeMsgTypes []string
-
 	GlobalFeeKeeper globalfeekeeper.Keeper
 	StakingKeeper   stakingkeeper.Keeper
 
@@ -87,7 +82,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	// transaction. The FeePay decorator is called first for FeePay transactions, and the GlobalFee decorator is called
 	// first for all other transactions. See the FeeRouteDecorator for more details.
 	fpd := feepayante.NewDeductFeeDecorator(options.FeePayKeeper, options.GlobalFeeKeeper, options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.BondDenom, &isFeePayTx)
-	gfd := globalfeeante.NewFeeDecorator(options.BypassMinFeeMsgTypes, options.GlobalFeeKeeper, options.StakingKeeper, maxBypassMinFeeMsgGasUsage, &isFeePayTx)
+	gfd := globalfeeante.NewFeeDecorator(options.GlobalFeeKeeper, options.StakingKeeper, &isFeePayTx)
 
 	anteDecorators := []sdk.AnteDecorator{
 		// GlobalFee query params for minimum fee
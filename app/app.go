@@ -25,10 +25,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	wasmlckeeper "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/keeper"
-	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	ibcclientclient "github.com/cosmos/ibc-go/v7/modules/core/02-client/client"
-	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
-	ibcchanneltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
@@ -53,7 +50,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
-	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
@@ -91,6 +87,7 @@ import (
 	v25 "github.com/CosmosContracts/juno/v26/app/upgrades/v25"
 	v26 "github.com/CosmosContracts/juno/v26/app/upgrades/v26"
 	"github.com/CosmosContracts/juno/v26/docs"
+	feeshareante "github.com/CosmosContracts/juno/v26/x/feeshare/ante"
 )
 
 const (
@@ -383,9 +380,8 @@ func New(
 			WasmConfig:        wasmConfig,
 			Cdc:               appCodec,
 
-			BypassMinFeeMsgTypes: GetDefaultBypassFeeMessages(),
-			GlobalFeeKeeper:      app.AppKeepers.GlobalFeeKeeper,
-			StakingKeeper:        *app.AppKeepers.StakingKeeper,
+			GlobalFeeKeeper: app.AppKeepers.GlobalFeeKeeper,
+			StakingKeeper:   *app.AppKeepers.StakingKeeper,
 
 			TxEncoder:     app.txConfig.TxEncoder(),
 			BuilderKeeper: app.AppKeepers.BuildKeeper,
@@ -505,31 +501,10 @@ func (app *App) SetCheckTx(handler pobabci.CheckTx) {
 	app.checkTxHandler = handler
 }
 
-func GetDefaultBypassFeeMessages() []string {
-	return []string{
-		// IBC
-		sdk.MsgTypeURL(&ibcchanneltypes.MsgRecvPacket{}),
-		sdk.MsgTypeURL(&ibcchanneltypes.MsgAcknowledgement{}),
-		sdk.MsgTypeURL(&ibcclienttypes.MsgCreateClient{}),
-		sdk.MsgTypeURL(&ibcclienttypes.MsgUpdateClient{}),
-		sdk.MsgTypeURL(&ibcclienttypes.MsgSubmitMisbehaviour{}),
-		sdk.MsgTypeURL(&ibcclienttypes.MsgUpgradeClient{}),
-		sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}),
-		sdk.MsgTypeURL(&ibcchanneltypes.MsgTimeout{}),
-		sdk.MsgTypeURL(&ibcchanneltypes.MsgTimeoutOnClose{}),
-		sdk.MsgTypeURL(&ibcchanneltypes.MsgChannelOpenTry{}),
-		sdk.MsgTypeURL(&ibcchanneltypes.MsgChannelOpenConfirm{}),
-		sdk.MsgTypeURL(&ibcchanneltypes.MsgChannelOpenAck{}),
-	}
-}
-
 func (app *App) setPostHandler() {
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
+	postHandler := sdk.ChainPostDecorators(
+		feeshareante.NewFeeSharePostDecorator(app.AppKeepers.BankKeeper, app.AppKeepers.FeeShareKeeper),
 	)
-	if err != nil {
-		panic(err)
-	}
 
 	app.SetPostHandler(postHandler)
 }
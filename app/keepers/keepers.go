@@ -90,10 +90,13 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	junoburn "github.com/CosmosContracts/juno/v26/x/burn"
+	burnkeeper "github.com/CosmosContracts/juno/v26/x/burn/keeper"
+	burntypes "github.com/CosmosContracts/juno/v26/x/burn/types"
 	clockkeeper "github.com/CosmosContracts/juno/v26/x/clock/keeper"
 	clocktypes "github.com/CosmosContracts/juno/v26/x/clock/types"
 	cwhookskeeper "github.com/CosmosContracts/juno/v26/x/cw-hooks/keeper"
 	cwhookstypes "github.com/CosmosContracts/juno/v26/x/cw-hooks/types"
+	dripbindings "github.com/CosmosContracts/juno/v26/x/drip/bindings"
 	dripkeeper "github.com/CosmosContracts/juno/v26/x/drip/keeper"
 	driptypes "github.com/CosmosContracts/juno/v26/x/drip/types"
 	feepaykeeper "github.com/CosmosContracts/juno/v26/x/feepay/keeper"
@@ -135,9 +138,11 @@ var maccPerms = map[string][]string{
 	ibcfeetypes.ModuleName:         nil,
 	wasmtypes.ModuleName:           {},
 	tokenfactorytypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
+	driptypes.ModuleName:           nil,
 	globalfee.ModuleName:           nil,
 	buildertypes.ModuleName:        nil,
 	feepaytypes.ModuleName:         nil,
+	feesharetypes.ModuleName:       nil,
 	junoburn.ModuleName:            {authtypes.Burner},
 }
 
@@ -196,6 +201,7 @@ type AppKeepers struct {
 	TokenFactoryKeeper tokenfactorykeeper.Keeper
 
 	DripKeeper dripkeeper.Keeper
+	BurnKeeper burnkeeper.Keeper
 
 	// Middleware wrapper
 	Ics20WasmHooks   *ibc_hooks.WasmHooks
@@ -272,22 +278,36 @@ func NewAppKeepers(
 		appKeepers.BankKeeper,
 		govModAddress,
 	)
+	appKeepers.DistrKeeper = distrkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[distrtypes.StoreKey],
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		stakingKeeper,
+		authtypes.FeeCollectorName,
+		govModAddress,
+	)
 	appKeepers.MintKeeper = mintkeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[minttypes.StoreKey],
 		stakingKeeper,
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
+		// the burn keeper records the burned share of the provisions, so it is
+		// created afterwards and referenced here
+		&appKeepers.BurnKeeper,
 		authtypes.FeeCollectorName,
 		govModAddress,
 	)
-	appKeepers.DistrKeeper = distrkeeper.NewKeeper(
+	appKeepers.BurnKeeper = burnkeeper.NewKeeper(
 		appCodec,
-		appKeepers.keys[distrtypes.StoreKey],
-		appKeepers.AccountKeeper,
+		appKeepers.keys[burntypes.StoreKey],
 		appKeepers.BankKeeper,
-		stakingKeeper,
-		authtypes.FeeCollectorName,
+		appKeepers.MintKeeper,
+		// the tokenfactory keeper records its burns in x/burn, so it is created
+		// afterwards and referenced here
+		&appKeepers.TokenFactoryKeeper,
 		govModAddress,
 	)
 	appKeepers.SlashingKeeper = slashingkeeper.NewKeeper(
@@ -473,6 +493,7 @@ func NewAppKeepers(
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.DistrKeeper,
+		appKeepers.BurnKeeper,
 		tokenFactoryCapabilities,
 		govModAddress,
 	)
@@ -495,10 +516,23 @@ func NewAppKeepers(
 		panic("error while reading wasm config: " + err.Error())
 	}
 
+	appKeepers.DripKeeper = dripkeeper.NewKeeper(
+		appKeepers.keys[driptypes.StoreKey],
+		appCodec,
+		appKeepers.BankKeeper,
+		stakingKeeper,
+		appKeepers.DistrKeeper,
+		authtypes.FeeCollectorName,
+		govModAddress,
+	)
+
 	// Move custom query of token factory to stargate, still use custom msg which is tfOpts[1]
 	tfOpts := bindings.RegisterCustomPlugins(appKeepers.BankKeeper, &appKeepers.TokenFactoryKeeper)
 	wasmOpts = append(wasmOpts, tfOpts...)
 
+	dripOpts := dripbindings.RegisterCustomPlugins(&appKeepers.DripKeeper)
+	wasmOpts = append(wasmOpts, dripOpts...)
+
 	// Stargate Queries
 	acceptedStargateQueries := wasmkeeper.AcceptedStargateQueries{
 		// ibc
@@ -532,7 +566,7 @@ func NewAppKeepers(
 		})
 	wasmOpts = append(wasmOpts, querierOpts)
 
-	junoBurnerPlugin := junoburn.NewBurnerPlugin(appKeepers.BankKeeper, appKeepers.MintKeeper)
+	junoBurnerPlugin := junoburn.NewBurnerPlugin(appKeepers.BankKeeper, appKeepers.BurnKeeper)
 
 	// ref: https://github.com/CosmWasm/wasmd/issues/1735
 	burnMessageHandler := wasmkeeper.WithMessageHandlerDecorator(func(nested wasmkeeper.Messenger) wasmkeeper.Messenger {
@@ -544,6 +578,9 @@ func NewAppKeepers(
 
 	wasmOpts = append(wasmOpts, burnMessageHandler)
 
+	// record the contracts executed in each tx, and their gas, for fee share payouts
+	wasmOpts = append(wasmOpts, feesharekeeper.WithContractExecutionTracking(&appKeepers.FeeShareKeeper))
+
 	mainWasmer, err := wasmvm.NewVM(path.Join(dataDir, "wasm"), wasmCapabilities, 32, wasmConfig.ContractDebugMode, wasmConfig.MemoryCacheSize)
 	if err != nil {
 		panic(fmt.Sprintf("failed to create juno wasm vm: %s", err))
@@ -616,6 +653,7 @@ func NewAppKeepers(
 
 	appKeepers.FeeShareKeeper = feesharekeeper.NewKeeper(
 		appKeepers.keys[feesharetypes.StoreKey],
+		appKeepers.tkeys[feesharetypes.TStoreKey],
 		appCodec,
 		appKeepers.BankKeeper,
 		appKeepers.WasmKeeper,
@@ -627,14 +665,10 @@ func NewAppKeepers(
 	appKeepers.GlobalFeeKeeper = globalfeekeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[globalfeetypes.StoreKey],
-		govModAddress,
-	)
-
-	appKeepers.DripKeeper = dripkeeper.NewKeeper(
-		appKeepers.keys[driptypes.StoreKey],
-		appCodec,
-		appKeepers.BankKeeper,
-		authtypes.FeeCollectorName,
+		appKeepers.WasmKeeper,
+		appKeepers.FeePayKeeper,
+		appKeepers.FeeShareKeeper,
+		bondDenom,
 		govModAddress,
 	)
 
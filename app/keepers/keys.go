@@ -32,6 +32,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	burntypes "github.com/CosmosContracts/juno/v26/x/burn/types"
 	clocktypes "github.com/CosmosContracts/juno/v26/x/clock/types"
 	cwhookstypes "github.com/CosmosContracts/juno/v26/x/cw-hooks/types"
 	driptypes "github.com/CosmosContracts/juno/v26/x/drip/types"
@@ -63,10 +64,11 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 		buildertypes.StoreKey,
 		driptypes.StoreKey,
 		clocktypes.StoreKey,
+		burntypes.StoreKey,
 		cwhookstypes.StoreKey,
 	)
 
-	appKeepers.tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	appKeepers.tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey, feesharetypes.TStoreKey)
 	appKeepers.memKeys = sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 }
 
@@ -62,6 +62,7 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	encparams "github.com/CosmosContracts/juno/v26/app/params"
+	junoburn "github.com/CosmosContracts/juno/v26/x/burn"
 	"github.com/CosmosContracts/juno/v26/x/clock"
 	clocktypes "github.com/CosmosContracts/juno/v26/x/clock/types"
 	cwhooks "github.com/CosmosContracts/juno/v26/x/cw-hooks"
@@ -111,6 +112,7 @@ var ModuleBasics = module.NewBasicManager(
 	feegrantmodule.AppModuleBasic{},
 	tokenfactory.AppModuleBasic{},
 	drip.AppModuleBasic{},
+	junoburn.AppModuleBasic{},
 	feepay.AppModuleBasic{},
 	feeshare.AppModuleBasic{},
 	globalfee.AppModuleBasic{},
@@ -158,13 +160,14 @@ func appModules(
 		ibcfee.NewAppModule(app.AppKeepers.IBCFeeKeeper),
 		tokenfactory.NewAppModule(app.AppKeepers.TokenFactoryKeeper, app.AppKeepers.AccountKeeper, app.AppKeepers.BankKeeper, app.GetSubspace(tokenfactorytypes.ModuleName)),
 		globalfee.NewAppModule(appCodec, app.AppKeepers.GlobalFeeKeeper, bondDenom),
-		feepay.NewAppModule(app.AppKeepers.FeePayKeeper, app.AppKeepers.AccountKeeper),
+		feepay.NewAppModule(appCodec, app.AppKeepers.FeePayKeeper, app.AppKeepers.AccountKeeper),
 		feeshare.NewAppModule(app.AppKeepers.FeeShareKeeper, app.AppKeepers.AccountKeeper, app.GetSubspace(feesharetypes.ModuleName)),
 		wasm.NewAppModule(appCodec, &app.AppKeepers.WasmKeeper, app.AppKeepers.StakingKeeper, app.AppKeepers.AccountKeeper, app.AppKeepers.BankKeeper, app.MsgServiceRouter(), app.GetSubspace(wasmtypes.ModuleName)),
 		ica.NewAppModule(&app.AppKeepers.ICAControllerKeeper, &app.AppKeepers.ICAHostKeeper),
 		crisis.NewAppModule(app.AppKeepers.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)),
 		buildermodule.NewAppModule(appCodec, app.AppKeepers.BuildKeeper),
 		drip.NewAppModule(app.AppKeepers.DripKeeper, app.AppKeepers.AccountKeeper),
+		junoburn.NewAppModule(app.AppKeepers.BurnKeeper, app.AppKeepers.AccountKeeper),
 		clock.NewAppModule(appCodec, app.AppKeepers.ClockKeeper),
 		cwhooks.NewAppModule(appCodec, app.AppKeepers.CWHooksKeeper),
 		// IBC modules
@@ -202,7 +205,7 @@ func simulationModules(
 		wasm.NewAppModule(appCodec, &app.AppKeepers.WasmKeeper, app.AppKeepers.StakingKeeper, app.AppKeepers.AccountKeeper, app.AppKeepers.BankKeeper, app.MsgServiceRouter(), app.GetSubspace(wasmtypes.ModuleName)),
 		ibc.NewAppModule(app.AppKeepers.IBCKeeper),
 		transfer.NewAppModule(app.AppKeepers.TransferKeeper),
-		feepay.NewAppModule(app.AppKeepers.FeePayKeeper, app.AppKeepers.AccountKeeper),
+		feepay.NewAppModule(appCodec, app.AppKeepers.FeePayKeeper, app.AppKeepers.AccountKeeper),
 		feeshare.NewAppModule(app.AppKeepers.FeeShareKeeper, app.AppKeepers.AccountKeeper, app.GetSubspace(feesharetypes.ModuleName)),
 		ibcfee.NewAppModule(app.AppKeepers.IBCFeeKeeper),
 	}
@@ -240,6 +243,7 @@ func orderBeginBlockers() []string {
 		icqtypes.ModuleName,
 		tokenfactorytypes.ModuleName,
 		driptypes.ModuleName,
+		junoburn.ModuleName,
 		feepaytypes.ModuleName,
 		feesharetypes.ModuleName,
 		globalfee.ModuleName,
@@ -281,6 +285,7 @@ func orderEndBlockers() []string {
 		icqtypes.ModuleName,
 		tokenfactorytypes.ModuleName,
 		driptypes.ModuleName,
+		junoburn.ModuleName,
 		feepaytypes.ModuleName,
 		feesharetypes.ModuleName,
 		globalfee.ModuleName,
@@ -322,6 +327,7 @@ func orderInitBlockers() []string {
 		icqtypes.ModuleName,
 		tokenfactorytypes.ModuleName,
 		driptypes.ModuleName,
+		junoburn.ModuleName,
 		feepaytypes.ModuleName,
 		feesharetypes.ModuleName,
 		globalfee.ModuleName,
@@ -4,6 +4,7 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/CosmosContracts/juno/v26/app/upgrades"
+	burntypes "github.com/CosmosContracts/juno/v26/x/burn/types"
 )
 
 // UpgradeName defines the on-chain upgrade name for the upgrade.
@@ -12,5 +13,9 @@ const UpgradeName = "v26"
 var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateV26UpgradeHandler,
-	StoreUpgrades:        store.StoreUpgrades{},
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{
+			burntypes.StoreKey,
+		},
+	},
 }
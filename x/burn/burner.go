@@ -6,45 +6,60 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
-	mintkeeper "github.com/CosmosContracts/juno/v26/x/mint/keeper"
+	"github.com/CosmosContracts/juno/v26/x/burn/keeper"
+	"github.com/CosmosContracts/juno/v26/x/burn/types"
+	minttypes "github.com/CosmosContracts/juno/v26/x/mint/types"
 )
 
 // used to override Wasmd's NewBurnCoinMessageHandler
 
 type BurnerWasmPlugin struct {
 	bk bankkeeper.Keeper
-	mk mintkeeper.Keeper
+	k  keeper.Keeper
 }
 
 var _ wasmtypes.Burner = &BurnerWasmPlugin{}
 
-func NewBurnerPlugin(bk bankkeeper.Keeper, mk mintkeeper.Keeper) *BurnerWasmPlugin {
-	return &BurnerWasmPlugin{bk: bk, mk: mk}
+func NewBurnerPlugin(bk bankkeeper.Keeper, k keeper.Keeper) *BurnerWasmPlugin {
+	return &BurnerWasmPlugin{bk: bk, k: k}
 }
 
-func (k *BurnerWasmPlugin) BurnCoins(ctx sdk.Context, _ string, amt sdk.Coins) error {
-	// first, try to burn the coins on bank module
-	err := k.bk.BurnCoins(ctx, ModuleName, amt)
-	if err != nil {
-		return err
+func (k *BurnerWasmPlugin) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	// contracts burn through the wasm module
+	source := minttypes.BurnSourceJunoBurn
+	if moduleName == wasmtypes.ModuleName {
+		source = minttypes.BurnSourceWasm
 	}
 
-	// get mint params
-	params := k.mk.GetParams(ctx)
-
-	// loop the burned coins
-	for _, amount := range amt {
-		// if we are burning mint denom, reduce the target staking supply
-		if amount.Denom == params.MintDenom {
-			if err := k.mk.ReduceTargetSupply(ctx, amount); err != nil {
-				return err
-			}
-		}
+	// the tokenfactory denoms were already burned from the sender
+	_, amt = types.SplitTokenFactoryCoins(amt)
+	if amt.IsZero() {
+		return nil
 	}
 
-	return nil
+	// burn the coins and account for them, reducing the target staking supply
+	// when burning the mint denom
+	return k.k.BurnCoins(ctx, source, amt)
 }
 
+// SendCoinsFromAccountToModule enforces the burn policy of each denom before the
+// coins are burned. The tokenfactory denoms are burned right away through
+// x/tokenfactory since only their admin can burn them.
 func (k *BurnerWasmPlugin) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, _ string, amt sdk.Coins) error {
-	return k.bk.SendCoinsFromAccountToModule(ctx, senderAddr, ModuleName, amt)
+	if err := k.k.CheckBurnPolicy(ctx, senderAddr, amt); err != nil {
+		return err
+	}
+
+	factory, other := types.SplitTokenFactoryCoins(amt)
+	if !factory.IsZero() {
+		if err := k.k.BurnFromAccount(ctx, minttypes.BurnSourceWasm, senderAddr, factory); err != nil {
+			return err
+		}
+	}
+
+	if other.IsZero() {
+		return nil
+	}
+
+	return k.bk.SendCoinsFromAccountToModule(ctx, senderAddr, ModuleName, other)
 }
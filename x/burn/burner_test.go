@@ -0,0 +1,61 @@
+package burn_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/CosmosContracts/juno/v26/app"
+	junoburn "github.com/CosmosContracts/juno/v26/x/burn"
+	"github.com/CosmosContracts/juno/v26/x/burn/types"
+	tokenfactorykeeper "github.com/CosmosContracts/juno/v26/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/CosmosContracts/juno/v26/x/tokenfactory/types"
+)
+
+func TestBurnerPluginPolicies(t *testing.T) {
+	junoapp := app.Setup(t)
+	ctx := junoapp.BaseApp.NewContext(false, tmproto.Header{ChainID: "testing"})
+	plugin := junoburn.NewBurnerPlugin(junoapp.AppKeepers.BankKeeper, junoapp.AppKeepers.BurnKeeper)
+
+	_, _, contract := testdata.KeyTestPubAddr()
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	funds := sdk.NewCoins(sdk.NewInt64Coin("utest", 100), sdk.NewInt64Coin(ibcDenom, 100))
+	require.NoError(t, junoapp.AppKeepers.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	require.NoError(t, junoapp.AppKeepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, contract, funds))
+
+	// the contract administers a tokenfactory denom
+	tfParams := junoapp.AppKeepers.TokenFactoryKeeper.GetParams(ctx)
+	tfParams.DenomCreationFee = nil
+	require.NoError(t, junoapp.AppKeepers.TokenFactoryKeeper.SetParams(ctx, tfParams))
+	denom, err := junoapp.AppKeepers.TokenFactoryKeeper.CreateDenom(ctx, contract.String(), "burnable")
+	require.NoError(t, err)
+	_, err = tokenfactorykeeper.NewMsgServerImpl(junoapp.AppKeepers.TokenFactoryKeeper).Mint(ctx, tokenfactorytypes.NewMsgMint(contract.String(), sdk.NewInt64Coin(denom, 100)))
+	require.NoError(t, err)
+
+	burn := func(amt sdk.Coins) error {
+		if err := plugin.SendCoinsFromAccountToModule(ctx, contract, wasmtypes.ModuleName, amt); err != nil {
+			return err
+		}
+		return plugin.BurnCoins(ctx, wasmtypes.ModuleName, amt)
+	}
+
+	// ibc denoms are rejected before any transfer
+	err = burn(sdk.NewCoins(sdk.NewInt64Coin("utest", 10), sdk.NewInt64Coin(ibcDenom, 10)))
+	require.ErrorIs(t, err, types.ErrIBCDenomNotAllowed)
+	require.Equal(t, funds, junoapp.AppKeepers.BankKeeper.GetAllBalances(ctx, contract).Sub(sdk.NewInt64Coin(denom, 100)))
+
+	// tokenfactory denoms are burned through x/tokenfactory by their admin
+	burned := sdk.NewCoins(sdk.NewInt64Coin("utest", 10), sdk.NewInt64Coin(denom, 10))
+	require.NoError(t, burn(burned))
+	require.Equal(t, int64(90), junoapp.AppKeepers.BankKeeper.GetSupply(ctx, denom).Amount.Int64())
+	require.Equal(t, burned, junoapp.AppKeepers.BurnKeeper.GetTotalBurned(ctx))
+	require.True(t, junoapp.AppKeepers.BankKeeper.GetAllBalances(ctx, junoapp.AppKeepers.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+}
@@ -1,5 +1,7 @@
 package burn
 
+import "github.com/CosmosContracts/juno/v26/x/burn/types"
+
 const (
-	ModuleName = "junoburn"
+	ModuleName = types.ModuleName
 )
@@ -0,0 +1,30 @@
+package burn_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/app"
+	junoburn "github.com/CosmosContracts/juno/v26/x/burn"
+	"github.com/CosmosContracts/juno/v26/x/burn/types"
+)
+
+func TestBurnGenesis(t *testing.T) {
+	junoapp := app.Setup(t)
+	ctx := junoapp.BaseApp.NewContext(false, tmproto.Header{ChainID: "testing"})
+
+	genesis := types.NewGenesisState(types.NewParams(false, nil), sdk.NewCoins(sdk.NewInt64Coin("ujuno", 10), sdk.NewInt64Coin("factory/juno1/token", 5)))
+	require.NoError(t, genesis.Validate())
+
+	junoburn.InitGenesis(ctx, junoapp.AppKeepers.BurnKeeper, genesis)
+	exported := junoburn.ExportGenesis(ctx, junoapp.AppKeepers.BurnKeeper)
+	require.Equal(t, genesis, *exported)
+
+	invalid := types.GenesisState{Burned: sdk.Coins{{Denom: "ujuno", Amount: sdk.NewInt(-1)}}}
+	require.Error(t, invalid.Validate())
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CosmosContracts/juno/v26/x/burn/types"
+	tokenfactorytypes "github.com/CosmosContracts/juno/v26/x/tokenfactory/types"
+)
+
+// GetBurned returns the total amount burned of a denom.
+func (k Keeper) GetBurned(ctx sdk.Context, denom string) sdk.Coin {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnedKeyPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, math.ZeroInt())
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return sdk.NewCoin(denom, amount)
+}
+
+// SetBurned sets the total amount burned of a denom.
+func (k Keeper) SetBurned(ctx sdk.Context, coin sdk.Coin) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnedKeyPrefix)
+	bz, err := coin.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(coin.Denom), bz)
+}
+
+// GetTotalBurned returns the total amount burned of each denom.
+func (k Keeper) GetTotalBurned(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnedKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	burned := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		burned = burned.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return burned
+}
+
+// CheckBurnPolicy returns an error if the burner is not allowed to burn one of
+// the coins. IBC vouchers must be allowed by governance since they could never
+// be recovered on their source chain, and x/tokenfactory denoms can only be
+// burned by their admin or burners.
+func (k Keeper) CheckBurnPolicy(ctx sdk.Context, burner sdk.AccAddress, amt sdk.Coins) error {
+	params := k.GetParams(ctx)
+	for _, coin := range amt {
+		switch {
+		case types.IsIBCDenom(coin.Denom):
+			if !params.IsAllowedIBCDenom(coin.Denom) {
+				return types.ErrIBCDenomNotAllowed.Wrapf("denom: %s", coin.Denom)
+			}
+		case types.IsTokenFactoryDenom(coin.Denom):
+			metadata, err := k.tfKeeper.GetAuthorityMetadata(ctx, coin.Denom)
+			if err != nil {
+				return err
+			}
+			if !metadata.HasRole(tokenfactorytypes.RoleBurner, burner.String()) {
+				return types.ErrNotDenomAdmin.Wrapf("denom: %s", coin.Denom)
+			}
+		}
+	}
+
+	return nil
+}
+
+// BurnFromAccount burns coins owned by an account. The x/tokenfactory denoms
+// are burned through x/tokenfactory, which records them with its own source.
+func (k Keeper) BurnFromAccount(ctx sdk.Context, source string, burner sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.CheckBurnPolicy(ctx, burner, amt); err != nil {
+		return err
+	}
+
+	factory, other := types.SplitTokenFactoryCoins(amt)
+	for _, coin := range factory {
+		if err := k.tfKeeper.BurnFrom(ctx, coin, burner.String()); err != nil {
+			return err
+		}
+	}
+
+	if other.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, burner, types.ModuleName, other); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, other); err != nil {
+		return err
+	}
+
+	return k.recordBurn(ctx, source, burner, other)
+}
+
+// BurnCoins burns coins held by the module account. The module account is not
+// the admin of any x/tokenfactory denom, so these can not be burned this way.
+func (k Keeper) BurnCoins(ctx sdk.Context, source string, amt sdk.Coins) error {
+	if err := k.CheckBurnPolicy(ctx, authtypes.NewModuleAddress(types.ModuleName), amt); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amt); err != nil {
+		return err
+	}
+
+	return k.RecordBurn(ctx, source, amt)
+}
+
+// RecordBurn accounts for coins burned through a source. It is the single
+// accounting path of every burn of the chain: it adds the coins to the burned
+// totals, records them in the x/mint burned supply and reduces the mint target
+// supply by the burned mint denom. Modules burning coins themselves call it
+// after the burn.
+func (k Keeper) RecordBurn(ctx sdk.Context, source string, amt sdk.Coins) error {
+	return k.recordBurn(ctx, source, nil, amt)
+}
+
+func (k Keeper) recordBurn(ctx sdk.Context, source string, burner sdk.AccAddress, amt sdk.Coins) error {
+	if amt.IsZero() {
+		return nil
+	}
+
+	for _, coin := range amt {
+		k.SetBurned(ctx, k.GetBurned(ctx, coin.Denom).Add(coin))
+	}
+
+	k.mintKeeper.RecordBurn(ctx, source, amt)
+
+	mintDenom := k.mintKeeper.GetParams(ctx).MintDenom
+	if amount := amt.AmountOf(mintDenom); amount.IsPositive() {
+		if err := k.mintKeeper.ReduceTargetSupply(ctx, sdk.NewCoin(mintDenom, amount)); err != nil {
+			return err
+		}
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySource, source),
+		sdk.NewAttribute(types.AttributeKeyAmount, amt.String()),
+	}
+	if burner != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyBurner, burner.String()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeBurn, attrs...))
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/burn/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines a wrapper around the x/burn keeper providing gRPC method
+// handlers.
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+// Params returns the burn module params
+func (q Querier) Params(
+	c context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// TotalBurned returns the total amount burned of each denom
+func (q Querier) TotalBurned(
+	c context.Context,
+	_ *types.QueryTotalBurnedRequest,
+) (*types.QueryTotalBurnedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTotalBurnedResponse{Burned: q.GetTotalBurned(ctx)}, nil
+}
+
+// BurnedByDenom returns the total amount burned of a denom
+func (q Querier) BurnedByDenom(
+	c context.Context,
+	req *types.QueryBurnedByDenomRequest,
+) (*types.QueryBurnedByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBurnedByDenomResponse{Burned: q.GetBurned(ctx, req.Denom)}, nil
+}
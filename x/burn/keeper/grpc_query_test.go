@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/burn/types"
+	minttypes "github.com/CosmosContracts/juno/v26/x/mint/types"
+)
+
+func (s *KeeperTestSuite) TestQueries() {
+	params, err := s.queryClient.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), params.Params)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 5), sdk.NewInt64Coin("factory/juno1/token", 7))
+	s.Require().NoError(s.app.AppKeepers.BurnKeeper.RecordBurn(s.ctx, minttypes.BurnSourceJunoBurn, coins))
+
+	total, err := s.queryClient.TotalBurned(s.ctx, &types.QueryTotalBurnedRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(coins, total.Burned)
+
+	byDenom, err := s.queryClient.BurnedByDenom(s.ctx, &types.QueryBurnedByDenomRequest{Denom: "factory/juno1/token"})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("factory/juno1/token", 7), byDenom.Burned)
+
+	byDenom, err = s.queryClient.BurnedByDenom(s.ctx, &types.QueryBurnedByDenomRequest{Denom: "unburned"})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("unburned", 0), byDenom.Burned)
+
+	_, err = s.queryClient.BurnedByDenom(s.ctx, &types.QueryBurnedByDenomRequest{Denom: "!"})
+	s.Require().Error(err)
+}
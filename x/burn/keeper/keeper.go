@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/burn/types"
+)
+
+// Keeper of this module burns tokens and accounts for all the burns of the
+// chain.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	bankKeeper types.BankKeeper
+	mintKeeper types.MintKeeper
+	tfKeeper   types.TokenFactoryKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates new instances of the Keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	bk types.BankKeeper,
+	mk types.MintKeeper,
+	tfk types.TokenFactoryKeeper,
+	authority string,
+) Keeper {
+	return Keeper{
+		storeKey:   storeKey,
+		cdc:        cdc,
+		bankKeeper: bk,
+		mintKeeper: mk,
+		tfKeeper:   tfk,
+		authority:  authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the x/burn module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/CosmosContracts/juno/v26/app"
+	"github.com/CosmosContracts/juno/v26/x/burn/keeper"
+	"github.com/CosmosContracts/juno/v26/x/burn/types"
+	junominttypes "github.com/CosmosContracts/juno/v26/x/mint/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *app.App
+	queryClient types.QueryClient
+	msgServer   types.MsgServer
+	bondDenom   string
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.Setup(s.T())
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{
+		ChainID: "testing",
+		Height:  9,
+		Time:    time.Now().UTC(),
+	})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.NewQuerier(s.app.AppKeepers.BurnKeeper))
+
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.msgServer = s.app.AppKeepers.BurnKeeper
+	s.bondDenom = s.app.AppKeepers.StakingKeeper.BondDenom(s.ctx)
+
+	// burn the staking token from the target supply
+	mintParams := s.app.AppKeepers.MintKeeper.GetParams(s.ctx)
+	mintParams.MintDenom = s.bondDenom
+	s.Require().NoError(s.app.AppKeepers.MintKeeper.SetParams(s.ctx, mintParams))
+
+	minter := junominttypes.DefaultInitialMinter()
+	minter.TargetSupply = math.NewInt(1_000_000)
+	s.app.AppKeepers.MintKeeper.SetMinter(s.ctx, minter)
+}
+
+func (s *KeeperTestSuite) FundAccount(ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
+	if err := s.app.AppKeepers.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amounts); err != nil {
+		return err
+	}
+
+	return s.app.AppKeepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, amounts)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
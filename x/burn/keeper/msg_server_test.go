@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/CosmosContracts/juno/v26/x/burn/types"
+	minttypes "github.com/CosmosContracts/juno/v26/x/mint/types"
+)
+
+func (s *KeeperTestSuite) TestMsgBurn() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	s.Require().NoError(s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 1_000), sdk.NewInt64Coin("utest", 1_000))))
+
+	startSupply := s.app.AppKeepers.BankKeeper.GetSupply(s.ctx, s.bondDenom).Amount
+
+	for _, tc := range []struct {
+		desc    string
+		msg     *types.MsgBurn
+		success bool
+	}{
+		{
+			desc:    "Fail - Insufficient funds",
+			msg:     types.NewMsgBurn(sender, sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 10_000))),
+			success: false,
+		},
+		{
+			desc:    "Fail - Empty amount",
+			msg:     types.NewMsgBurn(sender, sdk.NewCoins()),
+			success: false,
+		},
+		{
+			desc:    "Success - Burn staking and other tokens",
+			msg:     types.NewMsgBurn(sender, sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 100), sdk.NewInt64Coin("utest", 10))),
+			success: true,
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			_, err := s.msgServer.Burn(s.ctx, tc.msg)
+			if !tc.success {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	burned := sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 100), sdk.NewInt64Coin("utest", 10))
+	s.Require().Equal(startSupply.SubRaw(100), s.app.AppKeepers.BankKeeper.GetSupply(s.ctx, s.bondDenom).Amount)
+	s.Require().Equal(burned, s.app.AppKeepers.BurnKeeper.GetTotalBurned(s.ctx))
+	s.Require().Equal(burned, s.app.AppKeepers.MintKeeper.GetBurnedSupply(s.ctx, minttypes.BurnSourceUser))
+	s.Require().Equal(sdk.NewInt(1_000_000-100), s.app.AppKeepers.MintKeeper.GetMinter(s.ctx).TargetSupply)
+
+	// burning with MsgBurn disabled by governance
+	s.Require().NoError(s.app.AppKeepers.BurnKeeper.SetParams(s.ctx, types.NewParams(false, nil)))
+	_, err := s.msgServer.Burn(s.ctx, types.NewMsgBurn(sender, sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 1))))
+	s.Require().ErrorIs(err, types.ErrBurnMsgDisabled)
+}
+
+func (s *KeeperTestSuite) TestRecordBurn() {
+	// burns by other modules share the accounting of the module
+	coins := sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 5), sdk.NewInt64Coin("factory/juno1/token", 7))
+	s.Require().NoError(s.app.AppKeepers.BurnKeeper.RecordBurn(s.ctx, minttypes.BurnSourceTokenFactory, coins))
+	s.Require().NoError(s.app.AppKeepers.BurnKeeper.RecordBurn(s.ctx, minttypes.BurnSourceWasm, sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 3))))
+
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 8), sdk.NewInt64Coin("factory/juno1/token", 7)), s.app.AppKeepers.BurnKeeper.GetTotalBurned(s.ctx))
+	s.Require().Equal(coins, s.app.AppKeepers.MintKeeper.GetBurnedSupply(s.ctx, minttypes.BurnSourceTokenFactory))
+	s.Require().Equal(sdk.NewInt(1_000_000-8), s.app.AppKeepers.MintKeeper.GetMinter(s.ctx).TargetSupply)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	govModAddr := s.app.AppKeepers.AccountKeeper.GetModuleAddress(govtypes.ModuleName).String()
+	feeCollector := s.app.AppKeepers.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName).String()
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: feeCollector, Params: types.NewParams(false, nil)})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govModAddr, Params: types.NewParams(false, nil)})
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewParams(false, nil), s.app.AppKeepers.BurnKeeper.GetParams(s.ctx))
+}
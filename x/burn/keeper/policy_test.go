@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/burn/types"
+	minttypes "github.com/CosmosContracts/juno/v26/x/mint/types"
+	tokenfactorykeeper "github.com/CosmosContracts/juno/v26/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/CosmosContracts/juno/v26/x/tokenfactory/types"
+)
+
+const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+// createFactoryDenom creates a tokenfactory denom administered by admin and
+// mints the amount to it.
+func (s *KeeperTestSuite) createFactoryDenom(admin sdk.AccAddress, amount int64) string {
+	tfParams := s.app.AppKeepers.TokenFactoryKeeper.GetParams(s.ctx)
+	tfParams.DenomCreationFee = nil
+	s.Require().NoError(s.app.AppKeepers.TokenFactoryKeeper.SetParams(s.ctx, tfParams))
+
+	denom, err := s.app.AppKeepers.TokenFactoryKeeper.CreateDenom(s.ctx, admin.String(), "burnable")
+	s.Require().NoError(err)
+
+	tfMsgServer := tokenfactorykeeper.NewMsgServerImpl(s.app.AppKeepers.TokenFactoryKeeper)
+	_, err = tfMsgServer.Mint(s.ctx, tokenfactorytypes.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, amount)))
+	s.Require().NoError(err)
+
+	return denom
+}
+
+func (s *KeeperTestSuite) TestBurnTokenFactoryDenom() {
+	_, _, admin := testdata.KeyTestPubAddr()
+	_, _, holder := testdata.KeyTestPubAddr()
+	denom := s.createFactoryDenom(admin, 1_000)
+	s.Require().NoError(s.app.AppKeepers.BankKeeper.SendCoins(s.ctx, admin, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	// only the denom admin or burners can burn it
+	_, err := s.msgServer.Burn(s.ctx, types.NewMsgBurn(holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	s.Require().ErrorIs(err, types.ErrNotDenomAdmin)
+
+	s.Require().NoError(s.app.AppKeepers.TokenFactoryKeeper.GrantRole(s.ctx, denom, tokenfactorytypes.RoleBurner, holder.String()))
+	_, err = s.msgServer.Burn(s.ctx, types.NewMsgBurn(holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Burn(s.ctx, types.NewMsgBurn(admin, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	s.Require().NoError(err)
+
+	// the burns go through x/tokenfactory
+	burned := sdk.NewCoins(sdk.NewInt64Coin(denom, 20))
+	s.Require().Equal(int64(1_000-20), s.app.AppKeepers.BankKeeper.GetSupply(s.ctx, denom).Amount.Int64())
+	s.Require().Equal(burned, s.app.AppKeepers.BurnKeeper.GetTotalBurned(s.ctx))
+	s.Require().Equal(burned, s.app.AppKeepers.MintKeeper.GetBurnedSupply(s.ctx, minttypes.BurnSourceTokenFactory))
+	s.Require().True(s.app.AppKeepers.MintKeeper.GetBurnedSupply(s.ctx, minttypes.BurnSourceUser).IsZero())
+
+	// the module account is not the admin of the denom
+	err = s.app.AppKeepers.BurnKeeper.BurnCoins(s.ctx, minttypes.BurnSourceJunoBurn, burned)
+	s.Require().ErrorIs(err, types.ErrNotDenomAdmin)
+}
+
+func (s *KeeperTestSuite) TestBurnIBCDenom() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	s.Require().NoError(s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 1_000))))
+
+	// ibc denoms can not be burned unless governance allows them
+	burned := sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 100))
+	_, err := s.msgServer.Burn(s.ctx, types.NewMsgBurn(sender, burned))
+	s.Require().ErrorIs(err, types.ErrIBCDenomNotAllowed)
+
+	s.Require().NoError(s.app.AppKeepers.BurnKeeper.SetParams(s.ctx, types.NewParams(true, []string{ibcDenom})))
+	_, err = s.msgServer.Burn(s.ctx, types.NewMsgBurn(sender, burned))
+	s.Require().NoError(err)
+	s.Require().Equal(burned, s.app.AppKeepers.BurnKeeper.GetTotalBurned(s.ctx))
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrBurnMsgDisabled    = errorsmod.Register(ModuleName, 1, "burning with MsgBurn is disabled by governance")
+	ErrIBCDenomNotAllowed = errorsmod.Register(ModuleName, 2, "burning this ibc denom is not allowed by governance")
+	ErrNotDenomAdmin      = errorsmod.Register(ModuleName, 3, "only the admin or a burner of a tokenfactory denom can burn it")
+)
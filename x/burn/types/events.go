@@ -0,0 +1,9 @@
+package types
+
+const (
+	EventTypeBurn = "junoburn"
+
+	AttributeKeySource = "source"
+	AttributeKeyBurner = "burner"
+	AttributeKeyAmount = "amount"
+)
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	minttypes "github.com/CosmosContracts/juno/v26/x/mint/types"
+	tokenfactorytypes "github.com/CosmosContracts/juno/v26/x/tokenfactory/types"
+)
+
+// BankKeeper defines the expected interface needed to burn coins.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// MintKeeper defines the expected interface needed to account the burned coins
+// in x/mint.
+type MintKeeper interface {
+	GetParams(ctx sdk.Context) (params minttypes.Params)
+	ReduceTargetSupply(ctx sdk.Context, burnCoin sdk.Coin) error
+	RecordBurn(ctx sdk.Context, source string, coins sdk.Coins)
+}
+
+// TokenFactoryKeeper defines the expected interface needed to burn
+// x/tokenfactory denoms through their admin or burners.
+type TokenFactoryKeeper interface {
+	GetAuthorityMetadata(ctx sdk.Context, denom string) (tokenfactorytypes.DenomAuthorityMetadata, error)
+	BurnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) error
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, burned sdk.Coins) GenesisState {
+	return GenesisState{
+		Params: params,
+		Burned: burned,
+	}
+}
+
+// DefaultGenesisState sets default burn genesis state with default params and
+// nothing burned.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		Burned: sdk.NewCoins(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Burned.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid burned coins")
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgBurnValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender______________"))
+
+	testCases := []struct {
+		name     string
+		msg      MsgBurn
+		expError bool
+	}{
+		{"valid", *NewMsgBurn(sender, sdk.NewCoins(sdk.NewInt64Coin("ujuno", 1))), false},
+		{"invalid sender", MsgBurn{Sender: "invalid", Amount: sdk.NewCoins(sdk.NewInt64Coin("ujuno", 1))}, true},
+		{"empty amount", *NewMsgBurn(sender, sdk.NewCoins()), true},
+		{"invalid amount", MsgBurn{Sender: sender.String(), Amount: sdk.Coins{{Denom: "ujuno", Amount: sdk.NewInt(0)}}}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
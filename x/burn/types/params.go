@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultEnableBurnMsg lets accounts burn their tokens by default
+var DefaultEnableBurnMsg = true
+
+// NewParams creates a new Params object
+func NewParams(enableBurnMsg bool, allowedIBCDenoms []string) Params {
+	return Params{
+		EnableBurnMsg:    enableBurnMsg,
+		AllowedIbcDenoms: allowedIBCDenoms,
+	}
+}
+
+// DefaultParams returns default x/burn module parameters.
+func DefaultParams() Params {
+	return Params{
+		EnableBurnMsg:    DefaultEnableBurnMsg,
+		AllowedIbcDenoms: []string(nil),
+	}
+}
+
+// IsAllowedIBCDenom returns true if governance allows burning the IBC denom
+func (p Params) IsAllowedIBCDenom(denom string) bool {
+	for _, d := range p.AllowedIbcDenoms {
+		if d == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateIBCDenoms(denoms []string) error {
+	idx := make(map[string]struct{}, len(denoms))
+	for _, d := range denoms {
+		if err := sdk.ValidateDenom(d); err != nil {
+			return fmt.Errorf("invalid ibc denom %s: %w", d, err)
+		}
+		if !strings.HasPrefix(d, IBCDenomPrefix) {
+			return fmt.Errorf("denom %s is not an ibc denom", d)
+		}
+		if _, exists := idx[d]; exists {
+			return fmt.Errorf("duplicate ibc denom: %s", d)
+		}
+		idx[d] = struct{}{}
+	}
+
+	return nil
+}
+
+func (p Params) Validate() error {
+	if err := validateBool(p.EnableBurnMsg); err != nil {
+		return err
+	}
+
+	return validateIBCDenoms(p.AllowedIbcDenoms)
+}
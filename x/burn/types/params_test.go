@@ -0,0 +1,34 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmosContracts/juno/v26/x/burn/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	for _, tc := range []struct {
+		desc    string
+		params  types.Params
+		success bool
+	}{
+		{desc: "Success - Default", params: types.DefaultParams(), success: true},
+		{desc: "Success - Allowed ibc denom", params: types.NewParams(true, []string{ibcDenom}), success: true},
+		{desc: "Fail - Not an ibc denom", params: types.NewParams(true, []string{"ujuno"}), success: false},
+		{desc: "Fail - Duplicate ibc denom", params: types.NewParams(true, []string{ibcDenom, ibcDenom}), success: false},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.success {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
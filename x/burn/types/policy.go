@@ -0,0 +1,42 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tokenfactorytypes "github.com/CosmosContracts/juno/v26/x/tokenfactory/types"
+)
+
+const (
+	// IBCDenomPrefix prefixes the denoms of IBC vouchers
+	IBCDenomPrefix = "ibc/"
+
+	// TokenFactoryDenomPrefix prefixes the denoms created with x/tokenfactory
+	TokenFactoryDenomPrefix = tokenfactorytypes.ModuleDenomPrefix + "/"
+)
+
+// IsIBCDenom returns true if the denom is an IBC voucher
+func IsIBCDenom(denom string) bool {
+	return strings.HasPrefix(denom, IBCDenomPrefix)
+}
+
+// IsTokenFactoryDenom returns true if the denom was created with x/tokenfactory
+func IsTokenFactoryDenom(denom string) bool {
+	return strings.HasPrefix(denom, TokenFactoryDenomPrefix)
+}
+
+// SplitTokenFactoryCoins splits coins between the x/tokenfactory denoms, which
+// are burned through x/tokenfactory, and the other denoms.
+func SplitTokenFactoryCoins(amt sdk.Coins) (factory sdk.Coins, other sdk.Coins) {
+	factory, other = sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range amt {
+		if IsTokenFactoryDenom(coin.Denom) {
+			factory = factory.Add(coin)
+		} else {
+			other = other.Add(coin)
+		}
+	}
+
+	return factory, other
+}
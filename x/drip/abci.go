@@ -0,0 +1,20 @@
+package drip
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/drip/keeper"
+	"github.com/CosmosContracts/juno/v26/x/drip/types"
+)
+
+// BeginBlocker releases the slice of each drip schedule due at the current
+// block. A failed release is logged and skipped so it can't halt the chain or
+// hold back the other schedules.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.ReleaseSchedules(ctx)
+}
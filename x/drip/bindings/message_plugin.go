@@ -0,0 +1,85 @@
+package bindings
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/CosmosContracts/juno/v26/x/drip/bindings/types"
+	dripkeeper "github.com/CosmosContracts/juno/v26/x/drip/keeper"
+	driptypes "github.com/CosmosContracts/juno/v26/x/drip/types"
+)
+
+// CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
+func CustomMessageDecorator(drip *dripkeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped: old,
+			drip:    drip,
+		}
+	}
+}
+
+type CustomMessenger struct {
+	wrapped wasmkeeper.Messenger
+	drip    *dripkeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes on the contractMsg.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	if msg.Custom != nil {
+		// only handle drip messages, leave everything else for the wrapped version
+		var contractMsg bindingstypes.DripMsg
+		if err := json.Unmarshal(msg.Custom, &contractMsg); err != nil {
+			return nil, nil, errorsmod.Wrap(err, "drip msg")
+		}
+
+		if contractMsg.DistributeTokens != nil {
+			return m.distributeTokens(ctx, contractAddr, contractMsg.DistributeTokens)
+		}
+		if contractMsg.DistributeTokensToValidators != nil {
+			return m.distributeTokensToValidators(ctx, contractAddr, contractMsg.DistributeTokensToValidators)
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// distributeTokens distributes tokens owned by the contract to all stakers.
+func (m *CustomMessenger) distributeTokens(ctx sdk.Context, contractAddr sdk.AccAddress, distribute *bindingstypes.DistributeTokens) ([]sdk.Event, [][]byte, error) {
+	amount, err := wasmkeeper.ConvertWasmCoinsToSdkCoins(distribute.Amount)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "distribute tokens amount")
+	}
+
+	msg := driptypes.NewMsgDistributeTokens(amount, contractAddr)
+	if _, err := m.drip.DistributeTokens(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "distributing tokens")
+	}
+	return nil, nil, nil
+}
+
+// distributeTokensToValidators distributes tokens owned by the contract to the
+// delegators of a set of validators.
+func (m *CustomMessenger) distributeTokensToValidators(ctx sdk.Context, contractAddr sdk.AccAddress, distribute *bindingstypes.DistributeTokensToValidators) ([]sdk.Event, [][]byte, error) {
+	amount, err := wasmkeeper.ConvertWasmCoinsToSdkCoins(distribute.Amount)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "distribute tokens amount")
+	}
+
+	msg := &driptypes.MsgDistributeTokensToValidators{
+		SenderAddress:      contractAddr.String(),
+		Amount:             amount,
+		ValidatorAddresses: distribute.ValidatorAddresses,
+	}
+	if _, err := m.drip.DistributeTokensToValidators(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "distributing tokens to validators")
+	}
+	return nil, nil, nil
+}
@@ -0,0 +1,71 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/CosmosContracts/juno/v26/app"
+	"github.com/CosmosContracts/juno/v26/x/drip/bindings"
+	bindingstypes "github.com/CosmosContracts/juno/v26/x/drip/bindings/types"
+	"github.com/CosmosContracts/juno/v26/x/drip/types"
+)
+
+// wrappedMessenger records the messages not handled by the drip messenger
+type wrappedMessenger struct {
+	dispatched []wasmvmtypes.CosmosMsg
+}
+
+func (w *wrappedMessenger) DispatchMsg(_ sdk.Context, _ sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	w.dispatched = append(w.dispatched, msg)
+	return nil, nil, nil
+}
+
+func TestDistributeTokensMsg(t *testing.T) {
+	junoapp := app.Setup(t)
+	ctx := junoapp.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "testing", Time: time.Now().UTC()})
+
+	_, _, contract := testdata.KeyTestPubAddr()
+	require.NoError(t, banktestutil.FundAccount(junoapp.AppKeepers.BankKeeper, ctx, contract, sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000))))
+
+	wrapped := &wrappedMessenger{}
+	messenger := bindings.CustomMessageDecorator(&junoapp.AppKeepers.DripKeeper)(wrapped)
+
+	custom, err := json.Marshal(bindingstypes.DripMsg{DistributeTokens: &bindingstypes.DistributeTokens{
+		Amount: wasmvmtypes.Coins{wasmvmtypes.NewCoin(100, "stake")},
+	}})
+	require.NoError(t, err)
+
+	// the contract is not allowed to use the module
+	_, _, err = messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: custom})
+	require.ErrorIs(t, err, types.ErrDripNotAllowed)
+
+	require.NoError(t, junoapp.AppKeepers.DripKeeper.SetParams(ctx, types.Params{
+		EnableDrip:       true,
+		AllowedAddresses: []string{contract.String()},
+	}))
+
+	feeCollector := junoapp.AppKeepers.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	startBalance := junoapp.AppKeepers.BankKeeper.GetBalance(ctx, feeCollector, "stake").Amount
+
+	_, _, err = messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: custom})
+	require.NoError(t, err)
+	require.Equal(t, startBalance.AddRaw(100), junoapp.AppKeepers.BankKeeper.GetBalance(ctx, feeCollector, "stake").Amount)
+	require.Equal(t, sdk.NewInt(900), junoapp.AppKeepers.BankKeeper.GetBalance(ctx, contract, "stake").Amount)
+
+	// other custom messages are left to the wrapped messenger
+	other := wasmvmtypes.CosmosMsg{Custom: []byte(`{"create_denom":{"subdenom":"SUN"}}`)}
+	_, _, err = messenger.DispatchMsg(ctx, contract, "", other)
+	require.NoError(t, err)
+	require.Equal(t, []wasmvmtypes.CosmosMsg{other}, wrapped.dispatched)
+}
@@ -0,0 +1,25 @@
+package types
+
+import wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+type DripMsg struct {
+	/// Contracts can distribute the given native tokens they own to all stakers
+	/// at the next block.
+	DistributeTokens *DistributeTokens `json:"distribute_tokens,omitempty"`
+	/// Contracts can distribute the given native tokens they own to the
+	/// delegators of a set of validators.
+	DistributeTokensToValidators *DistributeTokensToValidators `json:"distribute_tokens_to_validators,omitempty"`
+}
+
+// DistributeTokens distributes tokens owned by the contract to all stakers.
+// The contract must be allowed to use x/drip or pay the permissionless fee.
+type DistributeTokens struct {
+	Amount wasmvmtypes.Coins `json:"amount"`
+}
+
+// DistributeTokensToValidators distributes tokens owned by the contract to the
+// delegators of the given validators, split pro rata to their bonded tokens.
+type DistributeTokensToValidators struct {
+	Amount             wasmvmtypes.Coins `json:"amount"`
+	ValidatorAddresses []string          `json:"validator_addresses"`
+}
@@ -0,0 +1,17 @@
+package bindings
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	dripkeeper "github.com/CosmosContracts/juno/v26/x/drip/keeper"
+)
+
+func RegisterCustomPlugins(drip *dripkeeper.Keeper) []wasmkeeper.Option {
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(drip),
+	)
+
+	return []wasmkeeper.Option{
+		messengerDecoratorOpt,
+	}
+}
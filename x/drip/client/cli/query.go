@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -24,6 +25,11 @@ func GetQueryCmd() *cobra.Command {
 
 	feesQueryCmd.AddCommand(
 		GetCmdQueryParams(),
+		GetCmdQuerySchedules(),
+		GetCmdQuerySchedule(),
+		GetCmdQueryHistory(),
+		GetCmdQuerySenderTotals(),
+		GetCmdQuerySenderTotal(),
 	)
 
 	return feesQueryCmd
@@ -57,3 +63,180 @@ func GetCmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQuerySchedules implements a command to return the active drip schedules.
+func GetCmdQuerySchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedules",
+		Short: "Query the active drip schedules",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DripSchedules(context.Background(), &types.QueryDripSchedulesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "schedules")
+
+	return cmd
+}
+
+// GetCmdQuerySchedule implements a command to return an active drip schedule.
+func GetCmdQuerySchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [id]",
+		Short: "Query an active drip schedule by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DripSchedule(context.Background(), &types.QueryDripScheduleRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Schedule)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const flagSender = "sender"
+
+// GetCmdQueryHistory implements a command to return the latest drips.
+func GetCmdQueryHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Query the latest drips, optionally filtered by --sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sender, err := cmd.Flags().GetString(flagSender)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DripHistory(context.Background(), &types.QueryDripHistoryRequest{
+				SenderAddress: sender,
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagSender, "", "only return the drips of this sender")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "history")
+
+	return cmd
+}
+
+// GetCmdQuerySenderTotals implements a command to return the aggregated drips
+// of all senders.
+func GetCmdQuerySenderTotals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "totals",
+		Short: "Query the aggregated drips of all senders",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SenderDripTotals(context.Background(), &types.QuerySenderDripTotalsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "totals")
+
+	return cmd
+}
+
+// GetCmdQuerySenderTotal implements a command to return the aggregated drips of
+// a sender.
+func GetCmdQuerySenderTotal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total [sender]",
+		Short: "Query the aggregated drips of a sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SenderDripTotal(context.Background(), &types.QuerySenderDripTotalRequest{
+				SenderAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Total)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -23,6 +26,9 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewDistributeToken(),
+		NewCreateDripSchedule(),
+		NewCancelDripSchedule(),
+		NewDistributeTokensToValidators(),
 	)
 	return txCmd
 }
@@ -62,3 +68,114 @@ func NewDistributeToken() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+const flagStartHeight = "start-height"
+
+// NewCreateDripSchedule returns a CLI command handler for creating a drip schedule.
+func NewCreateDripSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-schedule [amount] [num-blocks]",
+		Short: "Distribute tokens to all stakers pro rata over a number of blocks.",
+		Long:  "Escrow tokens in the drip module and distribute a pro-rata slice of them to all stakers each block, starting at the next block or at --start-height. The schedule can be cancelled to refund the tokens not distributed yet. This message can be executed only by authorized addresses.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			numBlocks, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := cmd.Flags().GetInt64(flagStartHeight)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDripSchedule(amount, cliCtx.GetFromAddress(), startHeight, numBlocks)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(flagStartHeight, 0, "first block distributing tokens, the next block when unset")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelDripSchedule returns a CLI command handler for cancelling a drip schedule.
+func NewCancelDripSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-schedule [id]",
+		Short: "Cancel a drip schedule and refund the tokens not distributed yet.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelDripSchedule(cliCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewDistributeTokensToValidators returns a CLI command handler for distributing
+// tokens to the delegators of a set of validators.
+func NewDistributeTokensToValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribute-tokens-to-validators [amount] [validator-addresses]",
+		Short: "Distribute tokens to the delegators of the given validators.",
+		Long:  "Distribute tokens to the delegators of a comma separated list of validators. The amount is split between the validators pro rata to their bonded tokens and credited to their delegators rewards without applying the validators commission. This message can be executed only by authorized addresses.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDistributeTokensToValidators{
+				SenderAddress:      cliCtx.GetFromAddress().String(),
+				Amount:             amount,
+				ValidatorAddresses: strings.Split(args[1], ","),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,6 +1,8 @@
 package drip
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/CosmosContracts/juno/v26/x/drip/keeper"
@@ -16,11 +18,46 @@ func InitGenesis(
 	if err := k.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
+
+	// Ensure the module account can back what the schedules still have to release
+	remaining := sdk.NewCoins()
+	for _, schedule := range data.Schedules {
+		remaining = remaining.Add(schedule.RemainingAmount()...)
+	}
+
+	if balance := k.GetModuleBalance(ctx); !balance.IsAllGTE(remaining) {
+		panic(fmt.Errorf("drip schedule remaining amounts (%s) exceed the module account balance (%s)", remaining, balance))
+	}
+
+	for _, schedule := range data.Schedules {
+		k.SetSchedule(ctx, schedule)
+	}
+
+	if data.NextScheduleId != 0 {
+		k.SetNextScheduleID(ctx, data.NextScheduleId)
+	}
+
+	for _, record := range data.History {
+		k.SetRecord(ctx, record)
+	}
+
+	if data.NextRecordId != 0 {
+		k.SetNextRecordID(ctx, data.NextRecordId)
+	}
+
+	for _, total := range data.SenderTotals {
+		k.SetSenderTotal(ctx, total)
+	}
 }
 
 // ExportGenesis export module state
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
+		Params:         k.GetParams(ctx),
+		Schedules:      k.GetAllSchedules(ctx),
+		NextScheduleId: k.GetNextScheduleID(ctx),
+		History:        k.GetAllRecords(ctx),
+		NextRecordId:   k.GetNextRecordID(ctx),
+		SenderTotals:   k.GetAllSenderTotals(ctx),
 	}
 }
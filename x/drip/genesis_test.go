@@ -9,6 +9,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/CosmosContracts/juno/v26/app"
 	drip "github.com/CosmosContracts/juno/v26/x/drip"
@@ -112,3 +113,52 @@ func (suite *GenesisTestSuite) TestDripInitGenesis() {
 		})
 	}
 }
+
+func (suite *GenesisTestSuite) TestDripExportGenesisHistory() {
+	sender := sdk.AccAddress([]byte("sender______________"))
+	genesis := *types.DefaultGenesisState()
+	genesis.History = []types.DripRecord{
+		types.NewDripRecord(3, sender, sdk.NewCoins(sdk.NewInt64Coin("ujuno", 10)), 5),
+		types.NewDripRecord(4, sender, sdk.NewCoins(sdk.NewInt64Coin("ujuno", 20)), 7),
+	}
+	genesis.NextRecordId = 5
+	genesis.SenderTotals = []types.SenderDripTotal{
+		{SenderAddress: sender.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("ujuno", 40)), Count: 4},
+	}
+	suite.Require().NoError(genesis.Validate())
+
+	drip.InitGenesis(suite.ctx, suite.app.AppKeepers.DripKeeper, genesis)
+	exported := drip.ExportGenesis(suite.ctx, suite.app.AppKeepers.DripKeeper)
+	suite.Require().Equal(genesis, *exported)
+
+	genesis.NextRecordId = 4
+	suite.Require().ErrorIs(genesis.Validate(), types.ErrInvalidRecord)
+
+	// the records must be among the latest ones, which are the only ones pruned
+	genesis.NextRecordId = types.MaxDripHistory + 3
+	suite.Require().NoError(genesis.Validate())
+	genesis.NextRecordId = types.MaxDripHistory + 4
+	suite.Require().ErrorIs(genesis.Validate(), types.ErrInvalidRecord)
+}
+
+func (suite *GenesisTestSuite) TestDripInitGenesisScheduleBacking() {
+	sender := sdk.AccAddress([]byte("sender______________"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	genesis := *types.DefaultGenesisState()
+	genesis.Schedules = []types.DripSchedule{types.NewDripSchedule(1, sender, amount, 5, 10)}
+	genesis.NextScheduleId = 2
+	suite.Require().NoError(genesis.Validate())
+
+	// the module account does not hold the schedule amount
+	suite.Require().Panics(func() {
+		drip.InitGenesis(suite.ctx, suite.app.AppKeepers.DripKeeper, genesis)
+	})
+
+	suite.SetupTest()
+	suite.Require().NoError(suite.app.AppKeepers.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, amount))
+	suite.Require().NoError(suite.app.AppKeepers.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, minttypes.ModuleName, types.ModuleName, amount))
+	suite.Require().NotPanics(func() {
+		drip.InitGenesis(suite.ctx, suite.app.AppKeepers.DripKeeper, genesis)
+	})
+}
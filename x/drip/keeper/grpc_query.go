@@ -3,7 +3,12 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/CosmosContracts/juno/v26/x/drip/types"
 )
@@ -29,3 +34,141 @@ func (q Querier) Params(
 	params := q.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// DripSchedules returns the active drip schedules
+func (q Querier) DripSchedules(
+	c context.Context,
+	req *types.QueryDripSchedulesRequest,
+) (*types.QueryDripSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.DripScheduleKeyPrefix)
+
+	var schedules []types.DripSchedule
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var schedule types.DripSchedule
+		if err := q.cdc.Unmarshal(value, &schedule); err != nil {
+			return err
+		}
+		schedules = append(schedules, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDripSchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
+
+// DripSchedule returns an active drip schedule by id
+func (q Querier) DripSchedule(
+	c context.Context,
+	req *types.QueryDripScheduleRequest,
+) (*types.QueryDripScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	schedule, found := q.GetSchedule(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "drip schedule %d not found", req.Id)
+	}
+
+	return &types.QueryDripScheduleResponse{Schedule: schedule}, nil
+}
+
+// DripHistory returns the latest drips, optionally filtered by sender
+func (q Querier) DripHistory(
+	c context.Context,
+	req *types.QueryDripHistoryRequest,
+) (*types.QueryDripHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.SenderAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(req.SenderAddress); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sender address %s: %s", req.SenderAddress, err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.DripRecordKeyPrefix)
+
+	var records []types.DripRecord
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var record types.DripRecord
+		if err := q.cdc.Unmarshal(value, &record); err != nil {
+			return false, err
+		}
+
+		if req.SenderAddress != "" && record.SenderAddress != req.SenderAddress {
+			return false, nil
+		}
+
+		if accumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDripHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// SenderDripTotals returns the aggregated drips of all senders
+func (q Querier) SenderDripTotals(
+	c context.Context,
+	req *types.QuerySenderDripTotalsRequest,
+) (*types.QuerySenderDripTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.SenderTotalKeyPrefix)
+
+	var totals []types.SenderDripTotal
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var total types.SenderDripTotal
+		if err := q.cdc.Unmarshal(value, &total); err != nil {
+			return err
+		}
+		totals = append(totals, total)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySenderDripTotalsResponse{Totals: totals, Pagination: pageRes}, nil
+}
+
+// SenderDripTotal returns the aggregated drips of a sender
+func (q Querier) SenderDripTotal(
+	c context.Context,
+	req *types.QuerySenderDripTotalRequest,
+) (*types.QuerySenderDripTotalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.SenderAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender address %s: %s", req.SenderAddress, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	total, found := q.GetSenderTotal(ctx, sender)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no drip from %s", req.SenderAddress)
+	}
+
+	return &types.QuerySenderDripTotalResponse{Total: total}, nil
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/drip/types"
+)
+
+// GetNextRecordID returns the id of the next drip history record.
+func (k Keeper) GetNextRecordID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextRecordIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextRecordID sets the id of the next drip history record.
+func (k Keeper) SetNextRecordID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetRecord stores a drip history record.
+func (k Keeper) SetRecord(ctx sdk.Context, record types.DripRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DripRecordKeyPrefix)
+	store.Set(sdk.Uint64ToBigEndian(record.Id), k.cdc.MustMarshal(&record))
+}
+
+// DeleteRecord removes a drip history record.
+func (k Keeper) DeleteRecord(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DripRecordKeyPrefix)
+	store.Delete(sdk.Uint64ToBigEndian(id))
+}
+
+// GetAllRecords returns the drip history, oldest first.
+func (k Keeper) GetAllRecords(ctx sdk.Context) []types.DripRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DripRecordKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.DripRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.DripRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetSenderTotal returns the aggregated drips of a sender.
+func (k Keeper) GetSenderTotal(ctx sdk.Context, sender sdk.AccAddress) (types.SenderDripTotal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SenderTotalKeyPrefix)
+	bz := store.Get(sender)
+	if bz == nil {
+		return types.SenderDripTotal{}, false
+	}
+
+	var total types.SenderDripTotal
+	k.cdc.MustUnmarshal(bz, &total)
+	return total, true
+}
+
+// SetSenderTotal stores the aggregated drips of a sender.
+func (k Keeper) SetSenderTotal(ctx sdk.Context, total types.SenderDripTotal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SenderTotalKeyPrefix)
+	store.Set(sdk.MustAccAddressFromBech32(total.SenderAddress), k.cdc.MustMarshal(&total))
+}
+
+// GetAllSenderTotals returns the aggregated drips of all senders.
+func (k Keeper) GetAllSenderTotals(ctx sdk.Context) []types.SenderDripTotal {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SenderTotalKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	totals := []types.SenderDripTotal{}
+	for ; iterator.Valid(); iterator.Next() {
+		var total types.SenderDripTotal
+		k.cdc.MustUnmarshal(iterator.Value(), &total)
+		totals = append(totals, total)
+	}
+	return totals
+}
+
+// RecordDrip appends a drip to the history, pruning the oldest record once
+// the history is full, and adds it to the sender totals.
+func (k Keeper) RecordDrip(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins) {
+	if amount.IsZero() {
+		return
+	}
+
+	id := k.GetNextRecordID(ctx)
+	k.SetRecord(ctx, types.NewDripRecord(id, sender, amount, ctx.BlockHeight()))
+	k.SetNextRecordID(ctx, id+1)
+
+	if id > types.MaxDripHistory {
+		k.DeleteRecord(ctx, id-types.MaxDripHistory)
+	}
+
+	total, found := k.GetSenderTotal(ctx, sender)
+	if !found {
+		total = types.SenderDripTotal{SenderAddress: sender.String()}
+	}
+	total.Amount = total.Amount.Add(amount...)
+	total.Count++
+	k.SetSenderTotal(ctx, total)
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CosmosContracts/juno/v26/x/drip"
+	"github.com/CosmosContracts/juno/v26/x/drip/types"
+)
+
+func (s *IntegrationTestSuite) TestDripHistory() {
+	_, _, sender1 := testdata.KeyTestPubAddr()
+	_, _, sender2 := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender1, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+	_ = s.FundAccount(s.ctx, sender2, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.Params{
+		EnableDrip:       true,
+		AllowedAddresses: []string{sender1.String(), sender2.String()},
+	})
+
+	dripKeeper := s.app.AppKeepers.DripKeeper
+	_, err := dripKeeper.DistributeTokens(s.ctx, types.NewMsgDistributeTokens(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sender1))
+	s.Require().NoError(err)
+	_, err = dripKeeper.DistributeTokens(s.ctx, types.NewMsgDistributeTokens(sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), sender2))
+	s.Require().NoError(err)
+
+	// a drip schedule is recorded once completed
+	_, err = dripKeeper.CreateDripSchedule(s.ctx, types.NewMsgCreateDripSchedule(sdk.NewCoins(sdk.NewInt64Coin("stake", 300)), sender1, 0, 2))
+	s.Require().NoError(err)
+	drip.BeginBlocker(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+1), dripKeeper)
+	s.Require().Len(dripKeeper.GetAllRecords(s.ctx), 2)
+	drip.BeginBlocker(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+2), dripKeeper)
+
+	res, err := s.queryClient.DripHistory(s.ctx, &types.QueryDripHistoryRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.DripRecord{
+		types.NewDripRecord(1, sender1, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), s.ctx.BlockHeight()),
+		types.NewDripRecord(2, sender2, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), s.ctx.BlockHeight()),
+		types.NewDripRecord(3, sender1, sdk.NewCoins(sdk.NewInt64Coin("stake", 300)), s.ctx.BlockHeight()+2),
+	}, res.Records)
+
+	res, err = s.queryClient.DripHistory(s.ctx, &types.QueryDripHistoryRequest{
+		SenderAddress: sender1.String(),
+		Pagination:    &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Records, 1)
+	s.Require().Equal(uint64(1), res.Records[0].Id)
+	s.Require().Equal(uint64(2), res.Pagination.Total)
+
+	totalRes, err := s.queryClient.SenderDripTotal(s.ctx, &types.QuerySenderDripTotalRequest{SenderAddress: sender1.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(types.SenderDripTotal{
+		SenderAddress: sender1.String(),
+		Amount:        sdk.NewCoins(sdk.NewInt64Coin("stake", 400)),
+		Count:         2,
+	}, totalRes.Total)
+
+	totalsRes, err := s.queryClient.SenderDripTotals(s.ctx, &types.QuerySenderDripTotalsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(totalsRes.Totals, 2)
+
+	_, err = s.queryClient.SenderDripTotal(s.ctx, &types.QuerySenderDripTotalRequest{SenderAddress: sdk.AccAddress([]byte("unknown")).String()})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestDripHistoryPruning() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	dripKeeper := s.app.AppKeepers.DripKeeper
+
+	for i := 0; i < types.MaxDripHistory+5; i++ {
+		dripKeeper.RecordDrip(s.ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	}
+
+	records := dripKeeper.GetAllRecords(s.ctx)
+	s.Require().Len(records, types.MaxDripHistory)
+	s.Require().Equal(uint64(6), records[0].Id)
+	s.Require().Equal(uint64(types.MaxDripHistory+5), records[len(records)-1].Id)
+
+	// the totals keep the pruned records
+	total, found := dripKeeper.GetSenderTotal(s.ctx, sender)
+	s.Require().True(found)
+	s.Require().Equal(uint64(types.MaxDripHistory+5), total.Count)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", types.MaxDripHistory+5)), total.Amount)
+}
@@ -1,9 +1,14 @@
 package keeper
 
 import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	driptypes "github.com/CosmosContracts/juno/v26/x/drip/types"
 )
@@ -13,7 +18,9 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 	cdc      codec.BinaryCodec
 
-	bankKeeper driptypes.BankKeeper
+	bankKeeper    driptypes.BankKeeper
+	stakingKeeper driptypes.StakingKeeper
+	distrKeeper   driptypes.DistributionKeeper
 
 	feeCollectorName string
 	// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -26,6 +33,8 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	cdc codec.BinaryCodec,
 	bk driptypes.BankKeeper,
+	sk driptypes.StakingKeeper,
+	dk driptypes.DistributionKeeper,
 	feeCollector string,
 	authority string,
 ) Keeper {
@@ -33,6 +42,8 @@ func NewKeeper(
 		storeKey:         storeKey,
 		cdc:              cdc,
 		bankKeeper:       bk,
+		stakingKeeper:    sk,
+		distrKeeper:      dk,
 		feeCollectorName: feeCollector,
 		authority:        authority,
 	}
@@ -43,6 +54,16 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", driptypes.ModuleName))
+}
+
+// GetModuleBalance returns the balances held by the drip module account.
+func (k Keeper) GetModuleBalance(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(driptypes.ModuleName))
+}
+
 // SendCoinsFromAccountToFeeCollector transfers amt to the fee collector account, where it will be catch up by the distribution module at the next block
 func (k Keeper) SendCoinsFromAccountToFeeCollector(ctx sdk.Context, senderAddr sdk.AccAddress, amt sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, k.feeCollectorName, amt)
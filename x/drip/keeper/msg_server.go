@@ -6,6 +6,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/CosmosContracts/juno/v26/x/drip/types"
@@ -24,35 +25,187 @@ func (k Keeper) DistributeTokens(
 		return nil, err
 	}
 
-	params := k.GetParams(ctx)
-	if !params.EnableDrip {
-		return nil, types.ErrDripDisabled
+	// Get sender
+	sender, err := sdk.AccAddressFromBech32(msg.SenderAddress)
+	if err != nil {
+		return nil, err
 	}
 
-	// Check if sender is allowed
-	authorized := false
-	for _, addr := range params.AllowedAddresses {
-		if msg.SenderAddress == addr {
-			authorized = true
-			break
-		}
+	if err := k.authorize(ctx, sender, msg.Amount); err != nil {
+		return nil, err
 	}
 
-	if !authorized {
-		return nil, types.ErrDripNotAllowed
+	if err := k.SendCoinsFromAccountToFeeCollector(ctx, sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	k.RecordDrip(ctx, sender, msg.Amount)
+
+	return &types.MsgDistributeTokensResponse{}, nil
+}
+
+// CreateDripSchedule escrows tokens and distributes them to all stakers pro rata
+// over a number of blocks
+func (k Keeper) CreateDripSchedule(
+	goCtx context.Context,
+	msg *types.MsgCreateDripSchedule,
+) (*types.MsgCreateDripScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// The current block has already been dripped
+	startHeight := msg.StartHeight
+	if startHeight == 0 {
+		startHeight = ctx.BlockHeight() + 1
+	}
+	if startHeight <= ctx.BlockHeight() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSchedule, "start height %d must be after the current height %d", startHeight, ctx.BlockHeight())
 	}
 
-	// Get sender
 	sender, err := sdk.AccAddressFromBech32(msg.SenderAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := k.SendCoinsFromAccountToFeeCollector(ctx, sender, msg.Amount); err != nil {
+	if err := k.authorize(ctx, sender, msg.Amount); err != nil {
 		return nil, err
 	}
 
-	return &types.MsgDistributeTokensResponse{}, nil
+	if err := k.checkScheduleLimits(ctx, sender, msg.NumBlocks); err != nil {
+		return nil, err
+	}
+
+	schedule, err := k.CreateSchedule(ctx, sender, msg.Amount, startHeight, msg.NumBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDripScheduleResponse{Id: schedule.Id}, nil
+}
+
+// CancelDripSchedule cancels a drip schedule and refunds the remaining tokens to
+// its sender. It can be executed by the sender or the module authority.
+func (k Keeper) CancelDripSchedule(
+	goCtx context.Context,
+	msg *types.MsgCancelDripSchedule,
+) (*types.MsgCancelDripScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	schedule, found := k.GetSchedule(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrScheduleNotFound, "id %d", msg.Id)
+	}
+
+	if msg.SenderAddress != schedule.SenderAddress && msg.SenderAddress != k.authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of drip schedule %d", msg.SenderAddress, msg.Id)
+	}
+
+	refund, err := k.CancelSchedule(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelDripScheduleResponse{RefundedAmount: refund}, nil
+}
+
+// DistributeTokensToValidators distribute tokens to the delegators of a set of
+// validators, bypassing their commission
+func (k Keeper) DistributeTokensToValidators(
+	goCtx context.Context,
+	msg *types.MsgDistributeTokensToValidators,
+) (*types.MsgDistributeTokensToValidatorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.SenderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.authorize(ctx, sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	validators := make([]sdk.ValAddress, len(msg.ValidatorAddresses))
+	for i, val := range msg.ValidatorAddresses {
+		if validators[i], err = sdk.ValAddressFromBech32(val); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.DistributeToValidators(ctx, sender, msg.Amount, validators); err != nil {
+		return nil, err
+	}
+
+	k.RecordDrip(ctx, sender, msg.Amount)
+
+	return &types.MsgDistributeTokensToValidatorsResponse{}, nil
+}
+
+// authorize returns an error if the module is disabled or the sender is not
+// allowed to distribute the amount. Senders not listed in the allowed addresses
+// can use the module when it is permissionless, paying the permissionless fee to
+// the community pool.
+func (k Keeper) authorize(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins) error {
+	params := k.GetParams(ctx)
+	if !params.EnableDrip {
+		return types.ErrDripDisabled
+	}
+
+	if params.IsAllowedAddress(sender.String()) {
+		return nil
+	}
+
+	if !params.Permissionless {
+		return types.ErrDripNotAllowed
+	}
+
+	for _, coin := range amount {
+		if !params.IsPermissionlessDenom(coin.Denom) {
+			return errorsmod.Wrapf(types.ErrDenomNotAllowed, "denom: %s", coin.Denom)
+		}
+
+		if minAmount := params.PermissionlessMinAmount.AmountOf(coin.Denom); coin.Amount.LT(minAmount) {
+			return errorsmod.Wrapf(types.ErrAmountTooLow, "%s < %s%s", coin, minAmount, coin.Denom)
+		}
+	}
+
+	if params.PermissionlessFee.IsZero() {
+		return nil
+	}
+
+	return k.distrKeeper.FundCommunityPool(ctx, params.PermissionlessFee, sender)
+}
+
+// checkScheduleLimits returns an error if a sender not listed in the allowed
+// addresses would exceed the permissionless limits by creating a drip schedule
+// of numBlocks blocks. Every active schedule is read each block once started,
+// so the limits bound what permissionless senders can add to that work.
+func (k Keeper) checkScheduleLimits(ctx sdk.Context, sender sdk.AccAddress, numBlocks uint64) error {
+	params := k.GetParams(ctx)
+	if params.IsAllowedAddress(sender.String()) {
+		return nil
+	}
+
+	if numBlocks > params.PermissionlessMaxScheduleBlocks {
+		return errorsmod.Wrapf(types.ErrScheduleTooLong, "%d > %d blocks", numBlocks, params.PermissionlessMaxScheduleBlocks)
+	}
+
+	if count := k.GetSenderScheduleCount(ctx, sender); count >= params.PermissionlessMaxSchedules {
+		return errorsmod.Wrapf(types.ErrTooManySchedules, "%d active, maximum %d", count, params.PermissionlessMaxSchedules)
+	}
+
+	return nil
 }
 
 func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
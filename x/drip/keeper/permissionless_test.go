@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/drip/types"
+)
+
+func (s *IntegrationTestSuite) TestPermissionlessDistributeTokens() {
+	_, _, allowedSender := testdata.KeyTestPubAddr()
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, allowedSender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000)), sdk.NewCoin("utest", sdk.NewInt(1_000_000))))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000))
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.NewParams(
+		true,
+		[]string{allowedSender.String()},
+		true,
+		fee,
+		[]string{"stake"},
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		0,
+		0,
+	))
+
+	distrKeeper := s.app.AppKeepers.DistrKeeper
+	communityPool := func() sdk.DecCoins {
+		return distrKeeper.GetFeePool(s.ctx).CommunityPool
+	}
+	startPool := communityPool()
+
+	for _, tc := range []struct {
+		desc    string
+		msg     *types.MsgDistributeTokens
+		success bool
+	}{
+		{
+			desc:    "Fail - Denom not allowed",
+			msg:     types.NewMsgDistributeTokens(sdk.NewCoins(sdk.NewInt64Coin("utest", 100)), sender),
+			success: false,
+		},
+		{
+			desc:    "Fail - Amount lower than minimum",
+			msg:     types.NewMsgDistributeTokens(sdk.NewCoins(sdk.NewInt64Coin("stake", 99)), sender),
+			success: false,
+		},
+		{
+			desc:    "Success - Permissionless sender pays the fee",
+			msg:     types.NewMsgDistributeTokens(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sender),
+			success: true,
+		},
+		{
+			desc:    "Success - Allowed sender bypasses the permissionless restrictions",
+			msg:     types.NewMsgDistributeTokens(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), allowedSender),
+			success: true,
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			_, err := s.dripMsgServer.DistributeTokens(s.ctx, tc.msg)
+			if !tc.success {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	// only the permissionless distribution paid the fee
+	s.Require().Equal(startPool.Add(sdk.NewDecCoinsFromCoins(fee...)...), communityPool())
+	s.Require().Equal(sdk.NewInt(1_000_000-100-1_000), s.app.AppKeepers.BankKeeper.GetBalance(s.ctx, sender, "stake").Amount)
+	s.Require().Equal(sdk.NewInt(1_000_000-1), s.app.AppKeepers.BankKeeper.GetBalance(s.ctx, allowedSender, "stake").Amount)
+
+	// permissionless mode disabled
+	params := s.app.AppKeepers.DripKeeper.GetParams(s.ctx)
+	params.Permissionless = false
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, params)
+
+	_, err := s.dripMsgServer.DistributeTokens(s.ctx, types.NewMsgDistributeTokens(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sender))
+	s.Require().ErrorIs(err, types.ErrDripNotAllowed)
+}
+
+func (s *IntegrationTestSuite) TestPermissionlessScheduleLimits() {
+	_, _, allowedSender := testdata.KeyTestPubAddr()
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, allowedSender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.NewParams(
+		true,
+		[]string{allowedSender.String()},
+		true,
+		nil,
+		[]string{"stake"},
+		nil,
+		2,
+		100,
+	))
+
+	for _, tc := range []struct {
+		desc   string
+		msg    *types.MsgCreateDripSchedule
+		expErr error
+	}{
+		{
+			desc:   "Fail - Longer than the permissionless maximum",
+			msg:    types.NewMsgCreateDripSchedule(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sender, 0, 101),
+			expErr: types.ErrScheduleTooLong,
+		},
+		{
+			desc: "Success - First schedule",
+			msg:  types.NewMsgCreateDripSchedule(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sender, 0, 100),
+		},
+		{
+			desc: "Success - Second schedule",
+			msg:  types.NewMsgCreateDripSchedule(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sender, 0, 10),
+		},
+		{
+			desc:   "Fail - Too many active schedules",
+			msg:    types.NewMsgCreateDripSchedule(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sender, 0, 10),
+			expErr: types.ErrTooManySchedules,
+		},
+		{
+			desc: "Success - Allowed sender bypasses the limits",
+			msg:  types.NewMsgCreateDripSchedule(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), allowedSender, 0, 1_000),
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			_, err := s.dripMsgServer.CreateDripSchedule(s.ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	// cancelling a schedule frees a slot
+	_, err := s.dripMsgServer.CancelDripSchedule(s.ctx, types.NewMsgCancelDripSchedule(sender, 2))
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), s.app.AppKeepers.DripKeeper.GetSenderScheduleCount(s.ctx, sender))
+
+	_, err = s.dripMsgServer.CreateDripSchedule(s.ctx, types.NewMsgCreateDripSchedule(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sender, 0, 10))
+	s.Require().NoError(err)
+}
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/drip/types"
+)
+
+// GetNextScheduleID returns the id of the next drip schedule.
+func (k Keeper) GetNextScheduleID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextScheduleIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextScheduleID sets the id of the next drip schedule.
+func (k Keeper) SetNextScheduleID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextScheduleIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetSchedule returns the active drip schedule with the given id.
+func (k Keeper) GetSchedule(ctx sdk.Context, id uint64) (types.DripSchedule, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DripScheduleKeyPrefix)
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
+	if bz == nil {
+		return types.DripSchedule{}, false
+	}
+
+	var schedule types.DripSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, true
+}
+
+// SetSchedule stores an active drip schedule and indexes it by sender and start
+// height.
+func (k Keeper) SetSchedule(ctx sdk.Context, schedule types.DripSchedule) {
+	k.updateSchedule(ctx, schedule)
+
+	sender := sdk.MustAccAddressFromBech32(schedule.SenderAddress)
+	id := sdk.Uint64ToBigEndian(schedule.Id)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSenderSchedulePrefix(sender)).Set(id, []byte{})
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.GetStartHeightSchedulePrefix(schedule.StartHeight)).Set(id, []byte{})
+}
+
+// updateSchedule stores an already indexed drip schedule.
+func (k Keeper) updateSchedule(ctx sdk.Context, schedule types.DripSchedule) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DripScheduleKeyPrefix)
+	store.Set(sdk.Uint64ToBigEndian(schedule.Id), k.cdc.MustMarshal(&schedule))
+}
+
+// DeleteSchedule removes a drip schedule and its index entries.
+func (k Keeper) DeleteSchedule(ctx sdk.Context, schedule types.DripSchedule) {
+	id := sdk.Uint64ToBigEndian(schedule.Id)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.DripScheduleKeyPrefix).Delete(id)
+
+	sender := sdk.MustAccAddressFromBech32(schedule.SenderAddress)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSenderSchedulePrefix(sender)).Delete(id)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.GetStartHeightSchedulePrefix(schedule.StartHeight)).Delete(id)
+}
+
+// GetSenderScheduleCount returns the number of active drip schedules of a
+// sender.
+func (k Keeper) GetSenderScheduleCount(ctx sdk.Context, sender sdk.AccAddress) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSenderSchedulePrefix(sender))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// IterateStartedSchedules iterates over the active drip schedules starting at
+// or before the given height, by start height. Schedules starting later are not
+// read. The iteration stops when the handler returns true.
+func (k Keeper) IterateStartedSchedules(ctx sdk.Context, height int64, handler func(schedule types.DripSchedule) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StartHeightScheduleKeyPrefix)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(height)+1))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the start height followed by the schedule id
+		schedule, found := k.GetSchedule(ctx, sdk.BigEndianToUint64(iterator.Key()[8:]))
+		if !found {
+			continue
+		}
+
+		if handler(schedule) {
+			break
+		}
+	}
+}
+
+// IterateSchedules iterates over the active drip schedules by id. The iteration
+// stops when the handler returns true.
+func (k Keeper) IterateSchedules(ctx sdk.Context, handler func(schedule types.DripSchedule) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DripScheduleKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.DripSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+
+		if handler(schedule) {
+			break
+		}
+	}
+}
+
+// GetAllSchedules returns the active drip schedules.
+func (k Keeper) GetAllSchedules(ctx sdk.Context) []types.DripSchedule {
+	schedules := []types.DripSchedule{}
+	k.IterateSchedules(ctx, func(schedule types.DripSchedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
+	return schedules
+}
+
+// CreateSchedule escrows the amount in the module account and stores a new
+// drip schedule releasing it over a number of blocks.
+func (k Keeper) CreateSchedule(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins, startHeight int64, numBlocks uint64) (types.DripSchedule, error) {
+	id := k.GetNextScheduleID(ctx)
+	schedule := types.NewDripSchedule(id, sender, amount, startHeight, numBlocks)
+	if err := schedule.Validate(); err != nil {
+		return types.DripSchedule{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
+		return types.DripSchedule{}, err
+	}
+
+	k.SetSchedule(ctx, schedule)
+	k.SetNextScheduleID(ctx, id+1)
+
+	return schedule, nil
+}
+
+// CancelSchedule removes a drip schedule and refunds the amount it has not
+// released yet to its sender. The released amount is recorded in the history.
+func (k Keeper) CancelSchedule(ctx sdk.Context, schedule types.DripSchedule) (sdk.Coins, error) {
+	refund := schedule.RemainingAmount()
+	if !refund.IsZero() {
+		sender := sdk.MustAccAddressFromBech32(schedule.SenderAddress)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, refund); err != nil {
+			return nil, err
+		}
+	}
+
+	k.DeleteSchedule(ctx, schedule)
+	k.RecordDrip(ctx, sdk.MustAccAddressFromBech32(schedule.SenderAddress), schedule.ReleasedAmount)
+
+	return refund, nil
+}
+
+// ReleaseSchedules sends the slice of each active drip schedule due at the
+// current height to the fee collector, and removes the completed schedules after
+// recording them in the history. Each schedule is released in its own cache
+// context, so a schedule that fails to release is skipped for the block
+// without discarding the releases of the others.
+func (k Keeper) ReleaseSchedules(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+
+	var schedules []types.DripSchedule
+	k.IterateStartedSchedules(ctx, height, func(schedule types.DripSchedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
+
+	for _, schedule := range schedules {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.releaseSchedule(cacheCtx, schedule); err != nil {
+			k.Logger(ctx).Error("failed to release drip schedule", "id", schedule.Id, "error", err)
+			continue
+		}
+		write()
+	}
+}
+
+// releaseSchedule sends the slice of a drip schedule due at the current height
+// to the fee collector, and removes the schedule once it is complete.
+func (k Keeper) releaseSchedule(ctx sdk.Context, schedule types.DripSchedule) error {
+	height := ctx.BlockHeight()
+
+	release := schedule.ReleasableAmount(height)
+	if !release.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, release); err != nil {
+			return err
+		}
+		schedule.ReleasedAmount = schedule.ReleasedAmount.Add(release...)
+	}
+
+	if schedule.IsComplete(height) {
+		k.DeleteSchedule(ctx, schedule)
+		k.RecordDrip(ctx, sdk.MustAccAddressFromBech32(schedule.SenderAddress), schedule.ReleasedAmount)
+		return nil
+	}
+	k.updateSchedule(ctx, schedule)
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CosmosContracts/juno/v26/x/drip"
+	"github.com/CosmosContracts/juno/v26/x/drip/types"
+)
+
+func (s *IntegrationTestSuite) TestDripSchedules() {
+	_, _, allowedSender := testdata.KeyTestPubAddr()
+	_, _, notAllowedSender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, allowedSender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+	_ = s.FundAccount(s.ctx, notAllowedSender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.Params{
+		EnableDrip:       true,
+		AllowedAddresses: []string{allowedSender.String()},
+	})
+
+	dripKeeper := s.app.AppKeepers.DripKeeper
+	feeCollector := s.app.AppKeepers.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalance := func(ctx sdk.Context) sdk.Int {
+		return s.app.AppKeepers.BankKeeper.GetBalance(ctx, feeCollector, "stake").Amount
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		msg     *types.MsgCreateDripSchedule
+		success bool
+	}{
+		{
+			desc:    "Fail - Non Allowed sender",
+			msg:     types.NewMsgCreateDripSchedule(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), notAllowedSender, 0, 10),
+			success: false,
+		},
+		{
+			desc:    "Fail - Start height in the past",
+			msg:     types.NewMsgCreateDripSchedule(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), allowedSender, s.ctx.BlockHeight(), 10),
+			success: false,
+		},
+		{
+			desc:    "Fail - No blocks",
+			msg:     types.NewMsgCreateDripSchedule(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), allowedSender, 0, 0),
+			success: false,
+		},
+		{
+			desc:    "Fail - Insufficient funds",
+			msg:     types.NewMsgCreateDripSchedule(sdk.NewCoins(sdk.NewInt64Coin("stake", 10_000_000)), allowedSender, 0, 10),
+			success: false,
+		},
+		{
+			desc:    "Success - Allowed sender with proper funds",
+			msg:     types.NewMsgCreateDripSchedule(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), allowedSender, 0, 3),
+			success: true,
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			_, err := dripKeeper.CreateDripSchedule(s.ctx, tc.msg)
+			if !tc.success {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	// the schedule starts at the next block and releases 100 stake over 3 blocks
+	schedule, found := dripKeeper.GetSchedule(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(s.ctx.BlockHeight()+1, schedule.StartHeight)
+
+	res, err := s.queryClient.DripSchedules(s.ctx, &types.QueryDripSchedulesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.DripSchedule{schedule}, res.Schedules)
+
+	startBalance := feeCollectorBalance(s.ctx)
+	for i, expReleased := range []int64{0, 33, 66, 100, 100} {
+		ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + int64(i))
+		drip.BeginBlocker(ctx, dripKeeper)
+		s.Require().Equal(startBalance.AddRaw(expReleased), feeCollectorBalance(ctx), "block %d", i)
+	}
+
+	// the completed schedule is removed
+	_, found = dripKeeper.GetSchedule(s.ctx, 1)
+	s.Require().False(found)
+	_, err = s.queryClient.DripSchedule(s.ctx, &types.QueryDripScheduleRequest{Id: 1})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestCancelDripSchedule() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, other := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000))))
+
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.Params{
+		EnableDrip:       true,
+		AllowedAddresses: []string{sender.String()},
+	})
+
+	dripKeeper := s.app.AppKeepers.DripKeeper
+	res, err := dripKeeper.CreateDripSchedule(s.ctx, types.NewMsgCreateDripSchedule(sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000)), sender, 0, 10))
+	s.Require().NoError(err)
+
+	// release the first two blocks
+	drip.BeginBlocker(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+1), dripKeeper)
+	drip.BeginBlocker(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+2), dripKeeper)
+
+	_, err = dripKeeper.CancelDripSchedule(s.ctx, types.NewMsgCancelDripSchedule(other, res.Id))
+	s.Require().Error(err)
+
+	_, err = dripKeeper.CancelDripSchedule(s.ctx, types.NewMsgCancelDripSchedule(sender, res.Id+1))
+	s.Require().ErrorIs(err, types.ErrScheduleNotFound)
+
+	cancelRes, err := dripKeeper.CancelDripSchedule(s.ctx, types.NewMsgCancelDripSchedule(sender, res.Id))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 800)), cancelRes.RefundedAmount)
+	s.Require().Equal(sdk.NewInt(800), s.app.AppKeepers.BankKeeper.GetBalance(s.ctx, sender, "stake").Amount)
+
+	_, found := dripKeeper.GetSchedule(s.ctx, res.Id)
+	s.Require().False(found)
+}
+
+func (s *IntegrationTestSuite) TestReleaseSchedulesSkipsFailedSchedule() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))))
+
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.Params{
+		EnableDrip:       true,
+		AllowedAddresses: []string{sender.String()},
+	})
+
+	dripKeeper := s.app.AppKeepers.DripKeeper
+	res, err := dripKeeper.CreateDripSchedule(s.ctx, types.NewMsgCreateDripSchedule(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sender, 0, 2))
+	s.Require().NoError(err)
+
+	// a schedule the module account does not hold the funds for
+	unbacked := types.NewDripSchedule(res.Id+1, sender, sdk.NewCoins(sdk.NewInt64Coin("unbacked", 100)), s.ctx.BlockHeight()+1, 2)
+	dripKeeper.SetSchedule(s.ctx, unbacked)
+	dripKeeper.SetNextScheduleID(s.ctx, unbacked.Id+1)
+
+	ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	drip.BeginBlocker(ctx, dripKeeper)
+
+	// the backed schedule released its first slice
+	schedule, found := dripKeeper.GetSchedule(ctx, res.Id)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), schedule.ReleasedAmount)
+
+	// the unbacked schedule is left untouched
+	schedule, found = dripKeeper.GetSchedule(ctx, unbacked.Id)
+	s.Require().True(found)
+	s.Require().True(schedule.ReleasedAmount.IsZero())
+}
+
+func (s *IntegrationTestSuite) TestReleaseSchedulesSkipsFutureSchedules() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(200))))
+
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.Params{
+		EnableDrip:       true,
+		AllowedAddresses: []string{sender.String()},
+	})
+
+	dripKeeper := s.app.AppKeepers.DripKeeper
+	current, err := dripKeeper.CreateDripSchedule(s.ctx, types.NewMsgCreateDripSchedule(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sender, 0, 2))
+	s.Require().NoError(err)
+	future, err := dripKeeper.CreateDripSchedule(s.ctx, types.NewMsgCreateDripSchedule(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sender, s.ctx.BlockHeight()+10, 2))
+	s.Require().NoError(err)
+
+	var started []uint64
+	dripKeeper.IterateStartedSchedules(s.ctx, s.ctx.BlockHeight()+1, func(schedule types.DripSchedule) bool {
+		started = append(started, schedule.Id)
+		return false
+	})
+	s.Require().Equal([]uint64{current.Id}, started)
+
+	// the future schedule is not released before its start height
+	drip.BeginBlocker(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+1), dripKeeper)
+	schedule, found := dripKeeper.GetSchedule(s.ctx, future.Id)
+	s.Require().True(found)
+	s.Require().True(schedule.ReleasedAmount.IsZero())
+}
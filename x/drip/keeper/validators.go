@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/CosmosContracts/juno/v26/x/drip/types"
+)
+
+// DistributeToValidators transfers amt from the sender to the distribution
+// module and credits it to the delegators of the given validators, split pro
+// rata to their bonded tokens. Unlike the fee collector route, the validators
+// commission is not applied.
+func (k Keeper) DistributeToValidators(ctx sdk.Context, senderAddr sdk.AccAddress, amt sdk.Coins, valAddrs []sdk.ValAddress) error {
+	validators := make([]stakingtypes.Validator, len(valAddrs))
+	totalTokens := math.ZeroInt()
+	for i, valAddr := range valAddrs {
+		val, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return errorsmod.Wrapf(types.ErrInvalidValidator, "validator %s not found", valAddr)
+		}
+
+		// rewards of a validator without bonded tokens would not reach any delegator
+		if !val.IsBonded() || !val.GetTokens().IsPositive() {
+			return errorsmod.Wrapf(types.ErrInvalidValidator, "validator %s is not bonded", valAddr)
+		}
+
+		validators[i] = val
+		totalTokens = totalTokens.Add(val.GetTokens())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, distrtypes.ModuleName, amt); err != nil {
+		return err
+	}
+
+	// the last validator receives the remainder so the whole amount is credited
+	remaining := sdk.NewDecCoinsFromCoins(amt...)
+	for i, val := range validators {
+		share := remaining
+		if i < len(validators)-1 {
+			fraction := sdk.NewDecFromInt(val.GetTokens()).QuoTruncate(sdk.NewDecFromInt(totalTokens))
+			share = sdk.NewDecCoinsFromCoins(amt...).MulDecTruncate(fraction)
+		}
+
+		remaining = remaining.Sub(share)
+		k.allocateTokensToDelegators(ctx, val.GetOperator(), share)
+	}
+
+	return nil
+}
+
+// allocateTokensToDelegators credits tokens to the current rewards of a
+// validator delegators. It mirrors the x/distribution allocation without
+// taking the validator commission.
+func (k Keeper) allocateTokensToDelegators(ctx sdk.Context, valAddr sdk.ValAddress, tokens sdk.DecCoins) {
+	if tokens.IsZero() {
+		return
+	}
+
+	currentRewards := k.distrKeeper.GetValidatorCurrentRewards(ctx, valAddr)
+	currentRewards.Rewards = currentRewards.Rewards.Add(tokens...)
+	k.distrKeeper.SetValidatorCurrentRewards(ctx, valAddr, currentRewards)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			distrtypes.EventTypeRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
+			sdk.NewAttribute(distrtypes.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+
+	outstanding := k.distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr)
+	outstanding.Rewards = outstanding.Rewards.Add(tokens...)
+	k.distrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, outstanding)
+}
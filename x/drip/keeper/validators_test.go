@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/drip/types"
+)
+
+func (s *IntegrationTestSuite) TestDistributeTokensToValidators() {
+	_, _, allowedSender := testdata.KeyTestPubAddr()
+	_, _, notAllowedSender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, allowedSender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+	_ = s.FundAccount(s.ctx, notAllowedSender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.Params{
+		EnableDrip:       true,
+		AllowedAddresses: []string{allowedSender.String()},
+	})
+
+	validators := s.app.AppKeepers.StakingKeeper.GetBondedValidatorsByPower(s.ctx)
+	s.Require().NotEmpty(validators)
+	valAddr := validators[0].GetOperator()
+	unknownVal := sdk.ValAddress(allowedSender)
+
+	distrKeeper := s.app.AppKeepers.DistrKeeper
+	startRewards := distrKeeper.GetValidatorCurrentRewards(s.ctx, valAddr).Rewards
+	startOutstanding := distrKeeper.GetValidatorOutstandingRewards(s.ctx, valAddr).Rewards
+	startCommission := distrKeeper.GetValidatorAccumulatedCommission(s.ctx, valAddr).Commission
+
+	for _, tc := range []struct {
+		desc    string
+		msg     *types.MsgDistributeTokensToValidators
+		success bool
+	}{
+		{
+			desc:    "Fail - Non Allowed sender",
+			msg:     types.NewMsgDistributeTokensToValidators(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), notAllowedSender, []sdk.ValAddress{valAddr}),
+			success: false,
+		},
+		{
+			desc:    "Fail - No validators",
+			msg:     types.NewMsgDistributeTokensToValidators(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), allowedSender, nil),
+			success: false,
+		},
+		{
+			desc:    "Fail - Duplicate validators",
+			msg:     types.NewMsgDistributeTokensToValidators(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), allowedSender, []sdk.ValAddress{valAddr, valAddr}),
+			success: false,
+		},
+		{
+			desc:    "Fail - Unknown validator",
+			msg:     types.NewMsgDistributeTokensToValidators(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), allowedSender, []sdk.ValAddress{valAddr, unknownVal}),
+			success: false,
+		},
+		{
+			desc:    "Fail - Insufficient funds",
+			msg:     types.NewMsgDistributeTokensToValidators(sdk.NewCoins(sdk.NewInt64Coin("stake", 10_000_000)), allowedSender, []sdk.ValAddress{valAddr}),
+			success: false,
+		},
+		{
+			desc:    "Success - Allowed sender with proper funds",
+			msg:     types.NewMsgDistributeTokensToValidators(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), allowedSender, []sdk.ValAddress{valAddr}),
+			success: true,
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			_, err := s.dripMsgServer.DistributeTokensToValidators(s.ctx, tc.msg)
+			if !tc.success {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	// the whole amount goes to the delegators, none to the validator commission
+	expected := sdk.NewDecCoinsFromCoins(sdk.NewInt64Coin("stake", 100))
+	s.Require().Equal(startRewards.Add(expected...), distrKeeper.GetValidatorCurrentRewards(s.ctx, valAddr).Rewards)
+	s.Require().Equal(startOutstanding.Add(expected...), distrKeeper.GetValidatorOutstandingRewards(s.ctx, valAddr).Rewards)
+	s.Require().Equal(startCommission, distrKeeper.GetValidatorAccumulatedCommission(s.ctx, valAddr).Commission)
+	s.Require().Equal(sdk.NewInt(999_900), s.app.AppKeepers.BankKeeper.GetBalance(s.ctx, allowedSender, "stake").Amount)
+}
@@ -24,7 +24,10 @@ var (
 
 const (
 	// Amino names
-	distributeTokensName = "juno/MsgDistributeTokens" //nolint:gosec // these are not hard coded credentials
+	distributeTokensName             = "juno/MsgDistributeTokens" //nolint:gosec // these are not hard coded credentials
+	createDripScheduleName           = "juno/MsgCreateDripSchedule"
+	cancelDripScheduleName           = "juno/MsgCancelDripSchedule"
+	distributeTokensToValidatorsName = "juno/MsgDistributeTokensToValidators"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -44,6 +47,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgDistributeTokens{},
+		&MsgCreateDripSchedule{},
+		&MsgCancelDripSchedule{},
+		&MsgDistributeTokensToValidators{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -54,4 +60,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // Amino JSON serialization and EIP-712 compatibility.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDistributeTokens{}, distributeTokensName, nil)
+	cdc.RegisterConcrete(&MsgCreateDripSchedule{}, createDripScheduleName, nil)
+	cdc.RegisterConcrete(&MsgCancelDripSchedule{}, cancelDripScheduleName, nil)
+	cdc.RegisterConcrete(&MsgDistributeTokensToValidators{}, distributeTokensToValidatorsName, nil)
 }
@@ -23,9 +23,12 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 	RegisterInterfaces(registry)
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
-	suite.Require().Equal(2, len(impls))
+	suite.Require().Equal(5, len(impls))
 	suite.Require().ElementsMatch([]string{
 		"/juno.drip.v1.MsgDistributeTokens",
 		"/juno.drip.v1.MsgUpdateParams",
+		"/juno.drip.v1.MsgCreateDripSchedule",
+		"/juno.drip.v1.MsgCancelDripSchedule",
+		"/juno.drip.v1.MsgDistributeTokensToValidators",
 	}, impls)
 }
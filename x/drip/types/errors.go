@@ -5,9 +5,17 @@ import (
 )
 
 var (
-	ErrDripDisabled   = errorsmod.Register(ModuleName, 1, "drip module is disabled by governance")
-	ErrDripNotAllowed = errorsmod.Register(ModuleName, 2, "this address is not allowed to use the module, you can request access from governance")
-	ErrEmpty          = errorsmod.Register(ModuleName, 3, "empty")
-	ErrDuplicate      = errorsmod.Register(ModuleName, 4, "duplicate")
-	ErrBlank          = errorsmod.Register(ModuleName, 5, "address cannot be blank")
+	ErrDripDisabled     = errorsmod.Register(ModuleName, 1, "drip module is disabled by governance")
+	ErrDripNotAllowed   = errorsmod.Register(ModuleName, 2, "this address is not allowed to use the module, you can request access from governance")
+	ErrEmpty            = errorsmod.Register(ModuleName, 3, "empty")
+	ErrDuplicate        = errorsmod.Register(ModuleName, 4, "duplicate")
+	ErrBlank            = errorsmod.Register(ModuleName, 5, "address cannot be blank")
+	ErrScheduleNotFound = errorsmod.Register(ModuleName, 6, "drip schedule not found")
+	ErrInvalidSchedule  = errorsmod.Register(ModuleName, 7, "invalid drip schedule")
+	ErrInvalidValidator = errorsmod.Register(ModuleName, 8, "invalid drip target validator")
+	ErrDenomNotAllowed  = errorsmod.Register(ModuleName, 9, "denom is not allowed for permissionless distribution")
+	ErrAmountTooLow     = errorsmod.Register(ModuleName, 10, "amount is lower than the permissionless minimum")
+	ErrInvalidRecord    = errorsmod.Register(ModuleName, 11, "invalid drip history record")
+	ErrTooManySchedules = errorsmod.Register(ModuleName, 12, "too many active drip schedules for a permissionless sender")
+	ErrScheduleTooLong  = errorsmod.Register(ModuleName, 13, "drip schedule is longer than the permissionless maximum")
 )
@@ -2,12 +2,31 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+// StakingKeeper defines the expected interface needed to retrieve validators.
+type StakingKeeper interface {
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+}
+
+// DistributionKeeper defines the expected interface needed to credit rewards to
+// the delegators of a validator and to fund the community pool.
+type DistributionKeeper interface {
+	GetValidatorCurrentRewards(ctx sdk.Context, val sdk.ValAddress) (rewards distrtypes.ValidatorCurrentRewards)
+	SetValidatorCurrentRewards(ctx sdk.Context, val sdk.ValAddress, rewards distrtypes.ValidatorCurrentRewards)
+	GetValidatorOutstandingRewards(ctx sdk.Context, val sdk.ValAddress) (rewards distrtypes.ValidatorOutstandingRewards)
+	SetValidatorOutstandingRewards(ctx sdk.Context, val sdk.ValAddress, rewards distrtypes.ValidatorOutstandingRewards)
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
@@ -1,5 +1,9 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(params Params) GenesisState {
 	return GenesisState{
@@ -11,12 +15,70 @@ func NewGenesisState(params Params) GenesisState {
 // default params and chain config values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:         DefaultParams(),
+		Schedules:      []DripSchedule{},
+		NextScheduleId: 1,
+		History:        []DripRecord{},
+		NextRecordId:   1,
+		SenderTotals:   []SenderDripTotal{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	ids := make(map[uint64]bool, len(gs.Schedules))
+	for _, schedule := range gs.Schedules {
+		if ids[schedule.Id] {
+			return errorsmod.Wrapf(ErrDuplicate, "drip schedule id %d", schedule.Id)
+		}
+		ids[schedule.Id] = true
+
+		if schedule.Id >= gs.NextScheduleId {
+			return errorsmod.Wrapf(ErrInvalidSchedule, "schedule id %d must be lower than the next schedule id %d", schedule.Id, gs.NextScheduleId)
+		}
+
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if len(gs.History) > MaxDripHistory {
+		return errorsmod.Wrapf(ErrInvalidRecord, "history has %d records, more than the maximum %d", len(gs.History), MaxDripHistory)
+	}
+
+	recordIDs := make(map[uint64]bool, len(gs.History))
+	for _, record := range gs.History {
+		if recordIDs[record.Id] {
+			return errorsmod.Wrapf(ErrDuplicate, "drip record id %d", record.Id)
+		}
+		recordIDs[record.Id] = true
+
+		if record.Id >= gs.NextRecordId {
+			return errorsmod.Wrapf(ErrInvalidRecord, "record id %d must be lower than the next record id %d", record.Id, gs.NextRecordId)
+		}
+
+		// Only the latest records are kept, older ones would never be pruned
+		if gs.NextRecordId > MaxDripHistory && record.Id < gs.NextRecordId-MaxDripHistory {
+			return errorsmod.Wrapf(ErrInvalidRecord, "record id %d is older than the %d latest records before id %d", record.Id, MaxDripHistory, gs.NextRecordId)
+		}
+
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+
+	senders := make(map[string]bool, len(gs.SenderTotals))
+	for _, total := range gs.SenderTotals {
+		if senders[total.SenderAddress] {
+			return errorsmod.Wrapf(ErrDuplicate, "sender total %s", total.SenderAddress)
+		}
+		senders[total.SenderAddress] = true
+
+		if err := total.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return gs.Params.Validate()
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -27,6 +29,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params are the drip module parameters
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// schedules are the active drip schedules
+	Schedules []DripSchedule `protobuf:"bytes,2,rep,name=schedules,proto3" json:"schedules"`
+	// next_schedule_id is the id of the next drip schedule
+	NextScheduleId uint64 `protobuf:"varint,3,opt,name=next_schedule_id,json=nextScheduleId,proto3" json:"next_schedule_id,omitempty"`
+	// history is the bounded history of the latest drips
+	History []DripRecord `protobuf:"bytes,4,rep,name=history,proto3" json:"history"`
+	// next_record_id is the id of the next drip history record
+	NextRecordId uint64 `protobuf:"varint,5,opt,name=next_record_id,json=nextRecordId,proto3" json:"next_record_id,omitempty"`
+	// sender_totals are the aggregated drips of each sender
+	SenderTotals []SenderDripTotal `protobuf:"bytes,6,rep,name=sender_totals,json=senderTotals,proto3" json:"sender_totals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,12 +81,65 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetSchedules() []DripSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduleId() uint64 {
+	if m != nil {
+		return m.NextScheduleId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetHistory() []DripRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextRecordId() uint64 {
+	if m != nil {
+		return m.NextRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSenderTotals() []SenderDripTotal {
+	if m != nil {
+		return m.SenderTotals
+	}
+	return nil
+}
+
 // Params defines the drip module params
 type Params struct {
 	// enable_drip defines a parameter to enable the drip module
 	EnableDrip bool `protobuf:"varint,1,opt,name=enable_drip,json=enableDrip,proto3" json:"enable_drip,omitempty"`
 	// allowed_addresses defines the list of addresses authorized to use the module
 	AllowedAddresses []string `protobuf:"bytes,3,rep,name=allowed_addresses,json=allowedAddresses,proto3" json:"allowed_addresses,omitempty" yaml:"addresses"`
+	// permissionless defines a parameter to let addresses not listed in
+	// allowed_addresses use the module
+	Permissionless bool `protobuf:"varint,4,opt,name=permissionless,proto3" json:"permissionless,omitempty"`
+	// permissionless_fee is the fee paid to the community pool by addresses not
+	// listed in allowed_addresses for each distribution
+	PermissionlessFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=permissionless_fee,json=permissionlessFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"permissionless_fee"`
+	// permissionless_denoms is the list of denoms addresses not listed in
+	// allowed_addresses can distribute
+	PermissionlessDenoms []string `protobuf:"bytes,6,rep,name=permissionless_denoms,json=permissionlessDenoms,proto3" json:"permissionless_denoms,omitempty"`
+	// permissionless_min_amount is the minimum amount of each denom addresses
+	// not listed in allowed_addresses can distribute
+	PermissionlessMinAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=permissionless_min_amount,json=permissionlessMinAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"permissionless_min_amount"`
+	// permissionless_max_schedules is the maximum number of active drip
+	// schedules an address not listed in allowed_addresses can have
+	PermissionlessMaxSchedules uint64 `protobuf:"varint,8,opt,name=permissionless_max_schedules,json=permissionlessMaxSchedules,proto3" json:"permissionless_max_schedules,omitempty"`
+	// permissionless_max_schedule_blocks is the maximum number of blocks a drip
+	// schedule created by an address not listed in allowed_addresses can last
+	PermissionlessMaxScheduleBlocks uint64 `protobuf:"varint,9,opt,name=permissionless_max_schedule_blocks,json=permissionlessMaxScheduleBlocks,proto3" json:"permissionless_max_schedule_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -124,6 +189,48 @@ func (m *Params) GetAllowedAddresses() []string {
 	return nil
 }
 
+func (m *Params) GetPermissionless() bool {
+	if m != nil {
+		return m.Permissionless
+	}
+	return false
+}
+
+func (m *Params) GetPermissionlessFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PermissionlessFee
+	}
+	return nil
+}
+
+func (m *Params) GetPermissionlessDenoms() []string {
+	if m != nil {
+		return m.PermissionlessDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetPermissionlessMinAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PermissionlessMinAmount
+	}
+	return nil
+}
+
+func (m *Params) GetPermissionlessMaxSchedules() uint64 {
+	if m != nil {
+		return m.PermissionlessMaxSchedules
+	}
+	return 0
+}
+
+func (m *Params) GetPermissionlessMaxScheduleBlocks() uint64 {
+	if m != nil {
+		return m.PermissionlessMaxScheduleBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "juno.drip.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "juno.drip.v1.Params")
@@ -132,24 +239,45 @@ func init() {
 func init() { proto.RegisterFile("juno/drip/v1/genesis.proto", fileDescriptor_a281ae9bcc19c501) }
 
 var fileDescriptor_a281ae9bcc19c501 = []byte{
-	// 272 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0x2a, 0xcd, 0xcb,
-	0xd7, 0x4f, 0x29, 0xca, 0x2c, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x01, 0xc9, 0xe9, 0x81, 0xe4, 0xf4, 0xca, 0x0c,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x12, 0xfa, 0x20, 0x16, 0x44, 0x8d, 0x92, 0x13, 0x17,
-	0x8f, 0x3b, 0x44, 0x53, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x11, 0x17, 0x5b, 0x41, 0x62, 0x51,
-	0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x88, 0x1e, 0xb2, 0x21, 0x7a, 0x01,
-	0x60, 0x39, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x2a, 0x95, 0x72, 0xb8, 0xd8, 0x20,
-	0xe2, 0x42, 0xf2, 0x5c, 0xdc, 0xa9, 0x79, 0x89, 0x49, 0x39, 0xa9, 0xf1, 0x20, 0x0d, 0x60, 0x23,
-	0x38, 0x82, 0xb8, 0x20, 0x42, 0x2e, 0x45, 0x99, 0x05, 0x42, 0x8e, 0x5c, 0x82, 0x89, 0x39, 0x39,
-	0xf9, 0xe5, 0xa9, 0x29, 0xf1, 0x89, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xa9, 0xc5, 0x12, 0xcc,
-	0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x22, 0x9f, 0xee, 0xc9, 0x0b, 0x54, 0x26, 0xe6, 0xe6, 0x58, 0x29,
-	0xc1, 0xa5, 0x94, 0x82, 0x04, 0xa0, 0xca, 0x1d, 0x61, 0x42, 0x4e, 0x6e, 0x27, 0x1e, 0xc9, 0x31,
-	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
-	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x93, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c,
-	0x9f, 0xab, 0xef, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0xec, 0x9c, 0x9f, 0x57, 0x52, 0x94, 0x98, 0x5c,
-	0x52, 0xac, 0x0f, 0x0e, 0xa6, 0x0a, 0x48, 0x40, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81,
-	0x03, 0xc0, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x9c, 0xad, 0xd3, 0x42, 0x01, 0x00, 0x00,
+	// 599 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc6, 0xe3, 0x26, 0x4d, 0x9b, 0x6d, 0xfe, 0x55, 0xbb, 0xca, 0x5f, 0x75, 0x23, 0x70, 0xa2,
+	0x08, 0xa1, 0x1c, 0xc0, 0x26, 0xed, 0x05, 0x71, 0x40, 0x34, 0xad, 0x0a, 0x15, 0x42, 0x42, 0x0e,
+	0x27, 0x2e, 0xd6, 0xc6, 0x1e, 0x52, 0x53, 0x7b, 0x37, 0xf2, 0x6c, 0x4a, 0xca, 0x0b, 0x70, 0xe5,
+	0x39, 0x78, 0x92, 0x1e, 0x7b, 0x42, 0x9c, 0x0a, 0x6a, 0xde, 0x80, 0x27, 0x40, 0x1e, 0x3b, 0x6d,
+	0x1d, 0x04, 0x27, 0x4e, 0xb6, 0xe6, 0x9b, 0xef, 0xfb, 0xed, 0xac, 0x46, 0xcb, 0x9a, 0xef, 0x27,
+	0x52, 0x39, 0x41, 0x12, 0x8e, 0x9d, 0xd3, 0x9e, 0x33, 0x02, 0x09, 0x18, 0xa2, 0x3d, 0x4e, 0x94,
+	0x56, 0xbc, 0x9e, 0x6a, 0x76, 0xaa, 0xd9, 0xa7, 0xbd, 0x66, 0x63, 0xa4, 0x46, 0x8a, 0x04, 0x27,
+	0xfd, 0xcb, 0x7a, 0x9a, 0x96, 0xaf, 0x30, 0x56, 0xe8, 0x0c, 0x05, 0x82, 0x73, 0xda, 0x1b, 0x82,
+	0x16, 0x3d, 0xc7, 0x57, 0xa1, 0xcc, 0xf5, 0xad, 0x42, 0x3e, 0x65, 0x91, 0xd0, 0xf9, 0xba, 0xc4,
+	0xea, 0xcf, 0x33, 0xdc, 0x40, 0x0b, 0x0d, 0x7c, 0x87, 0x55, 0xc7, 0x22, 0x11, 0x31, 0x9a, 0x46,
+	0xdb, 0xe8, 0xae, 0xed, 0x34, 0xec, 0xdb, 0x78, 0xfb, 0x35, 0x69, 0xfd, 0xca, 0xf9, 0x65, 0xab,
+	0xe4, 0xe6, 0x9d, 0xfc, 0x29, 0xab, 0xa1, 0x7f, 0x0c, 0xc1, 0x24, 0x02, 0x34, 0x97, 0xda, 0xe5,
+	0xee, 0xda, 0x4e, 0xb3, 0x68, 0x3b, 0x48, 0xc2, 0xf1, 0x20, 0x6f, 0xc9, 0xcd, 0x37, 0x16, 0xde,
+	0x65, 0x1b, 0x12, 0xa6, 0xda, 0x9b, 0x57, 0xbc, 0x30, 0x30, 0xcb, 0x6d, 0xa3, 0x5b, 0x71, 0xd7,
+	0xd3, 0xfa, 0xdc, 0x78, 0x14, 0xf0, 0xc7, 0x6c, 0xe5, 0x38, 0x44, 0xad, 0x92, 0x33, 0xb3, 0x42,
+	0x1c, 0xf3, 0x77, 0x8e, 0x0b, 0xbe, 0x4a, 0x82, 0x9c, 0x32, 0x6f, 0xe7, 0xf7, 0x18, 0x65, 0x79,
+	0x09, 0xa9, 0x29, 0x61, 0x99, 0x08, 0xf5, 0xb4, 0x9a, 0x59, 0x8e, 0x02, 0xfe, 0x82, 0xfd, 0x87,
+	0x20, 0x03, 0x48, 0x3c, 0xad, 0xb4, 0x88, 0xd0, 0xac, 0x12, 0xe5, 0x6e, 0x91, 0x32, 0xa0, 0x96,
+	0x94, 0xf5, 0x26, 0xed, 0xca, 0x51, 0xf5, 0xcc, 0x49, 0x25, 0xec, 0x5c, 0x56, 0x58, 0x35, 0xbb,
+	0x2c, 0xde, 0x62, 0x6b, 0x20, 0xc5, 0x30, 0x02, 0x2f, 0x0d, 0xa0, 0x7b, 0x5d, 0x75, 0x59, 0x56,
+	0x4a, 0x43, 0xf8, 0x1e, 0xdb, 0x14, 0x51, 0xa4, 0x3e, 0x40, 0xe0, 0x89, 0x20, 0x48, 0x00, 0x11,
+	0xd0, 0x2c, 0xb7, 0xcb, 0xdd, 0x5a, 0xbf, 0xf1, 0xf3, 0xb2, 0xb5, 0x71, 0x26, 0xe2, 0xe8, 0x49,
+	0xe7, 0x5a, 0xea, 0xb8, 0x1b, 0x79, 0xfb, 0xde, 0xbc, 0xc4, 0xef, 0xb3, 0xf5, 0x31, 0x24, 0x71,
+	0x88, 0x18, 0x2a, 0x19, 0x01, 0xa2, 0x59, 0x21, 0xcc, 0x42, 0x95, 0x7f, 0x64, 0xbc, 0x58, 0xf1,
+	0xde, 0x01, 0x98, 0xcb, 0x34, 0xe5, 0xb6, 0x9d, 0x6d, 0x91, 0x9d, 0x6e, 0x91, 0x9d, 0x6f, 0x91,
+	0xbd, 0xaf, 0x42, 0xd9, 0x7f, 0x94, 0x4e, 0xf8, 0xe5, 0x7b, 0xab, 0x3b, 0x0a, 0xf5, 0xf1, 0x64,
+	0x68, 0xfb, 0x2a, 0x76, 0xf2, 0x95, 0xcb, 0x3e, 0x0f, 0x31, 0x38, 0x71, 0xf4, 0xd9, 0x18, 0x90,
+	0x0c, 0xe8, 0x6e, 0x16, 0x31, 0x87, 0x00, 0x7c, 0x97, 0xfd, 0xbf, 0xc0, 0x0e, 0x40, 0xaa, 0x38,
+	0xbb, 0xe4, 0x9a, 0xdb, 0x28, 0x8a, 0x07, 0xa4, 0xf1, 0x4f, 0x06, 0xdb, 0x5e, 0x70, 0xc5, 0xa1,
+	0xf4, 0x44, 0xac, 0x26, 0x52, 0x9b, 0x2b, 0xff, 0xfe, 0xe0, 0x5b, 0x45, 0xda, 0xab, 0x50, 0xee,
+	0x11, 0x8b, 0x3f, 0x63, 0x77, 0x16, 0x0f, 0x22, 0xa6, 0xde, 0xcd, 0xe2, 0xaf, 0xd2, 0x3e, 0x35,
+	0x17, 0xec, 0x62, 0x3a, 0xb8, 0xde, 0xf3, 0x97, 0xac, 0xf3, 0x97, 0x04, 0x6f, 0x18, 0x29, 0xff,
+	0x04, 0xcd, 0x1a, 0xe5, 0xb4, 0xfe, 0x98, 0xd3, 0xa7, 0xb6, 0xfe, 0xe1, 0xf9, 0x95, 0x65, 0x5c,
+	0x5c, 0x59, 0xc6, 0x8f, 0x2b, 0xcb, 0xf8, 0x3c, 0xb3, 0x4a, 0x17, 0x33, 0xab, 0xf4, 0x6d, 0x66,
+	0x95, 0xde, 0x3e, 0xb8, 0x35, 0xeb, 0x3e, 0x0d, 0xb9, 0xaf, 0xa4, 0x4e, 0x84, 0xaf, 0xd1, 0xa1,
+	0x77, 0x60, 0x9a, 0xbd, 0x04, 0x34, 0xf5, 0xb0, 0x4a, 0x0f, 0xc1, 0xee, 0xaf, 0x01, 0x00, 0xa9,
+	0x29, 0x99, 0x24, 0x83, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -172,6 +300,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SenderTotals) > 0 {
+		for iNdEx := len(m.SenderTotals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SenderTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NextRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRecordId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NextScheduleId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduleId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -205,6 +385,63 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PermissionlessMaxScheduleBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PermissionlessMaxScheduleBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PermissionlessMaxSchedules != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PermissionlessMaxSchedules))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PermissionlessMinAmount) > 0 {
+		for iNdEx := len(m.PermissionlessMinAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PermissionlessMinAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PermissionlessDenoms) > 0 {
+		for iNdEx := len(m.PermissionlessDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PermissionlessDenoms[iNdEx])
+			copy(dAtA[i:], m.PermissionlessDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PermissionlessDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PermissionlessFee) > 0 {
+		for iNdEx := len(m.PermissionlessFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PermissionlessFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Permissionless {
+		i--
+		if m.Permissionless {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.AllowedAddresses) > 0 {
 		for iNdEx := len(m.AllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedAddresses[iNdEx])
@@ -246,6 +483,30 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduleId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduleId))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextRecordId))
+	}
+	if len(m.SenderTotals) > 0 {
+		for _, e := range m.SenderTotals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -264,6 +525,33 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.Permissionless {
+		n += 2
+	}
+	if len(m.PermissionlessFee) > 0 {
+		for _, e := range m.PermissionlessFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PermissionlessDenoms) > 0 {
+		for _, s := range m.PermissionlessDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PermissionlessMinAmount) > 0 {
+		for _, e := range m.PermissionlessMinAmount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PermissionlessMaxSchedules != 0 {
+		n += 1 + sovGenesis(uint64(m.PermissionlessMaxSchedules))
+	}
+	if m.PermissionlessMaxScheduleBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.PermissionlessMaxScheduleBlocks))
+	}
 	return n
 }
 
@@ -335,6 +623,146 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, DripSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduleId", wireType)
+			}
+			m.NextScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, DripRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRecordId", wireType)
+			}
+			m.NextRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderTotals = append(m.SenderTotals, SenderDripTotal{})
+			if err := m.SenderTotals[len(m.SenderTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -437,6 +865,164 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedAddresses = append(m.AllowedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissionless", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Permissionless = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PermissionlessFee = append(m.PermissionlessFee, types.Coin{})
+			if err := m.PermissionlessFee[len(m.PermissionlessFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PermissionlessDenoms = append(m.PermissionlessDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessMinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PermissionlessMinAmount = append(m.PermissionlessMinAmount, types.Coin{})
+			if err := m.PermissionlessMinAmount[len(m.PermissionlessMinAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessMaxSchedules", wireType)
+			}
+			m.PermissionlessMaxSchedules = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PermissionlessMaxSchedules |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessMaxScheduleBlocks", wireType)
+			}
+			m.PermissionlessMaxScheduleBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PermissionlessMaxScheduleBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,55 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxDripHistory is the number of drip records kept in the history. Older
+// records are pruned, the sender totals keep aggregating them.
+const MaxDripHistory = 1000
+
+// NewDripRecord returns a new drip history record.
+func NewDripRecord(id uint64, sender sdk.AccAddress, amount sdk.Coins, height int64) DripRecord {
+	return DripRecord{
+		Id:            id,
+		SenderAddress: sender.String(),
+		Amount:        amount,
+		Height:        height,
+	}
+}
+
+// Validate performs a stateless validation of the record.
+func (r DripRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.SenderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid drip record %d sender address", r.Id)
+	}
+
+	if r.Amount.Empty() || !r.Amount.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidRecord, "drip record %d amount: %s", r.Id, r.Amount)
+	}
+
+	if r.Height < 0 {
+		return errorsmod.Wrapf(ErrInvalidRecord, "drip record %d height cannot be negative: %d", r.Id, r.Height)
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the sender total.
+func (t SenderDripTotal) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(t.SenderAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid sender total address")
+	}
+
+	if t.Amount.Empty() || !t.Amount.IsValid() {
+		return errorsmod.Wrapf(ErrEmpty, "invalid sender total amount for %s: %s", t.SenderAddress, t.Amount)
+	}
+
+	if t.Count == 0 {
+		return errorsmod.Wrapf(ErrEmpty, "sender total count for %s", t.SenderAddress)
+	}
+
+	return nil
+}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// module name
 	ModuleName = "drip"
@@ -13,5 +18,25 @@ const (
 
 // KVStore key prefixes
 var (
-	ParamsKey = []byte{0x00} // Prefix for params key
+	ParamsKey             = []byte{0x00} // Prefix for params key
+	DripScheduleKeyPrefix = []byte{0x01} // Prefix for drip schedules
+	NextScheduleIDKey     = []byte{0x02} // Key for the next drip schedule id
+	DripRecordKeyPrefix   = []byte{0x03} // Prefix for the drip history records
+	NextRecordIDKey       = []byte{0x04} // Key for the next drip history record id
+	SenderTotalKeyPrefix  = []byte{0x05} // Prefix for the aggregated drips of each sender
+
+	SenderScheduleKeyPrefix      = []byte{0x06} // Prefix for the index of drip schedules by sender
+	StartHeightScheduleKeyPrefix = []byte{0x07} // Prefix for the index of drip schedules by start height
 )
+
+// GetSenderSchedulePrefix returns the prefix of the drip schedules of a sender
+// in the sender index.
+func GetSenderSchedulePrefix(sender sdk.AccAddress) []byte {
+	return append(append([]byte{}, SenderScheduleKeyPrefix...), address.MustLengthPrefix(sender)...)
+}
+
+// GetStartHeightSchedulePrefix returns the prefix of the drip schedules
+// starting at a height in the start height index.
+func GetStartHeightSchedulePrefix(height int64) []byte {
+	return append(append([]byte{}, StartHeightScheduleKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
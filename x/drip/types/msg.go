@@ -11,10 +11,16 @@ import (
 var (
 	_ sdk.Msg = &MsgDistributeTokens{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgCreateDripSchedule{}
+	_ sdk.Msg = &MsgCancelDripSchedule{}
+	_ sdk.Msg = &MsgDistributeTokensToValidators{}
 )
 
 const (
-	TypeMsgDistributeTokens = "distribute_tokens"
+	TypeMsgDistributeTokens             = "distribute_tokens"
+	TypeMsgCreateDripSchedule           = "create_drip_schedule"
+	TypeMsgCancelDripSchedule           = "cancel_drip_schedule"
+	TypeMsgDistributeTokensToValidators = "distribute_tokens_to_validators"
 )
 
 // NewMsgDistributeTokens creates new instance of MsgDistributeTokens
@@ -87,3 +93,156 @@ func (msg *MsgUpdateParams) ValidateBasic() error {
 
 	return err
 }
+
+// NewMsgCreateDripSchedule creates new instance of MsgCreateDripSchedule
+func NewMsgCreateDripSchedule(
+	amount sdk.Coins,
+	sender sdk.Address,
+	startHeight int64,
+	numBlocks uint64,
+) *MsgCreateDripSchedule {
+	return &MsgCreateDripSchedule{
+		SenderAddress: sender.String(),
+		Amount:        amount,
+		StartHeight:   startHeight,
+		NumBlocks:     numBlocks,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgCreateDripSchedule) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgCreateDripSchedule) Type() string { return TypeMsgCreateDripSchedule }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateDripSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.SenderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address: %s", err.Error())
+	}
+
+	if msg.Amount.Empty() || !msg.Amount.IsValid() {
+		return fmt.Errorf("invalid coins: %s", msg.Amount.String())
+	}
+
+	if msg.StartHeight < 0 {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "start height cannot be negative: %d", msg.StartHeight)
+	}
+
+	if msg.NumBlocks == 0 {
+		return errorsmod.Wrap(ErrInvalidSchedule, "number of blocks must be positive")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCreateDripSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateDripSchedule) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.SenderAddress)
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgCancelDripSchedule creates new instance of MsgCancelDripSchedule
+func NewMsgCancelDripSchedule(sender sdk.Address, id uint64) *MsgCancelDripSchedule {
+	return &MsgCancelDripSchedule{
+		SenderAddress: sender.String(),
+		Id:            id,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgCancelDripSchedule) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgCancelDripSchedule) Type() string { return TypeMsgCancelDripSchedule }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelDripSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.SenderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address: %s", err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCancelDripSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelDripSchedule) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.SenderAddress)
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgDistributeTokensToValidators creates new instance of MsgDistributeTokensToValidators
+func NewMsgDistributeTokensToValidators(
+	amount sdk.Coins,
+	sender sdk.Address,
+	validators []sdk.ValAddress,
+) *MsgDistributeTokensToValidators {
+	validatorAddresses := make([]string, len(validators))
+	for i, val := range validators {
+		validatorAddresses[i] = val.String()
+	}
+
+	return &MsgDistributeTokensToValidators{
+		SenderAddress:      sender.String(),
+		Amount:             amount,
+		ValidatorAddresses: validatorAddresses,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgDistributeTokensToValidators) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgDistributeTokensToValidators) Type() string {
+	return TypeMsgDistributeTokensToValidators
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDistributeTokensToValidators) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.SenderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address: %s", err.Error())
+	}
+
+	if msg.Amount.Empty() || !msg.Amount.IsValid() {
+		return fmt.Errorf("invalid coins: %s", msg.Amount.String())
+	}
+
+	if len(msg.ValidatorAddresses) == 0 {
+		return errorsmod.Wrap(ErrEmpty, "validator addresses")
+	}
+
+	seen := make(map[string]bool, len(msg.ValidatorAddresses))
+	for _, val := range msg.ValidatorAddresses {
+		if _, err := sdk.ValAddressFromBech32(val); err != nil {
+			return errorsmod.Wrapf(ErrInvalidValidator, "invalid validator address %s: %s", val, err.Error())
+		}
+
+		if seen[val] {
+			return errorsmod.Wrapf(ErrDuplicate, "validator address %s", val)
+		}
+		seen[val] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgDistributeTokensToValidators) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDistributeTokensToValidators) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.SenderAddress)
+	return []sdk.AccAddress{from}
+}
@@ -79,3 +79,62 @@ func (suite *MsgsTestSuite) TestMsgDistributeTokensNew() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgDistributeTokensToValidatorsNew() {
+	validator := sdk.ValAddress(suite.sender).String()
+
+	testCases := []struct {
+		msg        string
+		amount     sdk.Coins
+		validators []string
+		expectPass bool
+	}{
+		{
+			"pass",
+			suite.amount,
+			[]string{validator},
+			true,
+		},
+		{
+			"invalid coins",
+			nil,
+			[]string{validator},
+			false,
+		},
+		{
+			"validator addresses: empty",
+			suite.amount,
+			nil,
+			false,
+		},
+		{
+			"invalid validator address",
+			suite.amount,
+			[]string{suite.sender.String()},
+			false,
+		},
+		{
+			"duplicate",
+			suite.amount,
+			[]string{validator, validator},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgDistributeTokensToValidators{
+			SenderAddress:      suite.sender.String(),
+			Amount:             tc.amount,
+			ValidatorAddresses: tc.validators,
+		}
+
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+			suite.Require().Contains(err.Error(), tc.msg)
+		}
+	}
+}
@@ -11,16 +11,33 @@ import (
 var (
 	DefaultEnableDrip       = true
 	DefaultAllowedAddresses = []string(nil) // no one allowed
+	DefaultPermissionless   = false
+
+	DefaultPermissionlessMaxSchedules      = uint64(5)
+	DefaultPermissionlessMaxScheduleBlocks = uint64(432_000) // ~30 days with 6s blocks
 )
 
 // NewParams creates a new Params object
 func NewParams(
 	enableDrip bool,
 	allowedAddresses []string,
+	permissionless bool,
+	permissionlessFee sdk.Coins,
+	permissionlessDenoms []string,
+	permissionlessMinAmount sdk.Coins,
+	permissionlessMaxSchedules uint64,
+	permissionlessMaxScheduleBlocks uint64,
 ) Params {
 	return Params{
-		EnableDrip:       enableDrip,
-		AllowedAddresses: allowedAddresses,
+		EnableDrip:              enableDrip,
+		AllowedAddresses:        allowedAddresses,
+		Permissionless:          permissionless,
+		PermissionlessFee:       permissionlessFee,
+		PermissionlessDenoms:    permissionlessDenoms,
+		PermissionlessMinAmount: permissionlessMinAmount,
+
+		PermissionlessMaxSchedules:      permissionlessMaxSchedules,
+		PermissionlessMaxScheduleBlocks: permissionlessMaxScheduleBlocks,
 	}
 }
 
@@ -29,6 +46,10 @@ func DefaultParams() Params {
 	return Params{
 		EnableDrip:       DefaultEnableDrip,
 		AllowedAddresses: DefaultAllowedAddresses,
+		Permissionless:   DefaultPermissionless,
+
+		PermissionlessMaxSchedules:      DefaultPermissionlessMaxSchedules,
+		PermissionlessMaxScheduleBlocks: DefaultPermissionlessMaxScheduleBlocks,
 	}
 }
 
@@ -59,7 +80,42 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return assertValidAddresses(p.AllowedAddresses)
+	if err := assertValidAddresses(p.AllowedAddresses); err != nil {
+		return err
+	}
+
+	if err := p.PermissionlessFee.Validate(); err != nil {
+		return errorsmod.Wrap(err, "permissionless fee")
+	}
+
+	if err := p.PermissionlessMinAmount.Validate(); err != nil {
+		return errorsmod.Wrap(err, "permissionless min amount")
+	}
+
+	return assertValidDenoms(p.PermissionlessDenoms)
+}
+
+// IsAllowedAddress returns true if the address is listed in AllowedAddresses
+func (p Params) IsAllowedAddress(addr string) bool {
+	for _, a := range p.AllowedAddresses {
+		if a == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsPermissionlessDenom returns true if addresses not listed in
+// AllowedAddresses can distribute the denom
+func (p Params) IsPermissionlessDenom(denom string) bool {
+	for _, d := range p.PermissionlessDenoms {
+		if d == denom {
+			return true
+		}
+	}
+
+	return false
 }
 
 func assertValidAddresses(addrs []string) error {
@@ -78,3 +134,17 @@ func assertValidAddresses(addrs []string) error {
 	}
 	return nil
 }
+
+func assertValidDenoms(denoms []string) error {
+	idx := make(map[string]struct{}, len(denoms))
+	for _, d := range denoms {
+		if err := sdk.ValidateDenom(d); err != nil {
+			return errorsmod.Wrapf(err, "denom: %s", d)
+		}
+		if _, exists := idx[d]; exists {
+			return ErrDuplicate.Wrapf("denom: %s", d)
+		}
+		idx[d] = struct{}{}
+	}
+	return nil
+}
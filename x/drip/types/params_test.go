@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParamsValidate(t *testing.T) {
@@ -15,12 +17,37 @@ func TestParamsValidate(t *testing.T) {
 		{"default", DefaultParams(), false},
 		{
 			"valid: disabled, no one allowed",
-			NewParams(false, []string(nil)),
+			NewParams(false, []string(nil), false, nil, nil, nil, 0, 0),
 			false,
 		},
 		{
 			"invalid: enabled, address malformed",
-			NewParams(false, []string{"invalid address"}),
+			NewParams(false, []string{"invalid address"}, false, nil, nil, nil, 0, 0),
+			true,
+		},
+		{
+			"valid: permissionless",
+			NewParams(true, []string(nil), true, sdk.NewCoins(sdk.NewInt64Coin("ujuno", 1_000_000)), []string{"ujuno", "factory/juno1/token"}, sdk.NewCoins(sdk.NewInt64Coin("ujuno", 100)), 0, 0),
+			false,
+		},
+		{
+			"invalid: permissionless fee",
+			NewParams(true, []string(nil), true, sdk.Coins{{Denom: "ujuno", Amount: sdk.NewInt(-1)}}, []string{"ujuno"}, nil, 0, 0),
+			true,
+		},
+		{
+			"invalid: permissionless denom",
+			NewParams(true, []string(nil), true, nil, []string{"!"}, nil, 0, 0),
+			true,
+		},
+		{
+			"invalid: duplicate permissionless denom",
+			NewParams(true, []string(nil), true, nil, []string{"ujuno", "ujuno"}, nil, 0, 0),
+			true,
+		},
+		{
+			"invalid: permissionless min amount",
+			NewParams(true, []string(nil), true, nil, []string{"ujuno"}, sdk.Coins{{Denom: "ujuno", Amount: sdk.NewInt(0)}}, 0, 0),
 			true,
 		},
 	}
@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -113,212 +113,2410 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "juno.drip.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "juno.drip.v1.QueryParamsResponse")
+// QueryDripSchedulesRequest is the request type for the Query/DripSchedules RPC
+// method.
+type QueryDripSchedulesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("juno/drip/v1/query.proto", fileDescriptor_eec39884c203d30d) }
+func (m *QueryDripSchedulesRequest) Reset()         { *m = QueryDripSchedulesRequest{} }
+func (m *QueryDripSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDripSchedulesRequest) ProtoMessage()    {}
+func (*QueryDripSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eec39884c203d30d, []int{2}
+}
+func (m *QueryDripSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDripSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDripSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDripSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDripSchedulesRequest.Merge(m, src)
+}
+func (m *QueryDripSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDripSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDripSchedulesRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_eec39884c203d30d = []byte{
-	// 301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x50, 0xcf, 0x4a, 0xc3, 0x30,
-	0x18, 0x6f, 0x45, 0x77, 0x88, 0x9e, 0x62, 0x91, 0x51, 0x46, 0x9c, 0x3b, 0x89, 0x48, 0x42, 0xe7,
-	0x1b, 0x6c, 0x20, 0x78, 0xd3, 0x1d, 0xbd, 0xa5, 0x33, 0xc4, 0xa8, 0xcb, 0x97, 0x35, 0xe9, 0x70,
-	0x57, 0x9f, 0x40, 0xf0, 0xa5, 0x76, 0x1c, 0x78, 0xf1, 0x24, 0xd2, 0xfa, 0x20, 0xd2, 0xa4, 0x07,
-	0x8b, 0xe0, 0xed, 0xe3, 0xf7, 0x37, 0xf9, 0xa1, 0xfe, 0x43, 0xa9, 0x81, 0xdd, 0x15, 0xca, 0xb0,
-	0x55, 0xc6, 0x96, 0xa5, 0x28, 0xd6, 0xd4, 0x14, 0xe0, 0x00, 0x1f, 0x34, 0x0c, 0x6d, 0x18, 0xba,
-	0xca, 0xd2, 0xb3, 0x39, 0xd8, 0x05, 0x58, 0x96, 0x73, 0x2b, 0x82, 0x8c, 0xad, 0xb2, 0x5c, 0x38,
-	0x9e, 0x31, 0xc3, 0xa5, 0xd2, 0xdc, 0x29, 0xd0, 0xc1, 0x99, 0xa6, 0x9d, 0x4c, 0x29, 0xb4, 0xb0,
-	0xca, 0xb6, 0x5c, 0x22, 0x41, 0x82, 0x3f, 0x59, 0x73, 0xb5, 0xe8, 0x40, 0x02, 0xc8, 0x27, 0xc1,
-	0xb8, 0x51, 0x8c, 0x6b, 0x0d, 0xce, 0xc7, 0xb5, 0x9e, 0x51, 0x82, 0xf0, 0x4d, 0xd3, 0x78, 0xcd,
-	0x0b, 0xbe, 0xb0, 0x33, 0xb1, 0x2c, 0x85, 0x75, 0xa3, 0x2b, 0x74, 0xd8, 0x41, 0xad, 0x01, 0x6d,
-	0x05, 0x1e, 0xa3, 0x9e, 0xf1, 0x48, 0x3f, 0x1e, 0xc6, 0xa7, 0xfb, 0xe3, 0x84, 0xfe, 0xfe, 0x07,
-	0x0d, 0xea, 0xc9, 0xee, 0xe6, 0xf3, 0x38, 0x9a, 0xb5, 0xca, 0xb1, 0x43, 0x7b, 0x3e, 0x0a, 0x3f,
-	0xa2, 0x5e, 0x10, 0xe0, 0x61, 0xd7, 0xf6, 0xb7, 0x3f, 0x3d, 0xf9, 0x47, 0x11, 0xde, 0x32, 0x1a,
-	0xbc, 0xbc, 0x7f, 0xbf, 0xed, 0x1c, 0xe1, 0x84, 0x75, 0x16, 0x09, 0xad, 0x93, 0xcb, 0x4d, 0x45,
-	0xe2, 0x6d, 0x45, 0xe2, 0xaf, 0x8a, 0xc4, 0xaf, 0x35, 0x89, 0xb6, 0x35, 0x89, 0x3e, 0x6a, 0x12,
-	0xdd, 0x9e, 0x4b, 0xe5, 0xee, 0xcb, 0x9c, 0xce, 0x61, 0xc1, 0xa6, 0x7e, 0xf7, 0x29, 0x68, 0x57,
-	0xf0, 0xb9, 0xb3, 0x21, 0xe9, 0x39, 0x64, 0xb9, 0xb5, 0x11, 0x36, 0xef, 0xf9, 0x95, 0x2e, 0x7e,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xe9, 0xf5, 0x09, 0xb1, 0xcb, 0x01, 0x00, 0x00,
+var xxx_messageInfo_QueryDripSchedulesRequest proto.InternalMessageInfo
+
+func (m *QueryDripSchedulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// QueryDripSchedulesResponse is the response type for the Query/DripSchedules
+// RPC method.
+type QueryDripSchedulesResponse struct {
+	// schedules are the active drip schedules
+	Schedules []DripSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryDripSchedulesResponse) Reset()         { *m = QueryDripSchedulesResponse{} }
+func (m *QueryDripSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDripSchedulesResponse) ProtoMessage()    {}
+func (*QueryDripSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eec39884c203d30d, []int{3}
+}
+func (m *QueryDripSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDripSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDripSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDripSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDripSchedulesResponse.Merge(m, src)
+}
+func (m *QueryDripSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDripSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDripSchedulesResponse.DiscardUnknown(m)
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params retrieves the Drip module params
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryDripSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryDripSchedulesResponse) GetSchedules() []DripSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryDripSchedulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryDripScheduleRequest is the request type for the Query/DripSchedule RPC
+// method.
+type QueryDripScheduleRequest struct {
+	// id is the identifier of the drip schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/juno.drip.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDripScheduleRequest) Reset()         { *m = QueryDripScheduleRequest{} }
+func (m *QueryDripScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDripScheduleRequest) ProtoMessage()    {}
+func (*QueryDripScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eec39884c203d30d, []int{4}
+}
+func (m *QueryDripScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDripScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDripScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDripScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDripScheduleRequest.Merge(m, src)
+}
+func (m *QueryDripScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDripScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDripScheduleRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params retrieves the Drip module params
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryDripScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryDripScheduleRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryDripScheduleResponse is the response type for the Query/DripSchedule
+// RPC method.
+type QueryDripScheduleResponse struct {
+	// schedule is the active drip schedule
+	Schedule DripSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryDripScheduleResponse) Reset()         { *m = QueryDripScheduleResponse{} }
+func (m *QueryDripScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDripScheduleResponse) ProtoMessage()    {}
+func (*QueryDripScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eec39884c203d30d, []int{5}
+}
+func (m *QueryDripScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDripScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDripScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDripScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDripScheduleResponse.Merge(m, src)
+}
+func (m *QueryDripScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDripScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDripScheduleResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryDripScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryDripScheduleResponse) GetSchedule() DripSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return DripSchedule{}
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// QueryDripHistoryRequest is the request type for the Query/DripHistory RPC
+// method.
+type QueryDripHistoryRequest struct {
+	// sender_address optionally filters the drips of a sender
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDripHistoryRequest) Reset()         { *m = QueryDripHistoryRequest{} }
+func (m *QueryDripHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDripHistoryRequest) ProtoMessage()    {}
+func (*QueryDripHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eec39884c203d30d, []int{6}
+}
+func (m *QueryDripHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDripHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDripHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+}
+func (m *QueryDripHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDripHistoryRequest.Merge(m, src)
+}
+func (m *QueryDripHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDripHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDripHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDripHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryDripHistoryRequest) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.drip.v1.Query/Params",
+	return ""
+}
+
+func (m *QueryDripHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return nil
+}
+
+// QueryDripHistoryResponse is the response type for the Query/DripHistory RPC
+// method.
+type QueryDripHistoryResponse struct {
+	// records are the drip history records, oldest first
+	Records []DripRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDripHistoryResponse) Reset()         { *m = QueryDripHistoryResponse{} }
+func (m *QueryDripHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDripHistoryResponse) ProtoMessage()    {}
+func (*QueryDripHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eec39884c203d30d, []int{7}
+}
+func (m *QueryDripHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDripHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDripHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDripHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDripHistoryResponse.Merge(m, src)
+}
+func (m *QueryDripHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDripHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDripHistoryResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "juno.drip.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "juno/drip/v1/query.proto",
+var xxx_messageInfo_QueryDripHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryDripHistoryResponse) GetRecords() []DripRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDripHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySenderDripTotalsRequest is the request type for the
+// Query/SenderDripTotals RPC method.
+type QuerySenderDripTotalsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySenderDripTotalsRequest) Reset()         { *m = QuerySenderDripTotalsRequest{} }
+func (m *QuerySenderDripTotalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySenderDripTotalsRequest) ProtoMessage()    {}
+func (*QuerySenderDripTotalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eec39884c203d30d, []int{8}
+}
+func (m *QuerySenderDripTotalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySenderDripTotalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySenderDripTotalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySenderDripTotalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySenderDripTotalsRequest.Merge(m, src)
+}
+func (m *QuerySenderDripTotalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySenderDripTotalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySenderDripTotalsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySenderDripTotalsRequest proto.InternalMessageInfo
+
+func (m *QuerySenderDripTotalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySenderDripTotalsResponse is the response type for the
+// Query/SenderDripTotals RPC method.
+type QuerySenderDripTotalsResponse struct {
+	// totals are the aggregated drips of each sender
+	Totals []SenderDripTotal `protobuf:"bytes,1,rep,name=totals,proto3" json:"totals"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySenderDripTotalsResponse) Reset()         { *m = QuerySenderDripTotalsResponse{} }
+func (m *QuerySenderDripTotalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySenderDripTotalsResponse) ProtoMessage()    {}
+func (*QuerySenderDripTotalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eec39884c203d30d, []int{9}
+}
+func (m *QuerySenderDripTotalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySenderDripTotalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySenderDripTotalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySenderDripTotalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySenderDripTotalsResponse.Merge(m, src)
+}
+func (m *QuerySenderDripTotalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySenderDripTotalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySenderDripTotalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySenderDripTotalsResponse proto.InternalMessageInfo
+
+func (m *QuerySenderDripTotalsResponse) GetTotals() []SenderDripTotal {
+	if m != nil {
+		return m.Totals
+	}
+	return nil
+}
+
+func (m *QuerySenderDripTotalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySenderDripTotalRequest is the request type for the
+// Query/SenderDripTotal RPC method.
+type QuerySenderDripTotalRequest struct {
+	// sender_address is the bech32 address of the drip sender
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+}
+
+func (m *QuerySenderDripTotalRequest) Reset()         { *m = QuerySenderDripTotalRequest{} }
+func (m *QuerySenderDripTotalRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySenderDripTotalRequest) ProtoMessage()    {}
+func (*QuerySenderDripTotalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eec39884c203d30d, []int{10}
+}
+func (m *QuerySenderDripTotalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySenderDripTotalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySenderDripTotalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySenderDripTotalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySenderDripTotalRequest.Merge(m, src)
+}
+func (m *QuerySenderDripTotalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySenderDripTotalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySenderDripTotalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySenderDripTotalRequest proto.InternalMessageInfo
+
+func (m *QuerySenderDripTotalRequest) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+// QuerySenderDripTotalResponse is the response type for the
+// Query/SenderDripTotal RPC method.
+type QuerySenderDripTotalResponse struct {
+	// total is the aggregated drips of the sender
+	Total SenderDripTotal `protobuf:"bytes,1,opt,name=total,proto3" json:"total"`
+}
+
+func (m *QuerySenderDripTotalResponse) Reset()         { *m = QuerySenderDripTotalResponse{} }
+func (m *QuerySenderDripTotalResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySenderDripTotalResponse) ProtoMessage()    {}
+func (*QuerySenderDripTotalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eec39884c203d30d, []int{11}
+}
+func (m *QuerySenderDripTotalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySenderDripTotalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySenderDripTotalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySenderDripTotalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySenderDripTotalResponse.Merge(m, src)
+}
+func (m *QuerySenderDripTotalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySenderDripTotalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySenderDripTotalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySenderDripTotalResponse proto.InternalMessageInfo
+
+func (m *QuerySenderDripTotalResponse) GetTotal() SenderDripTotal {
+	if m != nil {
+		return m.Total
+	}
+	return SenderDripTotal{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "juno.drip.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "juno.drip.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryDripSchedulesRequest)(nil), "juno.drip.v1.QueryDripSchedulesRequest")
+	proto.RegisterType((*QueryDripSchedulesResponse)(nil), "juno.drip.v1.QueryDripSchedulesResponse")
+	proto.RegisterType((*QueryDripScheduleRequest)(nil), "juno.drip.v1.QueryDripScheduleRequest")
+	proto.RegisterType((*QueryDripScheduleResponse)(nil), "juno.drip.v1.QueryDripScheduleResponse")
+	proto.RegisterType((*QueryDripHistoryRequest)(nil), "juno.drip.v1.QueryDripHistoryRequest")
+	proto.RegisterType((*QueryDripHistoryResponse)(nil), "juno.drip.v1.QueryDripHistoryResponse")
+	proto.RegisterType((*QuerySenderDripTotalsRequest)(nil), "juno.drip.v1.QuerySenderDripTotalsRequest")
+	proto.RegisterType((*QuerySenderDripTotalsResponse)(nil), "juno.drip.v1.QuerySenderDripTotalsResponse")
+	proto.RegisterType((*QuerySenderDripTotalRequest)(nil), "juno.drip.v1.QuerySenderDripTotalRequest")
+	proto.RegisterType((*QuerySenderDripTotalResponse)(nil), "juno.drip.v1.QuerySenderDripTotalResponse")
+}
+
+func init() { proto.RegisterFile("juno/drip/v1/query.proto", fileDescriptor_eec39884c203d30d) }
+
+var fileDescriptor_eec39884c203d30d = []byte{
+	// 733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0xbb, 0xfd, 0x41, 0x7f, 0xf2, 0xf0, 0x47, 0x33, 0x56, 0x5b, 0xd6, 0xb2, 0xe0, 0x46,
+	0x28, 0x56, 0xdd, 0x49, 0xeb, 0x45, 0xa3, 0x31, 0x11, 0x08, 0xea, 0x0d, 0x8b, 0x17, 0xbc, 0x98,
+	0x6d, 0x77, 0x5c, 0x56, 0x61, 0x67, 0xd9, 0xd9, 0x12, 0x09, 0xe1, 0xa2, 0x31, 0xd1, 0x9b, 0x89,
+	0x07, 0x4f, 0x1e, 0x79, 0x2f, 0x1c, 0x49, 0xbc, 0x78, 0x32, 0x06, 0x7c, 0x21, 0xa6, 0x33, 0xb3,
+	0xa5, 0x43, 0xb7, 0x2c, 0x1a, 0xbc, 0x35, 0xcf, 0x3c, 0xcf, 0xf7, 0xf9, 0x3c, 0xdf, 0xd9, 0x79,
+	0x52, 0x28, 0xbe, 0x6a, 0xf9, 0x14, 0x3b, 0xa1, 0x17, 0xe0, 0xcd, 0x2a, 0xde, 0x68, 0x91, 0x70,
+	0xcb, 0x0a, 0x42, 0x1a, 0x51, 0x34, 0xd2, 0x3e, 0xb1, 0xda, 0x27, 0xd6, 0x66, 0x55, 0xaf, 0x34,
+	0x29, 0x5b, 0xa7, 0x0c, 0x37, 0x6c, 0x46, 0x44, 0x1a, 0xde, 0xac, 0x36, 0x48, 0x64, 0x57, 0x71,
+	0x60, 0xbb, 0x9e, 0x6f, 0x47, 0x1e, 0xf5, 0x45, 0xa5, 0xae, 0x2b, 0x9a, 0x2e, 0xf1, 0x09, 0xf3,
+	0x98, 0x3c, 0x2b, 0x28, 0x67, 0x5c, 0x5d, 0x1c, 0xe4, 0x5d, 0xea, 0x52, 0xfe, 0x13, 0xb7, 0x7f,
+	0xc9, 0x68, 0xc9, 0xa5, 0xd4, 0x5d, 0x23, 0xd8, 0x0e, 0x3c, 0x6c, 0xfb, 0x3e, 0x8d, 0x78, 0x1f,
+	0x29, 0x66, 0xe6, 0x01, 0x3d, 0x6d, 0xa3, 0x2c, 0xd9, 0xa1, 0xbd, 0xce, 0xea, 0x64, 0xa3, 0x45,
+	0x58, 0x64, 0x3e, 0x81, 0x8b, 0x4a, 0x94, 0x05, 0xd4, 0x67, 0x04, 0xd5, 0x20, 0x17, 0xf0, 0x48,
+	0x51, 0x9b, 0xd2, 0x66, 0x87, 0x6b, 0x79, 0xab, 0x7b, 0x40, 0x4b, 0x64, 0xcf, 0x0d, 0xec, 0xfd,
+	0x98, 0xcc, 0xd4, 0x65, 0xa6, 0xd9, 0x84, 0x71, 0x2e, 0xb5, 0x10, 0x7a, 0xc1, 0x72, 0x73, 0x95,
+	0x38, 0xad, 0x35, 0x12, 0xf7, 0x41, 0x8b, 0x00, 0x47, 0xa3, 0x4b, 0xd1, 0x19, 0x4b, 0xf8, 0x64,
+	0xb5, 0x7d, 0xb2, 0x84, 0x9d, 0xd2, 0x27, 0x6b, 0xc9, 0x76, 0x89, 0xac, 0xad, 0x77, 0x55, 0x9a,
+	0xbb, 0x1a, 0xe8, 0x49, 0x5d, 0x24, 0xf7, 0x03, 0x18, 0x62, 0x71, 0xb0, 0xa8, 0x4d, 0xfd, 0x37,
+	0x3b, 0x5c, 0xd3, 0x55, 0xf4, 0xee, 0x3a, 0x39, 0xc0, 0x51, 0x09, 0x7a, 0xa4, 0x60, 0x66, 0x39,
+	0x66, 0x39, 0x15, 0x53, 0x34, 0x57, 0x38, 0x2b, 0x50, 0xec, 0xc1, 0x8c, 0xbd, 0x18, 0x83, 0xac,
+	0xe7, 0x70, 0x0f, 0x06, 0xea, 0x59, 0xcf, 0x31, 0x57, 0x12, 0x8c, 0xeb, 0x4c, 0x74, 0x1f, 0xce,
+	0xc5, 0x78, 0xd2, 0xb6, 0xf4, 0x81, 0x3a, 0x15, 0xe6, 0x07, 0x0d, 0x0a, 0x1d, 0xed, 0xc7, 0x1e,
+	0x8b, 0x68, 0xb8, 0x15, 0x63, 0x4c, 0xc3, 0x18, 0x23, 0xbe, 0x43, 0xc2, 0x17, 0xb6, 0xe3, 0x84,
+	0x84, 0x89, 0xbb, 0x1e, 0xaa, 0x8f, 0x8a, 0xe8, 0x43, 0x11, 0x44, 0x8b, 0x09, 0x96, 0xfc, 0xcd,
+	0xcd, 0x7d, 0xd5, 0xa0, 0xd8, 0x8b, 0x22, 0xa7, 0xbc, 0x03, 0xff, 0x87, 0xa4, 0x49, 0x43, 0x27,
+	0xbe, 0xb5, 0x62, 0xef, 0x90, 0x75, 0x9e, 0x20, 0x47, 0x8c, 0xd3, 0xcf, 0xee, 0xc6, 0x5e, 0x42,
+	0x89, 0xe3, 0x2d, 0xf3, 0xe9, 0xdb, 0x0d, 0x9f, 0xd1, 0xc8, 0x5e, 0xfb, 0x17, 0x5f, 0xf0, 0x44,
+	0x9f, 0x46, 0xd2, 0x8c, 0x7b, 0x90, 0x8b, 0x78, 0x44, 0x7a, 0x31, 0xa1, 0x7a, 0x71, 0xac, 0x2e,
+	0x7e, 0x85, 0xa2, 0xe4, 0xec, 0xfc, 0x58, 0x80, 0x2b, 0x49, 0x98, 0x7f, 0xf6, 0xf5, 0x98, 0x2b,
+	0xc9, 0xae, 0x76, 0x66, 0xbd, 0x0b, 0x83, 0x1c, 0x5c, 0x1a, 0x7a, 0xaa, 0x51, 0x45, 0x45, 0x6d,
+	0x37, 0x07, 0x83, 0x5c, 0x1b, 0xbd, 0x86, 0x9c, 0xd8, 0x48, 0x68, 0x4a, 0xad, 0xef, 0x5d, 0x78,
+	0xfa, 0xd5, 0x13, 0x32, 0x04, 0x93, 0x59, 0x7a, 0xfb, 0xed, 0xd7, 0xe7, 0xec, 0x65, 0x94, 0xc7,
+	0xca, 0xfe, 0x15, 0x6b, 0x0e, 0xbd, 0xd3, 0x60, 0x54, 0x59, 0x3e, 0xa8, 0x9c, 0x20, 0x99, 0xb4,
+	0x04, 0xf5, 0xd9, 0xf4, 0x44, 0x89, 0x30, 0xc9, 0x11, 0xc6, 0x51, 0x41, 0x45, 0x38, 0x5a, 0x54,
+	0xef, 0x35, 0x18, 0xe9, 0x2e, 0x45, 0x33, 0x29, 0xda, 0x31, 0x43, 0x39, 0x35, 0x4f, 0x22, 0x5c,
+	0xe3, 0x08, 0x06, 0x2a, 0xf5, 0x41, 0xc0, 0xdb, 0x9e, 0xb3, 0x83, 0xb6, 0x61, 0xb8, 0xeb, 0x3d,
+	0xa3, 0xe9, 0x3e, 0xea, 0xea, 0xea, 0xd1, 0x67, 0xd2, 0xd2, 0x24, 0xc3, 0x04, 0x67, 0x28, 0xa0,
+	0x4b, 0x2a, 0xc3, 0xaa, 0xec, 0xf6, 0x51, 0x83, 0x0b, 0xc7, 0x5f, 0x11, 0xaa, 0x24, 0x68, 0xf7,
+	0x79, 0xd3, 0xfa, 0x8d, 0x53, 0xe5, 0x9e, 0xfc, 0x59, 0xc8, 0x77, 0xf7, 0x45, 0x83, 0xf3, 0xc7,
+	0x4a, 0xd1, 0xf5, 0x74, 0xf9, 0x98, 0xa4, 0x72, 0x9a, 0x54, 0x09, 0x72, 0x8b, 0x83, 0x94, 0xd1,
+	0x74, 0x12, 0x08, 0xde, 0x56, 0x9f, 0xe5, 0xce, 0xdc, 0xe2, 0xde, 0x81, 0xa1, 0xed, 0x1f, 0x18,
+	0xda, 0xcf, 0x03, 0x43, 0xfb, 0x74, 0x68, 0x64, 0xf6, 0x0f, 0x8d, 0xcc, 0xf7, 0x43, 0x23, 0xf3,
+	0xfc, 0xa6, 0xeb, 0x45, 0xab, 0xad, 0x86, 0xd5, 0xa4, 0xeb, 0x78, 0x9e, 0x6f, 0x88, 0x79, 0xea,
+	0x47, 0xa1, 0xdd, 0x8c, 0x98, 0x90, 0x7e, 0x23, 0xc4, 0xa3, 0xad, 0x80, 0xb0, 0x46, 0x8e, 0xff,
+	0x8f, 0xb8, 0xfd, 0x7b, 0x00, 0x64, 0xbd, 0x95, 0x44, 0x06, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params retrieves the Drip module params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// DripSchedules retrieves all active drip schedules
+	DripSchedules(ctx context.Context, in *QueryDripSchedulesRequest, opts ...grpc.CallOption) (*QueryDripSchedulesResponse, error)
+	// DripSchedule retrieves an active drip schedule by id
+	DripSchedule(ctx context.Context, in *QueryDripScheduleRequest, opts ...grpc.CallOption) (*QueryDripScheduleResponse, error)
+	// DripHistory retrieves the latest drips, optionally filtered by sender
+	DripHistory(ctx context.Context, in *QueryDripHistoryRequest, opts ...grpc.CallOption) (*QueryDripHistoryResponse, error)
+	// SenderDripTotals retrieves the aggregated drips of all senders
+	SenderDripTotals(ctx context.Context, in *QuerySenderDripTotalsRequest, opts ...grpc.CallOption) (*QuerySenderDripTotalsResponse, error)
+	// SenderDripTotal retrieves the aggregated drips of a sender
+	SenderDripTotal(ctx context.Context, in *QuerySenderDripTotalRequest, opts ...grpc.CallOption) (*QuerySenderDripTotalResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/juno.drip.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DripSchedules(ctx context.Context, in *QueryDripSchedulesRequest, opts ...grpc.CallOption) (*QueryDripSchedulesResponse, error) {
+	out := new(QueryDripSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/juno.drip.v1.Query/DripSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DripSchedule(ctx context.Context, in *QueryDripScheduleRequest, opts ...grpc.CallOption) (*QueryDripScheduleResponse, error) {
+	out := new(QueryDripScheduleResponse)
+	err := c.cc.Invoke(ctx, "/juno.drip.v1.Query/DripSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DripHistory(ctx context.Context, in *QueryDripHistoryRequest, opts ...grpc.CallOption) (*QueryDripHistoryResponse, error) {
+	out := new(QueryDripHistoryResponse)
+	err := c.cc.Invoke(ctx, "/juno.drip.v1.Query/DripHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SenderDripTotals(ctx context.Context, in *QuerySenderDripTotalsRequest, opts ...grpc.CallOption) (*QuerySenderDripTotalsResponse, error) {
+	out := new(QuerySenderDripTotalsResponse)
+	err := c.cc.Invoke(ctx, "/juno.drip.v1.Query/SenderDripTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SenderDripTotal(ctx context.Context, in *QuerySenderDripTotalRequest, opts ...grpc.CallOption) (*QuerySenderDripTotalResponse, error) {
+	out := new(QuerySenderDripTotalResponse)
+	err := c.cc.Invoke(ctx, "/juno.drip.v1.Query/SenderDripTotal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params retrieves the Drip module params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// DripSchedules retrieves all active drip schedules
+	DripSchedules(context.Context, *QueryDripSchedulesRequest) (*QueryDripSchedulesResponse, error)
+	// DripSchedule retrieves an active drip schedule by id
+	DripSchedule(context.Context, *QueryDripScheduleRequest) (*QueryDripScheduleResponse, error)
+	// DripHistory retrieves the latest drips, optionally filtered by sender
+	DripHistory(context.Context, *QueryDripHistoryRequest) (*QueryDripHistoryResponse, error)
+	// SenderDripTotals retrieves the aggregated drips of all senders
+	SenderDripTotals(context.Context, *QuerySenderDripTotalsRequest) (*QuerySenderDripTotalsResponse, error)
+	// SenderDripTotal retrieves the aggregated drips of a sender
+	SenderDripTotal(context.Context, *QuerySenderDripTotalRequest) (*QuerySenderDripTotalResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) DripSchedules(ctx context.Context, req *QueryDripSchedulesRequest) (*QueryDripSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DripSchedules not implemented")
+}
+func (*UnimplementedQueryServer) DripSchedule(ctx context.Context, req *QueryDripScheduleRequest) (*QueryDripScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DripSchedule not implemented")
+}
+func (*UnimplementedQueryServer) DripHistory(ctx context.Context, req *QueryDripHistoryRequest) (*QueryDripHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DripHistory not implemented")
+}
+func (*UnimplementedQueryServer) SenderDripTotals(ctx context.Context, req *QuerySenderDripTotalsRequest) (*QuerySenderDripTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SenderDripTotals not implemented")
+}
+func (*UnimplementedQueryServer) SenderDripTotal(ctx context.Context, req *QuerySenderDripTotalRequest) (*QuerySenderDripTotalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SenderDripTotal not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.drip.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DripSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDripSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DripSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.drip.v1.Query/DripSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DripSchedules(ctx, req.(*QueryDripSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DripSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDripScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DripSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.drip.v1.Query/DripSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DripSchedule(ctx, req.(*QueryDripScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DripHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDripHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DripHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.drip.v1.Query/DripHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DripHistory(ctx, req.(*QueryDripHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SenderDripTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySenderDripTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SenderDripTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.drip.v1.Query/SenderDripTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SenderDripTotals(ctx, req.(*QuerySenderDripTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SenderDripTotal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySenderDripTotalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SenderDripTotal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.drip.v1.Query/SenderDripTotal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SenderDripTotal(ctx, req.(*QuerySenderDripTotalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "juno.drip.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DripSchedules",
+			Handler:    _Query_DripSchedules_Handler,
+		},
+		{
+			MethodName: "DripSchedule",
+			Handler:    _Query_DripSchedule_Handler,
+		},
+		{
+			MethodName: "DripHistory",
+			Handler:    _Query_DripHistory_Handler,
+		},
+		{
+			MethodName: "SenderDripTotals",
+			Handler:    _Query_SenderDripTotals_Handler,
+		},
+		{
+			MethodName: "SenderDripTotal",
+			Handler:    _Query_SenderDripTotal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "juno/drip/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDripSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDripSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDripSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDripSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDripSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDripSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDripScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDripScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDripScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDripScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDripScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDripScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDripHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDripHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDripHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDripHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDripHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDripHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySenderDripTotalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySenderDripTotalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySenderDripTotalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySenderDripTotalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySenderDripTotalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySenderDripTotalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Totals) > 0 {
+		for iNdEx := len(m.Totals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Totals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySenderDripTotalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySenderDripTotalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySenderDripTotalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySenderDripTotalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySenderDripTotalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySenderDripTotalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDripSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDripSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDripScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryDripScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDripHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDripHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySenderDripTotalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySenderDripTotalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Totals) > 0 {
+		for _, e := range m.Totals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySenderDripTotalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySenderDripTotalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDripSchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDripSchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDripSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDripSchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDripSchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDripSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, DripSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDripScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDripScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDripScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDripScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDripScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDripScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDripHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDripHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDripHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDripHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDripHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDripHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, DripRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySenderDripTotalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySenderDripTotalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySenderDripTotalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QuerySenderDripTotalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySenderDripTotalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySenderDripTotalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Totals = append(m.Totals, SenderDripTotal{})
+			if err := m.Totals[len(m.Totals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySenderDripTotalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -341,12 +2539,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySenderDripTotalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySenderDripTotalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -368,7 +2598,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySenderDripTotalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -391,15 +2621,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySenderDripTotalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySenderDripTotalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -426,7 +2656,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDripSchedule returns a new drip schedule that has not released any tokens.
+func NewDripSchedule(id uint64, sender sdk.AccAddress, amount sdk.Coins, startHeight int64, numBlocks uint64) DripSchedule {
+	return DripSchedule{
+		Id:             id,
+		SenderAddress:  sender.String(),
+		TotalAmount:    amount,
+		ReleasedAmount: sdk.NewCoins(),
+		StartHeight:    startHeight,
+		NumBlocks:      numBlocks,
+	}
+}
+
+// EndHeight returns the last block releasing tokens.
+func (s DripSchedule) EndHeight() int64 {
+	return s.StartHeight + int64(s.NumBlocks) - 1
+}
+
+// IsComplete returns true once all tokens are released at the given height.
+func (s DripSchedule) IsComplete(height int64) bool {
+	return height >= s.EndHeight()
+}
+
+// VestedAmount returns the amount released by the schedule up to and including
+// the given height. Each block releases a pro-rata slice of the total amount,
+// and the last block releases the remainder.
+func (s DripSchedule) VestedAmount(height int64) sdk.Coins {
+	if height < s.StartHeight {
+		return sdk.NewCoins()
+	}
+	if s.IsComplete(height) {
+		return s.TotalAmount
+	}
+
+	elapsed := sdk.NewInt(height - s.StartHeight + 1)
+	numBlocks := sdk.NewIntFromUint64(s.NumBlocks)
+
+	vested := sdk.NewCoins()
+	for _, coin := range s.TotalAmount {
+		vested = vested.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(elapsed).Quo(numBlocks)))
+	}
+	return vested
+}
+
+// ReleasableAmount returns the amount to release at the given height.
+func (s DripSchedule) ReleasableAmount(height int64) sdk.Coins {
+	return s.VestedAmount(height).Sub(s.ReleasedAmount...)
+}
+
+// RemainingAmount returns the amount not released yet.
+func (s DripSchedule) RemainingAmount() sdk.Coins {
+	return s.TotalAmount.Sub(s.ReleasedAmount...)
+}
+
+// Validate performs a stateless validation of the drip schedule.
+func (s DripSchedule) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.SenderAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "invalid sender address: %s", err)
+	}
+
+	if s.TotalAmount.Empty() || !s.TotalAmount.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "invalid total amount: %s", s.TotalAmount)
+	}
+
+	if !s.ReleasedAmount.IsValid() || !s.TotalAmount.IsAllGTE(s.ReleasedAmount) {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "invalid released amount %s of total amount %s", s.ReleasedAmount, s.TotalAmount)
+	}
+
+	if s.StartHeight <= 0 {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "start height must be positive: %d", s.StartHeight)
+	}
+
+	if s.NumBlocks == 0 || s.NumBlocks > uint64(math.MaxInt64-s.StartHeight) {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "invalid number of blocks: %d", s.NumBlocks)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDripScheduleVestedAmount(t *testing.T) {
+	sender := sdk.AccAddress(make([]byte, 20))
+	schedule := NewDripSchedule(1, sender, sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("ujuno", 3)), 100, 4)
+	require.NoError(t, schedule.Validate())
+	require.Equal(t, int64(103), schedule.EndHeight())
+
+	for _, tc := range []struct {
+		height    int64
+		expVested sdk.Coins
+	}{
+		{99, sdk.NewCoins()},
+		{100, sdk.NewCoins(sdk.NewInt64Coin("stake", 2))},
+		{101, sdk.NewCoins(sdk.NewInt64Coin("stake", 5), sdk.NewInt64Coin("ujuno", 1))},
+		{102, sdk.NewCoins(sdk.NewInt64Coin("stake", 7), sdk.NewInt64Coin("ujuno", 2))},
+		// the last block releases the remainder
+		{103, sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("ujuno", 3))},
+		{200, sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("ujuno", 3))},
+	} {
+		require.Equal(t, tc.expVested, schedule.VestedAmount(tc.height), "height %d", tc.height)
+	}
+
+	schedule.ReleasedAmount = schedule.VestedAmount(101)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 2), sdk.NewInt64Coin("ujuno", 1)), schedule.ReleasableAmount(102))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 5), sdk.NewInt64Coin("ujuno", 2)), schedule.RemainingAmount())
+}
+
+func TestDripScheduleValidate(t *testing.T) {
+	sender := sdk.AccAddress(make([]byte, 20))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	require.NoError(t, NewDripSchedule(1, sender, amount, 1, 1).Validate())
+	require.Error(t, NewDripSchedule(1, sender, sdk.NewCoins(), 1, 1).Validate())
+	require.Error(t, NewDripSchedule(1, sender, amount, 0, 1).Validate())
+	require.Error(t, NewDripSchedule(1, sender, amount, 1, 0).Validate())
+
+	overReleased := NewDripSchedule(1, sender, amount, 1, 1)
+	overReleased.ReleasedAmount = sdk.NewCoins(sdk.NewInt64Coin("stake", 11))
+	require.Error(t, overReleased.Validate())
+
+	invalidSender := NewDripSchedule(1, sender, amount, 1, 1)
+	invalidSender.SenderAddress = "invalid"
+	require.Error(t, invalidSender.Validate())
+}
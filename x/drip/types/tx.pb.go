@@ -221,52 +221,404 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgCreateDripSchedule defines a message that creates a drip schedule.
+type MsgCreateDripSchedule struct {
+	// sender_address is the bech32 address of message sender.
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// amount is the amount distributed to stakers over the schedule
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// start_height is the first block releasing tokens. The next block is used
+	// when zero.
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// num_blocks is the number of blocks the tokens are released over
+	NumBlocks uint64 `protobuf:"varint,4,opt,name=num_blocks,json=numBlocks,proto3" json:"num_blocks,omitempty"`
+}
+
+func (m *MsgCreateDripSchedule) Reset()         { *m = MsgCreateDripSchedule{} }
+func (m *MsgCreateDripSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDripSchedule) ProtoMessage()    {}
+func (*MsgCreateDripSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c0f1d75f17f4bc, []int{4}
+}
+func (m *MsgCreateDripSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDripSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDripSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDripSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDripSchedule.Merge(m, src)
+}
+func (m *MsgCreateDripSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDripSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDripSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDripSchedule proto.InternalMessageInfo
+
+func (m *MsgCreateDripSchedule) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateDripSchedule) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgCreateDripSchedule) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MsgCreateDripSchedule) GetNumBlocks() uint64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
+
+// MsgCreateDripScheduleResponse defines the MsgCreateDripSchedule response type
+type MsgCreateDripScheduleResponse struct {
+	// id is the identifier of the created drip schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCreateDripScheduleResponse) Reset()         { *m = MsgCreateDripScheduleResponse{} }
+func (m *MsgCreateDripScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDripScheduleResponse) ProtoMessage()    {}
+func (*MsgCreateDripScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c0f1d75f17f4bc, []int{5}
+}
+func (m *MsgCreateDripScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDripScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDripScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDripScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDripScheduleResponse.Merge(m, src)
+}
+func (m *MsgCreateDripScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDripScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDripScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDripScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgCreateDripScheduleResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelDripSchedule defines a message that cancels a drip schedule.
+type MsgCancelDripSchedule struct {
+	// sender_address is the bech32 address of the schedule creator.
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// id is the identifier of the drip schedule
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelDripSchedule) Reset()         { *m = MsgCancelDripSchedule{} }
+func (m *MsgCancelDripSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDripSchedule) ProtoMessage()    {}
+func (*MsgCancelDripSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c0f1d75f17f4bc, []int{6}
+}
+func (m *MsgCancelDripSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDripSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDripSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDripSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDripSchedule.Merge(m, src)
+}
+func (m *MsgCancelDripSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDripSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDripSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDripSchedule proto.InternalMessageInfo
+
+func (m *MsgCancelDripSchedule) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *MsgCancelDripSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelDripScheduleResponse defines the MsgCancelDripSchedule response type
+type MsgCancelDripScheduleResponse struct {
+	// refunded_amount is the amount refunded to the sender
+	RefundedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refunded_amount,json=refundedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_amount"`
+}
+
+func (m *MsgCancelDripScheduleResponse) Reset()         { *m = MsgCancelDripScheduleResponse{} }
+func (m *MsgCancelDripScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDripScheduleResponse) ProtoMessage()    {}
+func (*MsgCancelDripScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c0f1d75f17f4bc, []int{7}
+}
+func (m *MsgCancelDripScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDripScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDripScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDripScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDripScheduleResponse.Merge(m, src)
+}
+func (m *MsgCancelDripScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDripScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDripScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDripScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgCancelDripScheduleResponse) GetRefundedAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RefundedAmount
+	}
+	return nil
+}
+
+// MsgDistributeTokensToValidators defines a message that distributes tokens to
+// the delegators of a set of validators.
+type MsgDistributeTokensToValidators struct {
+	// sender_address is the bech32 address of message sender.
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// amount is the amount being airdropped to the delegators. It is split
+	// between the validators pro rata to their bonded tokens.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// validator_addresses are the bech32 operator addresses of the validators
+	// whose delegators receive the tokens
+	ValidatorAddresses []string `protobuf:"bytes,3,rep,name=validator_addresses,json=validatorAddresses,proto3" json:"validator_addresses,omitempty"`
+}
+
+func (m *MsgDistributeTokensToValidators) Reset()         { *m = MsgDistributeTokensToValidators{} }
+func (m *MsgDistributeTokensToValidators) String() string { return proto.CompactTextString(m) }
+func (*MsgDistributeTokensToValidators) ProtoMessage()    {}
+func (*MsgDistributeTokensToValidators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c0f1d75f17f4bc, []int{8}
+}
+func (m *MsgDistributeTokensToValidators) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDistributeTokensToValidators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDistributeTokensToValidators.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDistributeTokensToValidators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDistributeTokensToValidators.Merge(m, src)
+}
+func (m *MsgDistributeTokensToValidators) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDistributeTokensToValidators) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDistributeTokensToValidators.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDistributeTokensToValidators proto.InternalMessageInfo
+
+func (m *MsgDistributeTokensToValidators) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *MsgDistributeTokensToValidators) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgDistributeTokensToValidators) GetValidatorAddresses() []string {
+	if m != nil {
+		return m.ValidatorAddresses
+	}
+	return nil
+}
+
+// MsgDistributeTokensToValidatorsResponse defines the
+// MsgDistributeTokensToValidators response type
+type MsgDistributeTokensToValidatorsResponse struct {
+}
+
+func (m *MsgDistributeTokensToValidatorsResponse) Reset() {
+	*m = MsgDistributeTokensToValidatorsResponse{}
+}
+func (m *MsgDistributeTokensToValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDistributeTokensToValidatorsResponse) ProtoMessage()    {}
+func (*MsgDistributeTokensToValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c0f1d75f17f4bc, []int{9}
+}
+func (m *MsgDistributeTokensToValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDistributeTokensToValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDistributeTokensToValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDistributeTokensToValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDistributeTokensToValidatorsResponse.Merge(m, src)
+}
+func (m *MsgDistributeTokensToValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDistributeTokensToValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDistributeTokensToValidatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDistributeTokensToValidatorsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgDistributeTokens)(nil), "juno.drip.v1.MsgDistributeTokens")
 	proto.RegisterType((*MsgDistributeTokensResponse)(nil), "juno.drip.v1.MsgDistributeTokensResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "juno.drip.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "juno.drip.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateDripSchedule)(nil), "juno.drip.v1.MsgCreateDripSchedule")
+	proto.RegisterType((*MsgCreateDripScheduleResponse)(nil), "juno.drip.v1.MsgCreateDripScheduleResponse")
+	proto.RegisterType((*MsgCancelDripSchedule)(nil), "juno.drip.v1.MsgCancelDripSchedule")
+	proto.RegisterType((*MsgCancelDripScheduleResponse)(nil), "juno.drip.v1.MsgCancelDripScheduleResponse")
+	proto.RegisterType((*MsgDistributeTokensToValidators)(nil), "juno.drip.v1.MsgDistributeTokensToValidators")
+	proto.RegisterType((*MsgDistributeTokensToValidatorsResponse)(nil), "juno.drip.v1.MsgDistributeTokensToValidatorsResponse")
 }
 
 func init() { proto.RegisterFile("juno/drip/v1/tx.proto", fileDescriptor_73c0f1d75f17f4bc) }
 
 var fileDescriptor_73c0f1d75f17f4bc = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xbd, 0x6f, 0x13, 0x4d,
-	0x10, 0xc6, 0x7d, 0xc9, 0xab, 0x48, 0xde, 0xf8, 0xe5, 0xe3, 0x30, 0x8a, 0x6d, 0xc8, 0xd9, 0x39,
-	0x11, 0xc9, 0x58, 0xe4, 0x56, 0x36, 0x08, 0xa4, 0x74, 0xd8, 0x28, 0x9d, 0x25, 0x64, 0x42, 0x43,
-	0x63, 0xad, 0x7d, 0xab, 0xcd, 0x92, 0xdc, 0xee, 0xe9, 0x66, 0x6d, 0xd9, 0x6d, 0x4a, 0x44, 0x81,
-	0x44, 0x47, 0x45, 0x89, 0xa8, 0x5c, 0x50, 0xd0, 0xd0, 0xa7, 0x8c, 0xa0, 0xa1, 0x02, 0x64, 0x23,
-	0x19, 0xf1, 0x57, 0xa0, 0xbb, 0xdd, 0x24, 0xb6, 0x13, 0x41, 0x73, 0x1f, 0xcf, 0x33, 0x3b, 0xf3,
-	0x9b, 0xb9, 0x39, 0x74, 0xfd, 0x79, 0x4f, 0x48, 0xec, 0x47, 0x3c, 0xc4, 0xfd, 0x2a, 0x56, 0x03,
-	0x2f, 0x8c, 0xa4, 0x92, 0x76, 0x26, 0x96, 0xbd, 0x58, 0xf6, 0xfa, 0xd5, 0x42, 0x96, 0x49, 0x26,
-	0x13, 0x03, 0xc7, 0x4f, 0x3a, 0xa6, 0x70, 0x93, 0x49, 0xc9, 0x0e, 0x28, 0x26, 0x21, 0xc7, 0x44,
-	0x08, 0xa9, 0x88, 0xe2, 0x52, 0x80, 0x71, 0xaf, 0x92, 0x80, 0x0b, 0x89, 0x93, 0xab, 0x91, 0x9c,
-	0xae, 0x84, 0x40, 0x02, 0xee, 0x10, 0xa0, 0xb8, 0x5f, 0xed, 0x50, 0x45, 0xaa, 0xb8, 0x2b, 0xb9,
-	0x30, 0xfe, 0x9a, 0xf1, 0x03, 0x60, 0x31, 0x4c, 0x00, 0xcc, 0x18, 0x79, 0x6d, 0xb4, 0x35, 0x82,
-	0x7e, 0x31, 0x56, 0x61, 0x8e, 0x9f, 0x51, 0x41, 0x81, 0x1b, 0xcf, 0xfd, 0x64, 0xa1, 0x6b, 0x4d,
-	0x60, 0x8f, 0x38, 0xa8, 0x88, 0x77, 0x7a, 0x8a, 0xee, 0xca, 0x7d, 0x2a, 0xc0, 0xde, 0x44, 0x97,
-	0x80, 0x0a, 0x9f, 0x46, 0x6d, 0xe2, 0xfb, 0x11, 0x05, 0xc8, 0x59, 0x25, 0xab, 0x9c, 0x6e, 0xfd,
-	0xaf, 0xd5, 0x87, 0x5a, 0xb4, 0x87, 0x68, 0x85, 0x04, 0xb2, 0x27, 0x54, 0x6e, 0xa9, 0xb4, 0x5c,
-	0x5e, 0xad, 0xe5, 0x3d, 0x53, 0x39, 0xe6, 0xf7, 0x0c, 0xbf, 0xd7, 0x90, 0x5c, 0xd4, 0x77, 0x8e,
-	0xbe, 0x15, 0x53, 0xef, 0xbf, 0x17, 0xcb, 0x8c, 0xab, 0xbd, 0x5e, 0xc7, 0xeb, 0xca, 0xc0, 0x60,
-	0x9a, 0xdb, 0x16, 0xf8, 0xfb, 0x58, 0x0d, 0x43, 0x0a, 0xc9, 0x01, 0x78, 0x33, 0x1d, 0x55, 0x32,
-	0x07, 0x94, 0x91, 0xee, 0xb0, 0x1d, 0x4f, 0x00, 0xde, 0x4d, 0x47, 0x15, 0xab, 0x65, 0x0a, 0x6e,
-	0xff, 0xf7, 0xeb, 0x6d, 0x31, 0xe5, 0xae, 0xa3, 0x1b, 0x17, 0xe0, 0xb7, 0x28, 0x84, 0x52, 0x00,
-	0x75, 0x3f, 0x5a, 0xe8, 0x72, 0x13, 0xd8, 0xd3, 0xd0, 0x27, 0x8a, 0x3e, 0x26, 0x11, 0x09, 0xc0,
-	0xbe, 0x8f, 0xd2, 0xa4, 0xa7, 0xf6, 0x64, 0xc4, 0xd5, 0x50, 0x77, 0x55, 0xcf, 0x7d, 0xfe, 0xb0,
-	0x95, 0x35, 0xe4, 0xa6, 0xb5, 0x27, 0x2a, 0xe2, 0x82, 0xb5, 0xce, 0x42, 0xed, 0x07, 0x68, 0x25,
-	0x4c, 0x32, 0xe4, 0x96, 0x4a, 0x56, 0x79, 0xb5, 0x96, 0xf5, 0x66, 0x17, 0xc0, 0xd3, 0xd9, 0xeb,
-	0xe9, 0xb8, 0x4d, 0x43, 0xaa, 0xc3, 0xb7, 0xef, 0x1d, 0x4e, 0x47, 0x95, 0xb3, 0x44, 0x2f, 0xa6,
-	0xa3, 0xca, 0xc6, 0x4c, 0xcb, 0x03, 0x1c, 0x5b, 0x78, 0x01, 0xd3, 0xcd, 0xa3, 0xb5, 0x05, 0xe9,
-	0xa4, 0xab, 0xda, 0x6f, 0x0b, 0x2d, 0x37, 0x81, 0xd9, 0x2f, 0x2d, 0x74, 0xe5, 0xdc, 0x97, 0xdb,
-	0x98, 0xc7, 0xba, 0x60, 0x3a, 0x85, 0xdb, 0xff, 0x0c, 0x39, 0x1d, 0x60, 0xe5, 0xf0, 0xcb, 0xcf,
-	0xd7, 0x4b, 0xb7, 0x5c, 0x17, 0x2f, 0xfc, 0x04, 0xd8, 0x3f, 0x3d, 0xd2, 0x56, 0xba, 0xf2, 0x2e,
-	0xca, 0xcc, 0x0d, 0x7a, 0xfd, 0x5c, 0x99, 0x59, 0xbb, 0xb0, 0xf9, 0x57, 0xfb, 0x84, 0xa0, 0xbe,
-	0x73, 0x34, 0x76, 0xac, 0xe3, 0xb1, 0x63, 0xfd, 0x18, 0x3b, 0xd6, 0xab, 0x89, 0x93, 0x3a, 0x9e,
-	0x38, 0xa9, 0xaf, 0x13, 0x27, 0xf5, 0xec, 0xce, 0xcc, 0x26, 0x35, 0x92, 0x79, 0x36, 0xa4, 0x50,
-	0x11, 0xe9, 0x2a, 0xd0, 0xb4, 0x03, 0xcd, 0x9b, 0xec, 0x54, 0x67, 0x25, 0x59, 0xf8, 0xbb, 0x7f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x00, 0x1f, 0x02, 0x23, 0xce, 0x03, 0x00, 0x00,
+	// 846 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xbf, 0x6f, 0x23, 0x45,
+	0x14, 0xc7, 0x3d, 0x76, 0x88, 0xe4, 0x49, 0xc8, 0xc1, 0x5e, 0x4e, 0xe7, 0x2c, 0x67, 0xc7, 0x59,
+	0x88, 0xce, 0xe7, 0x23, 0x3b, 0x97, 0xf0, 0x53, 0xd7, 0xc5, 0x39, 0x9d, 0x68, 0x22, 0xa1, 0x3d,
+	0x43, 0x41, 0xb3, 0x1a, 0xef, 0x0e, 0xeb, 0x21, 0xde, 0x99, 0xd5, 0xce, 0xac, 0x15, 0x97, 0x5c,
+	0x89, 0x28, 0x90, 0xd0, 0x35, 0x54, 0x94, 0x88, 0xca, 0x05, 0x05, 0x0d, 0xd4, 0x57, 0x9e, 0xa0,
+	0x41, 0x42, 0x02, 0x94, 0x20, 0x99, 0xbf, 0x81, 0x0a, 0xed, 0xec, 0xec, 0x9e, 0x63, 0x5b, 0xe7,
+	0x1c, 0x15, 0x34, 0xfe, 0xf1, 0xbe, 0x6f, 0xde, 0xfb, 0xbc, 0xf7, 0xf6, 0x8d, 0x0d, 0xaf, 0x7d,
+	0x92, 0x30, 0x8e, 0xfc, 0x98, 0x46, 0x68, 0xb8, 0x8f, 0xe4, 0xa9, 0x1d, 0xc5, 0x5c, 0x72, 0x63,
+	0x3d, 0x35, 0xdb, 0xa9, 0xd9, 0x1e, 0xee, 0x9b, 0x9b, 0x01, 0x0f, 0xb8, 0x12, 0x50, 0xfa, 0x29,
+	0xf3, 0x31, 0x6f, 0x04, 0x9c, 0x07, 0x03, 0x82, 0x70, 0x44, 0x11, 0x66, 0x8c, 0x4b, 0x2c, 0x29,
+	0x67, 0x42, 0xab, 0x2f, 0xe3, 0x90, 0x32, 0x8e, 0xd4, 0xab, 0x36, 0x35, 0x3c, 0x2e, 0x42, 0x2e,
+	0x50, 0x0f, 0x0b, 0x82, 0x86, 0xfb, 0x3d, 0x22, 0xf1, 0x3e, 0xf2, 0x38, 0x65, 0x5a, 0xbf, 0xae,
+	0xf5, 0x50, 0x04, 0x29, 0x4c, 0x28, 0x02, 0x2d, 0x6c, 0x65, 0x82, 0x9b, 0x21, 0x64, 0x5f, 0xb4,
+	0x64, 0x5e, 0xe0, 0x0f, 0x08, 0x23, 0x82, 0x6a, 0xcd, 0xfa, 0x01, 0xc0, 0xab, 0xc7, 0x22, 0xb8,
+	0x47, 0x85, 0x8c, 0x69, 0x2f, 0x91, 0xa4, 0xcb, 0x4f, 0x08, 0x13, 0xc6, 0x2e, 0xdc, 0x10, 0x84,
+	0xf9, 0x24, 0x76, 0xb1, 0xef, 0xc7, 0x44, 0x88, 0x1a, 0x68, 0x82, 0x56, 0xd5, 0x79, 0x31, 0xb3,
+	0x1e, 0x66, 0x46, 0x63, 0x04, 0x57, 0x71, 0xc8, 0x13, 0x26, 0x6b, 0xe5, 0x66, 0xa5, 0xb5, 0x76,
+	0xb0, 0x65, 0xeb, 0xcc, 0x29, 0xbf, 0xad, 0xf9, 0xed, 0x23, 0x4e, 0x59, 0xe7, 0xfe, 0xe3, 0xdf,
+	0xb6, 0x4b, 0xdf, 0xfe, 0xbe, 0xdd, 0x0a, 0xa8, 0xec, 0x27, 0x3d, 0xdb, 0xe3, 0xa1, 0xc6, 0xd4,
+	0x6f, 0x7b, 0xc2, 0x3f, 0x41, 0x72, 0x14, 0x11, 0xa1, 0x0e, 0x88, 0xaf, 0x26, 0xe3, 0xf6, 0xfa,
+	0x80, 0x04, 0xd8, 0x1b, 0xb9, 0x69, 0x07, 0xc4, 0x37, 0x93, 0x71, 0x1b, 0x38, 0x3a, 0xe1, 0xdd,
+	0x95, 0xbf, 0xbe, 0xde, 0x2e, 0x59, 0x75, 0xf8, 0xca, 0x02, 0x7c, 0x87, 0x88, 0x88, 0x33, 0x41,
+	0xac, 0xef, 0x01, 0xbc, 0x72, 0x2c, 0x82, 0x0f, 0x22, 0x1f, 0x4b, 0xf2, 0x3e, 0x8e, 0x71, 0x28,
+	0x8c, 0xb7, 0x61, 0x15, 0x27, 0xb2, 0xcf, 0x63, 0x2a, 0x47, 0x59, 0x55, 0x9d, 0xda, 0x4f, 0xdf,
+	0xed, 0x6d, 0x6a, 0x72, 0x5d, 0xda, 0x03, 0x19, 0x53, 0x16, 0x38, 0x4f, 0x5d, 0x8d, 0x77, 0xe0,
+	0x6a, 0xa4, 0x22, 0xd4, 0xca, 0x4d, 0xd0, 0x5a, 0x3b, 0xd8, 0xb4, 0xa7, 0x1f, 0x00, 0x3b, 0x8b,
+	0xde, 0xa9, 0xa6, 0x65, 0x6a, 0xd2, 0xcc, 0xfd, 0xee, 0x9b, 0x0f, 0x27, 0xe3, 0xf6, 0xd3, 0x40,
+	0x9f, 0x4d, 0xc6, 0xed, 0x9d, 0xa9, 0x92, 0x4f, 0x51, 0x2a, 0xa1, 0x19, 0x4c, 0x6b, 0x0b, 0x5e,
+	0x9f, 0x31, 0x15, 0x55, 0x7d, 0x5a, 0x86, 0xd7, 0x8e, 0x45, 0x70, 0x14, 0x13, 0x2c, 0xc9, 0xbd,
+	0x98, 0x46, 0x0f, 0xbc, 0x3e, 0xf1, 0x93, 0x01, 0xf9, 0xef, 0x8f, 0xcd, 0xd8, 0x81, 0xeb, 0x42,
+	0xe2, 0x58, 0xba, 0x7d, 0x42, 0x83, 0xbe, 0xac, 0x55, 0x9a, 0xa0, 0x55, 0x71, 0xd6, 0x94, 0xed,
+	0x3d, 0x65, 0x32, 0xea, 0x10, 0xb2, 0x24, 0x74, 0x7b, 0x03, 0xee, 0x9d, 0x88, 0xda, 0x4a, 0x13,
+	0xb4, 0x56, 0x9c, 0x2a, 0x4b, 0xc2, 0x8e, 0x32, 0xe8, 0xc1, 0x23, 0x58, 0x5f, 0xd8, 0x82, 0xbc,
+	0x49, 0xc6, 0x06, 0x2c, 0x53, 0x5f, 0x95, 0xbf, 0xe2, 0x94, 0xa9, 0x6f, 0x75, 0xb3, 0x9e, 0x61,
+	0xe6, 0x91, 0xc1, 0xbf, 0xe9, 0x59, 0x16, 0xaf, 0x9c, 0xc7, 0xd3, 0x18, 0x8f, 0x00, 0xac, 0x2f,
+	0x0c, 0x5b, 0x70, 0x48, 0x78, 0x25, 0x26, 0x1f, 0x27, 0xcc, 0x27, 0xbe, 0xab, 0x9b, 0x0e, 0x96,
+	0x35, 0xfd, 0xce, 0xf3, 0x36, 0xdd, 0xd9, 0xc8, 0x73, 0x1c, 0xaa, 0x14, 0xd6, 0xdf, 0x00, 0x6e,
+	0x2f, 0x58, 0x8c, 0x2e, 0xff, 0x10, 0x0f, 0xa8, 0x8f, 0x25, 0x8f, 0xff, 0x07, 0x3b, 0x6e, 0x20,
+	0x78, 0x75, 0x98, 0xf3, 0xe6, 0x90, 0x44, 0xd4, 0x2a, 0xcd, 0x4a, 0xab, 0xea, 0x18, 0x85, 0x74,
+	0x98, 0x2b, 0x7a, 0x28, 0xb7, 0xe0, 0xcd, 0x25, 0xb5, 0xe7, 0xd3, 0x39, 0xf8, 0xf5, 0x05, 0x58,
+	0x39, 0x16, 0x81, 0xf1, 0x39, 0x80, 0x2f, 0xcd, 0x5d, 0x82, 0x3b, 0x17, 0x37, 0x7c, 0x41, 0x4c,
+	0xf3, 0xd6, 0x52, 0x97, 0x62, 0x6b, 0xdb, 0x0f, 0x7f, 0xfe, 0xf3, 0xcb, 0xf2, 0x6b, 0x96, 0x85,
+	0x66, 0x7e, 0x4f, 0x90, 0x5f, 0x1c, 0x71, 0x65, 0x96, 0xb9, 0x0b, 0xd7, 0x2f, 0xdc, 0x59, 0xf5,
+	0xb9, 0x34, 0xd3, 0xb2, 0xb9, 0xfb, 0x4c, 0xb9, 0x78, 0x14, 0x1f, 0x01, 0x68, 0x2c, 0xb8, 0x34,
+	0x5e, 0x9d, 0x3b, 0x3d, 0xef, 0x64, 0xde, 0xbe, 0x84, 0x53, 0x51, 0xea, 0x9e, 0x2a, 0xf5, 0xa6,
+	0xb5, 0x3b, 0x57, 0xaa, 0xa7, 0x0e, 0xb9, 0xa9, 0xc5, 0x15, 0x39, 0x80, 0xe2, 0x9a, 0x5f, 0xcc,
+	0x05, 0x5c, 0x73, 0x4e, 0xe6, 0xed, 0x4b, 0x38, 0x5d, 0x86, 0x4b, 0x1d, 0x9a, 0xe1, 0xfa, 0x11,
+	0xc0, 0x1b, 0xcf, 0xdc, 0xa0, 0xbd, 0xa5, 0xd3, 0x9f, 0x76, 0x37, 0xdf, 0x7a, 0x2e, 0xf7, 0x82,
+	0xfa, 0x5d, 0x45, 0x7d, 0x60, 0xdd, 0x59, 0xfe, 0xe0, 0xb8, 0x92, 0xbb, 0xc5, 0x5a, 0x88, 0xce,
+	0xfd, 0xc7, 0x67, 0x0d, 0xf0, 0xe4, 0xac, 0x01, 0xfe, 0x38, 0x6b, 0x80, 0x2f, 0xce, 0x1b, 0xa5,
+	0x27, 0xe7, 0x8d, 0xd2, 0x2f, 0xe7, 0x8d, 0xd2, 0x47, 0xaf, 0x4f, 0x6d, 0xe8, 0x91, 0x5a, 0xcd,
+	0x23, 0xce, 0x64, 0x8c, 0x3d, 0x29, 0xb2, 0x2c, 0xa7, 0x59, 0x1e, 0xb5, 0xab, 0xbd, 0x55, 0xf5,
+	0x67, 0xe1, 0x8d, 0x7f, 0x06, 0x00, 0xac, 0xd7, 0xc5, 0xdf, 0x0a, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -284,6 +636,15 @@ type MsgClient interface {
 	// DistributeTokens distribute the sent tokens to all stakers in the next block
 	DistributeTokens(ctx context.Context, in *MsgDistributeTokens, opts ...grpc.CallOption) (*MsgDistributeTokensResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateDripSchedule escrows the sent tokens and distributes them to all
+	// stakers pro rata over a number of blocks
+	CreateDripSchedule(ctx context.Context, in *MsgCreateDripSchedule, opts ...grpc.CallOption) (*MsgCreateDripScheduleResponse, error)
+	// CancelDripSchedule cancels a drip schedule and refunds the tokens not
+	// distributed yet to its sender
+	CancelDripSchedule(ctx context.Context, in *MsgCancelDripSchedule, opts ...grpc.CallOption) (*MsgCancelDripScheduleResponse, error)
+	// DistributeTokensToValidators distribute the sent tokens to the delegators
+	// of the given validators, bypassing the validators commission
+	DistributeTokensToValidators(ctx context.Context, in *MsgDistributeTokensToValidators, opts ...grpc.CallOption) (*MsgDistributeTokensToValidatorsResponse, error)
 }
 
 type msgClient struct {
@@ -312,11 +673,47 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) CreateDripSchedule(ctx context.Context, in *MsgCreateDripSchedule, opts ...grpc.CallOption) (*MsgCreateDripScheduleResponse, error) {
+	out := new(MsgCreateDripScheduleResponse)
+	err := c.cc.Invoke(ctx, "/juno.drip.v1.Msg/CreateDripSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDripSchedule(ctx context.Context, in *MsgCancelDripSchedule, opts ...grpc.CallOption) (*MsgCancelDripScheduleResponse, error) {
+	out := new(MsgCancelDripScheduleResponse)
+	err := c.cc.Invoke(ctx, "/juno.drip.v1.Msg/CancelDripSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DistributeTokensToValidators(ctx context.Context, in *MsgDistributeTokensToValidators, opts ...grpc.CallOption) (*MsgDistributeTokensToValidatorsResponse, error) {
+	out := new(MsgDistributeTokensToValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/juno.drip.v1.Msg/DistributeTokensToValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// DistributeTokens distribute the sent tokens to all stakers in the next block
 	DistributeTokens(context.Context, *MsgDistributeTokens) (*MsgDistributeTokensResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateDripSchedule escrows the sent tokens and distributes them to all
+	// stakers pro rata over a number of blocks
+	CreateDripSchedule(context.Context, *MsgCreateDripSchedule) (*MsgCreateDripScheduleResponse, error)
+	// CancelDripSchedule cancels a drip schedule and refunds the tokens not
+	// distributed yet to its sender
+	CancelDripSchedule(context.Context, *MsgCancelDripSchedule) (*MsgCancelDripScheduleResponse, error)
+	// DistributeTokensToValidators distribute the sent tokens to the delegators
+	// of the given validators, bypassing the validators commission
+	DistributeTokensToValidators(context.Context, *MsgDistributeTokensToValidators) (*MsgDistributeTokensToValidatorsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -329,6 +726,15 @@ func (*UnimplementedMsgServer) DistributeTokens(ctx context.Context, req *MsgDis
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) CreateDripSchedule(ctx context.Context, req *MsgCreateDripSchedule) (*MsgCreateDripScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDripSchedule not implemented")
+}
+func (*UnimplementedMsgServer) CancelDripSchedule(ctx context.Context, req *MsgCancelDripSchedule) (*MsgCancelDripScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDripSchedule not implemented")
+}
+func (*UnimplementedMsgServer) DistributeTokensToValidators(ctx context.Context, req *MsgDistributeTokensToValidators) (*MsgDistributeTokensToValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributeTokensToValidators not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -370,6 +776,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateDripSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDripSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDripSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.drip.v1.Msg/CreateDripSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDripSchedule(ctx, req.(*MsgCreateDripSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDripSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDripSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDripSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.drip.v1.Msg/CancelDripSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDripSchedule(ctx, req.(*MsgCancelDripSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DistributeTokensToValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDistributeTokensToValidators)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DistributeTokensToValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.drip.v1.Msg/DistributeTokensToValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DistributeTokensToValidators(ctx, req.(*MsgDistributeTokensToValidators))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "juno.drip.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -382,6 +842,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "CreateDripSchedule",
+			Handler:    _Msg_CreateDripSchedule_Handler,
+		},
+		{
+			MethodName: "CancelDripSchedule",
+			Handler:    _Msg_CancelDripSchedule_Handler,
+		},
+		{
+			MethodName: "DistributeTokensToValidators",
+			Handler:    _Msg_DistributeTokensToValidators_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "juno/drip/v1/tx.proto",
@@ -517,6 +989,236 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCreateDripSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDripSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDripSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDripScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDripScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDripScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDripSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDripSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDripSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDripScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDripScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDripScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundedAmount) > 0 {
+		for iNdEx := len(m.RefundedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDistributeTokensToValidators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDistributeTokensToValidators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDistributeTokensToValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddresses) > 0 {
+		for iNdEx := len(m.ValidatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorAddresses[iNdEx])
+			copy(dAtA[i:], m.ValidatorAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDistributeTokensToValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDistributeTokensToValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDistributeTokensToValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -544,49 +1246,705 @@ func (m *MsgDistributeTokens) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgDistributeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateDripSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.NumBlocks != 0 {
+		n += 1 + sovTx(uint64(m.NumBlocks))
+	}
+	return n
+}
+
+func (m *MsgCreateDripScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelDripSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelDripScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RefundedAmount) > 0 {
+		for _, e := range m.RefundedAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDistributeTokensToValidators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ValidatorAddresses) > 0 {
+		for _, s := range m.ValidatorAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDistributeTokensToValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgDistributeTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDistributeTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDistributeTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDistributeTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDistributeTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDistributeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDripSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDripSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDripSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgDistributeTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateDripScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDripScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDripScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgDistributeTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelDripSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -609,10 +1967,10 @@ func (m *MsgDistributeTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDistributeTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelDripSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDistributeTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelDripSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -648,10 +2006,10 @@ func (m *MsgDistributeTokens) Unmarshal(dAtA []byte) error {
 			m.SenderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -661,26 +2019,11 @@ func (m *MsgDistributeTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -702,7 +2045,7 @@ func (m *MsgDistributeTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDistributeTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelDripScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -725,12 +2068,46 @@ func (m *MsgDistributeTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDistributeTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelDripScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDistributeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelDripScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedAmount = append(m.RefundedAmount, types.Coin{})
+			if err := m.RefundedAmount[len(m.RefundedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -752,7 +2129,7 @@ func (m *MsgDistributeTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgDistributeTokensToValidators) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -775,15 +2152,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDistributeTokensToValidators: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDistributeTokensToValidators: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -811,11 +2188,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -842,10 +2219,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddresses = append(m.ValidatorAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -867,7 +2277,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDistributeTokensToValidatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -890,10 +2300,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDistributeTokensToValidatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDistributeTokensToValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
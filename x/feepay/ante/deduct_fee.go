@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"math"
 
-	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
-
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
@@ -160,46 +158,15 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 
 // Handle zero fee transactions for fee prepay module
 func (dfd DeductFeeDecorator) handleZeroFees(ctx sdk.Context, deductFeesFromAcc types.AccountI, tx sdk.Tx, _ sdk.Coins) error {
-	msg := tx.GetMsgs()[0]
-	cw := msg.(*wasmtypes.MsgExecuteContract)
-
-	// Get the fee pay contract
-	feepayContract, err := dfd.feepayKeeper.GetContract(ctx, cw.GetContract())
-	if err != nil {
-		return errorsmod.Wrapf(err, "error getting contract %s", cw.GetContract())
-	}
-
-	// Get the fee price in the chain denom
-	feePrice := sdk.DecCoin{}
-	for _, c := range dfd.globalfeeKeeper.GetParams(ctx).MinimumGasPrices {
-		if c.Denom == dfd.bondDenom {
-			feePrice = c
-		}
-	}
-
-	if feePrice == (sdk.DecCoin{}) {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "fee price not found for denom %s in globalfee keeper", dfd.bondDenom)
-	}
-
-	// Get the tx gas
+	// Get the fee pay contract covering the fee, and the fee it covers
 	feeTx := tx.(sdk.FeeTx)
-	gas := sdkmath.LegacyNewDec(int64(feeTx.GetGas()))
-
-	requiredFee := feePrice.Amount.Mul(gas).Ceil().RoundInt()
-
-	// Check if wallet exceeded usage limit on contract
-	accBech32 := deductFeesFromAcc.GetAddress().String()
-	if dfd.feepayKeeper.HasWalletExceededUsageLimit(ctx, feepayContract, accBech32) {
-		return errorsmod.Wrapf(feepaytypes.ErrWalletExceededUsageLimit, "wallet has exceeded usage limit (%d)", feepayContract.WalletLimit)
-	}
-
-	// Check if the contract has enough funds to cover the fee
-	if !dfd.feepayKeeper.CanContractCoverFee(feepayContract, requiredFee.Uint64()) {
-		return errorsmod.Wrapf(feepaytypes.ErrContractNotEnoughFunds, "contract has insufficient funds; expected: %d, got: %d", requiredFee.Uint64(), feepayContract.Balance)
+	feepayContract, requiredFee, err := dfd.feepayKeeper.GetSponsoringContract(ctx, feeTx, dfd.globalfeeKeeper.GetGlobalMinGasPrices(ctx))
+	if err != nil {
+		return err
 	}
 
 	// Create an array of coins, storing the required fee
-	payment := sdk.NewCoins(sdk.NewCoin(feePrice.Denom, requiredFee))
+	payment := sdk.NewCoins(requiredFee)
 
 	// Cover the fees of the transaction, send from FeePay Module to FeeCollector Module
 	if err := dfd.bankKeeper.SendCoinsFromModuleToModule(ctx, feepaytypes.ModuleName, types.FeeCollectorName, payment); err != nil {
@@ -207,10 +174,10 @@ func (dfd DeductFeeDecorator) handleZeroFees(ctx sdk.Context, deductFeesFromAcc
 	}
 
 	// Deduct the fee from the contract balance
-	dfd.feepayKeeper.SetContractBalance(ctx, feepayContract, feepayContract.Balance-requiredFee.Uint64())
+	dfd.feepayKeeper.SetContractBalance(ctx, feepayContract, feepayContract.Balance-requiredFee.Amount.Uint64())
 
 	// Increment wallet usage
-	if err := dfd.feepayKeeper.IncrementContractUses(ctx, feepayContract, accBech32, 1); err != nil {
+	if err := dfd.feepayKeeper.IncrementContractUses(ctx, feepayContract, deductFeesFromAcc.GetAddress().String(), 1); err != nil {
 		return errorsmod.Wrapf(err, "error incrementing contract uses")
 	}
 
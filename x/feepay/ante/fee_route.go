@@ -6,7 +6,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	feepayhelpers "github.com/CosmosContracts/juno/v26/x/feepay/helpers"
 	feepaykeeper "github.com/CosmosContracts/juno/v26/x/feepay/keeper"
 	globalfeeante "github.com/CosmosContracts/juno/v26/x/globalfee/ante"
 )
@@ -46,7 +45,7 @@ func (mfd MsgIsFeePayTx) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, n
 	}
 
 	// Flag a transaction as a fee pay transaction
-	*mfd.isFeePayTx = feepayhelpers.IsValidFeePayTransaction(ctx, mfd.feePayKeeper, feeTx)
+	*mfd.isFeePayTx = mfd.feePayKeeper.IsValidFeePayTransaction(ctx, feeTx)
 
 	// If a FeePayTx, call FeePay decorator then global fee decorator.
 	// Otherwise, call global fee decorator then FeePay decorator.
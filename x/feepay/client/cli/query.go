@@ -28,6 +28,7 @@ func NewQueryCmd() *cobra.Command {
 		NewQueryFeePayContractUsage(),
 		NewQueryWalletIsEligible(),
 		GetCmdQueryParams(),
+		GetCmdQueryInvariants(),
 	)
 
 	return feepayQueryCmd
@@ -201,3 +202,30 @@ func GetCmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryInvariants runs the feepay module invariants
+func GetCmdQueryInvariants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants",
+		Short: "Check the feepay module invariants without halting the chain",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Invariants(context.Background(), &types.QueryInvariantsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
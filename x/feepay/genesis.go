@@ -1,6 +1,10 @@
 package feepay
 
 import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/CosmosContracts/juno/v26/x/feepay/keeper"
@@ -17,19 +21,34 @@ func InitGenesis(
 		panic(err)
 	}
 
+	// Ensure the module account can back every contract balance
+	ledger := sdkmath.ZeroInt()
+	for _, feepay := range data.FeePayContracts {
+		ledger = ledger.Add(sdkmath.NewIntFromUint64(feepay.Balance))
+	}
+
+	if balance := k.GetModuleBalance(ctx); balance.Amount.LT(ledger) {
+		panic(fmt.Errorf("feepay contract balances (%s) exceed the module account balance (%s)", ledger, balance))
+	}
+
 	for _, feepay := range data.FeePayContracts {
-		// TODO: future, add all wallet interactions for exports?
 		k.SetFeePayContract(ctx, feepay)
 	}
+
+	for _, usage := range data.FeePayWalletUsages {
+		k.SetWalletUsage(ctx, usage)
+	}
 }
 
 // ExportGenesis export module state
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	params := k.GetParams(ctx)
 	contracts := k.GetAllContracts(ctx)
+	usages := k.GetAllWalletUsages(ctx)
 
 	return &types.GenesisState{
-		Params:          params,
-		FeePayContracts: contracts,
+		Params:             params,
+		FeePayContracts:    contracts,
+		FeePayWalletUsages: usages,
 	}
 }
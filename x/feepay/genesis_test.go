@@ -8,7 +8,9 @@ import (
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/CosmosContracts/juno/v26/app"
 	"github.com/CosmosContracts/juno/v26/x/feepay"
@@ -72,3 +74,139 @@ func (suite *GenesisTestSuite) TestFeeShareInitGenesis() {
 		})
 	}
 }
+
+func (suite *GenesisTestSuite) TestFeePayGenesisRoundTrip() {
+	_, _, contractA := testdata.KeyTestPubAddr()
+	_, _, contractB := testdata.KeyTestPubAddr()
+	_, _, wallet := testdata.KeyTestPubAddr()
+
+	genesis := types.GenesisState{
+		Params: types.Params{EnableFeepay: true},
+		FeePayContracts: []types.FeePayContract{
+			{ContractAddress: contractA.String(), Balance: 700, WalletLimit: 5},
+			{ContractAddress: contractB.String(), Balance: 300, WalletLimit: 1},
+		},
+		FeePayWalletUsages: []types.FeePayWalletUsage{
+			{ContractAddress: contractA.String(), WalletAddress: wallet.String(), Uses: 4},
+			{ContractAddress: contractB.String(), WalletAddress: wallet.String(), Uses: 1},
+		},
+	}
+	suite.Require().NoError(genesis.Validate())
+
+	suite.Run("Fail - Module Account Does Not Cover Balances", func() {
+		suite.SetupTest() // reset
+
+		suite.Require().Panics(func() {
+			feepay.InitGenesis(suite.ctx, suite.app.AppKeepers.FeePayKeeper, genesis)
+		})
+	})
+
+	suite.Run("Success - Balances And Usages Exported", func() {
+		suite.SetupTest() // reset
+
+		coins := sdk.NewCoins(sdk.NewCoin(suite.app.AppKeepers.StakingKeeper.BondDenom(suite.ctx), sdk.NewInt(1_000)))
+		suite.Require().NoError(suite.app.AppKeepers.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, coins))
+		suite.Require().NoError(suite.app.AppKeepers.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, minttypes.ModuleName, types.ModuleName, coins))
+
+		suite.Require().NotPanics(func() {
+			feepay.InitGenesis(suite.ctx, suite.app.AppKeepers.FeePayKeeper, genesis)
+		})
+
+		exported := feepay.ExportGenesis(suite.ctx, suite.app.AppKeepers.FeePayKeeper)
+		suite.Require().Equal(genesis.Params, exported.Params)
+		suite.Require().ElementsMatch(genesis.FeePayContracts, exported.FeePayContracts)
+		suite.Require().ElementsMatch(genesis.FeePayWalletUsages, exported.FeePayWalletUsages)
+
+		fpc, err := suite.app.AppKeepers.FeePayKeeper.GetContract(suite.ctx, contractA.String())
+		suite.Require().NoError(err)
+		uses, err := suite.app.AppKeepers.FeePayKeeper.GetContractUses(suite.ctx, fpc, wallet.String())
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(4), uses)
+	})
+}
+
+func (suite *GenesisTestSuite) TestFeePayGenesisValidate() {
+	_, _, contract := testdata.KeyTestPubAddr()
+	_, _, wallet := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		name     string
+		genesis  types.GenesisState
+		expError bool
+	}{
+		{
+			"Success - Default Genesis",
+			*types.DefaultGenesisState(),
+			false,
+		},
+		{
+			"Success - Contract With Usage",
+			types.NewGenesisState(
+				types.Params{EnableFeepay: true},
+				[]types.FeePayContract{{ContractAddress: contract.String(), WalletLimit: 1}},
+				[]types.FeePayWalletUsage{{ContractAddress: contract.String(), WalletAddress: wallet.String(), Uses: 1}},
+			),
+			false,
+		},
+		{
+			"Fail - Invalid Contract Address",
+			types.NewGenesisState(
+				types.Params{EnableFeepay: true},
+				[]types.FeePayContract{{ContractAddress: "invalid"}},
+				nil,
+			),
+			true,
+		},
+		{
+			"Fail - Duplicate Contract",
+			types.NewGenesisState(
+				types.Params{EnableFeepay: true},
+				[]types.FeePayContract{{ContractAddress: contract.String()}, {ContractAddress: contract.String()}},
+				nil,
+			),
+			true,
+		},
+		{
+			"Fail - Usage For Unknown Contract",
+			types.NewGenesisState(
+				types.Params{EnableFeepay: true},
+				nil,
+				[]types.FeePayWalletUsage{{ContractAddress: contract.String(), WalletAddress: wallet.String(), Uses: 1}},
+			),
+			true,
+		},
+		{
+			"Fail - Invalid Wallet Address",
+			types.NewGenesisState(
+				types.Params{EnableFeepay: true},
+				[]types.FeePayContract{{ContractAddress: contract.String()}},
+				[]types.FeePayWalletUsage{{ContractAddress: contract.String(), WalletAddress: "invalid", Uses: 1}},
+			),
+			true,
+		},
+		{
+			"Fail - Duplicate Usage",
+			types.NewGenesisState(
+				types.Params{EnableFeepay: true},
+				[]types.FeePayContract{{ContractAddress: contract.String()}},
+				[]types.FeePayWalletUsage{
+					{ContractAddress: contract.String(), WalletAddress: wallet.String(), Uses: 1},
+					{ContractAddress: contract.String(), WalletAddress: wallet.String(), Uses: 2},
+				},
+			),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			err := tc.genesis.Validate()
+			if tc.expError {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
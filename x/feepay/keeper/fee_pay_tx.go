@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmosContracts/juno/v26/x/feepay/types"
+)
+
+// Check if a transaction should be processed as a FeePay transaction.
+// A valid FeePay transaction has no fee and only 1 message which
+// executes a CW contract.
+//
+// TODO: Future allow for multiple msgs.
+func (k Keeper) IsValidFeePayTransaction(ctx sdk.Context, feeTx sdk.FeeTx) bool {
+	// Defaults to false
+	isValid := false
+
+	// Check if the fee pay module is enabled
+	isEnabled := k.GetParams(ctx).EnableFeepay
+
+	// Check if fee is zero, and tx has only 1 message for executing a contract
+	if isEnabled && feeTx.GetFee().IsZero() && len(feeTx.GetMsgs()) == 1 {
+		// Check if the message is a CW contract execution
+		if cw, ok := (feeTx.GetMsgs()[0]).(*wasmtypes.MsgExecuteContract); ok {
+			// Check if the contract is registered
+			if _, err := k.GetContract(ctx, cw.Contract); err == nil {
+				isValid = true
+			}
+		}
+	}
+
+	// Return if the tx is valid
+	return isValid
+}
+
+// GetSponsoringContract returns the FeePay contract covering the fee of a FeePay
+// transaction, along with the fee it covers at the given gas prices. The wallet
+// paying the fee, the fee granter if any, must be within the usage limit of the
+// contract, and the contract balance must cover the fee in the bond denom.
+func (k Keeper) GetSponsoringContract(ctx sdk.Context, feeTx sdk.FeeTx, gasPrices sdk.DecCoins) (*types.FeePayContract, sdk.Coin, error) {
+	if !k.IsValidFeePayTransaction(ctx, feeTx) {
+		return nil, sdk.Coin{}, types.ErrNotFeePayTx
+	}
+
+	cw := feeTx.GetMsgs()[0].(*wasmtypes.MsgExecuteContract)
+
+	// Get the fee pay contract
+	feepayContract, err := k.GetContract(ctx, cw.GetContract())
+	if err != nil {
+		return nil, sdk.Coin{}, errorsmod.Wrapf(err, "error getting contract %s", cw.GetContract())
+	}
+
+	// Get the fee price in the chain denom
+	feePrice := gasPrices.AmountOf(k.bondDenom)
+	if !feePrice.IsPositive() {
+		return nil, sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "fee price not found for denom %s in globalfee keeper", k.bondDenom)
+	}
+
+	requiredFee := feePrice.MulInt64(int64(feeTx.GetGas())).Ceil().RoundInt()
+
+	payer := feeTx.FeePayer()
+	if feeTx.FeeGranter() != nil {
+		payer = feeTx.FeeGranter()
+	}
+
+	// Check if wallet exceeded usage limit on contract
+	if k.HasWalletExceededUsageLimit(ctx, feepayContract, payer.String()) {
+		return nil, sdk.Coin{}, errorsmod.Wrapf(types.ErrWalletExceededUsageLimit, "wallet has exceeded usage limit (%d)", feepayContract.WalletLimit)
+	}
+
+	// Check if the contract has enough funds to cover the fee
+	if !k.CanContractCoverFee(feepayContract, requiredFee.Uint64()) {
+		return nil, sdk.Coin{}, errorsmod.Wrapf(types.ErrContractNotEnoughFunds, "contract has insufficient funds; expected: %d, got: %d", requiredFee.Uint64(), feepayContract.Balance)
+	}
+
+	return feepayContract, sdk.NewCoin(k.bondDenom, requiredFee), nil
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/app"
+	"github.com/CosmosContracts/juno/v26/x/feepay/types"
+)
+
+func (s *IntegrationTestSuite) TestGetSponsoringContract() {
+	// Get & fund creator
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000)), sdk.NewCoin("ujuno", sdk.NewInt(100_000_000))))
+
+	// Instantiate & register the contract with a limit of one use per wallet
+	contractAddr := s.InstantiateContract(sender.String(), "")
+	s.registerFeePayContract(sender.String(), contractAddr, 0, 1)
+
+	k := s.app.AppKeepers.FeePayKeeper
+	bondDenom := s.app.AppKeepers.StakingKeeper.BondDenom(s.ctx)
+	gasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(bondDenom, sdk.NewDecWithPrec(1, 1)))
+
+	newTx := func(fee sdk.Coins, msgs ...sdk.Msg) sdk.FeeTx {
+		txBuilder := app.MakeEncodingConfig().TxConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(msgs...))
+		txBuilder.SetGasLimit(100_000)
+		txBuilder.SetFeeAmount(fee)
+		return txBuilder.GetTx()
+	}
+	execute := &wasmtypes.MsgExecuteContract{
+		Sender:   sender.String(),
+		Contract: contractAddr,
+		Msg:      []byte(`{}`),
+	}
+	feePayTx := newTx(nil, execute)
+
+	// Only free txs executing a single registered contract are fee pay txs
+	_, _, err := k.GetSponsoringContract(s.ctx, newTx(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1)), execute), gasPrices)
+	s.Require().ErrorIs(err, types.ErrNotFeePayTx)
+	_, _, err = k.GetSponsoringContract(s.ctx, newTx(nil, execute, execute), gasPrices)
+	s.Require().ErrorIs(err, types.ErrNotFeePayTx)
+
+	// The contract must cover the fee
+	_, _, err = k.GetSponsoringContract(s.ctx, feePayTx, gasPrices)
+	s.Require().ErrorIs(err, types.ErrContractNotEnoughFunds)
+
+	_, err = k.FundFeePayContract(s.ctx, &types.MsgFundFeePayContract{
+		SenderAddress:   sender.String(),
+		ContractAddress: contractAddr,
+		Amount:          sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(1_000_000))),
+	})
+	s.Require().NoError(err)
+
+	fpc, fee, err := k.GetSponsoringContract(s.ctx, feePayTx, gasPrices)
+	s.Require().NoError(err)
+	s.Require().Equal(contractAddr, fpc.ContractAddress)
+	s.Require().Equal(sdk.NewInt64Coin(bondDenom, 10_000), fee)
+
+	// The fee is priced in the bond denom
+	_, _, err = k.GetSponsoringContract(s.ctx, feePayTx, sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.OneDec())))
+	s.Require().Error(err)
+
+	// The wallet must be within the usage limit of the contract
+	s.Require().NoError(k.IncrementContractUses(s.ctx, fpc, sender.String(), 1))
+	_, _, err = k.GetSponsoringContract(s.ctx, feePayTx, gasPrices)
+	s.Require().ErrorIs(err, types.ErrWalletExceededUsageLimit)
+}
@@ -157,12 +157,19 @@ func (k Keeper) UnregisterContract(ctx sdk.Context, rfp *types.MsgUnregisterFeeP
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), StoreKeyContracts)
 	store.Delete([]byte(rfp.ContractAddress))
 
-	// Remove all usage entries for contract
+	// Remove all usage entries for contract. Keys are collected first so the
+	// store is not mutated while it is being iterated.
 	store = prefix.NewStore(ctx.KVStore(k.storeKey), StoreKeyContractUses)
-	iterator := sdk.KVStorePrefixIterator(store, []byte(rfp.ContractAddress))
+	iterator := sdk.KVStorePrefixIterator(store, walletUsageKey(rfp.ContractAddress, ""))
 
+	var usageKeys [][]byte
 	for ; iterator.Valid(); iterator.Next() {
-		store.Delete(iterator.Key())
+		usageKeys = append(usageKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range usageKeys {
+		store.Delete(key)
 	}
 
 	// Calculate coins to refund
@@ -215,6 +222,12 @@ func (k Keeper) FundContract(ctx sdk.Context, fpc *types.FeePayContract, senderA
 	return nil
 }
 
+// GetModuleBalance returns the bond denom balance held by the FeePay module account
+func (k Keeper) GetModuleBalance(ctx sdk.Context) sdk.Coin {
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	return k.bankKeeper.GetBalance(ctx, moduleAddr, k.bondDenom)
+}
+
 // Check if a fee pay contract has a balance greater than or equal to the fee
 func (k Keeper) CanContractCoverFee(fpc *types.FeePayContract, fee uint64) bool {
 	return fpc.Balance >= fee
@@ -224,8 +237,7 @@ func (k Keeper) CanContractCoverFee(fpc *types.FeePayContract, fee uint64) bool
 func (k Keeper) GetContractUses(ctx sdk.Context, fpc *types.FeePayContract, walletAddress string) (uint64, error) {
 	// Get usage from store
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), StoreKeyContractUses)
-	key := []byte(fpc.ContractAddress + "-" + walletAddress)
-	bz := store.Get(key)
+	bz := store.Get(walletUsageKey(fpc.ContractAddress, walletAddress))
 
 	var walletUsage types.FeePayWalletUsage
 	if err := k.cdc.Unmarshal(bz, &walletUsage); err != nil {
@@ -244,7 +256,7 @@ func (k Keeper) IncrementContractUses(ctx sdk.Context, fpc *types.FeePayContract
 
 	// Get store, key, & value for setting usage
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), StoreKeyContractUses)
-	key := []byte(fpc.ContractAddress + "-" + walletAddress)
+	key := walletUsageKey(fpc.ContractAddress, walletAddress)
 	bz, err := k.cdc.Marshal(&types.FeePayWalletUsage{
 		ContractAddress: fpc.ContractAddress,
 		WalletAddress:   walletAddress,
@@ -258,6 +270,46 @@ func (k Keeper) IncrementContractUses(ctx sdk.Context, fpc *types.FeePayContract
 	return nil
 }
 
+// Set a wallet usage in the KV store
+func (k Keeper) SetWalletUsage(ctx sdk.Context, usage types.FeePayWalletUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), StoreKeyContractUses)
+	key := walletUsageKey(usage.ContractAddress, usage.WalletAddress)
+	bz := k.cdc.MustMarshal(&usage)
+	store.Set(key, bz)
+}
+
+// GetAllWalletUsages returns the usages of every wallet on every FeePay contract.
+func (k Keeper) GetAllWalletUsages(ctx sdk.Context) []types.FeePayWalletUsage {
+	usages := []types.FeePayWalletUsage{}
+
+	k.IterateWalletUsages(ctx, func(_ []byte, usage types.FeePayWalletUsage) bool {
+		usages = append(usages, usage)
+		return false
+	})
+
+	return usages
+}
+
+// IterateWalletUsages iterates over all stored wallet usages and performs a
+// callback with the store key and the corresponding FeePayWalletUsage.
+func (k Keeper) IterateWalletUsages(
+	ctx sdk.Context,
+	handlerFn func(key []byte, usage types.FeePayWalletUsage) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), StoreKeyContractUses)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.FeePayWalletUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+
+		if handlerFn(iterator.Key(), usage) {
+			break
+		}
+	}
+}
+
 // Check if a wallet exceeded usage limit (defaults to true if contract not registered)
 func (k Keeper) HasWalletExceededUsageLimit(ctx sdk.Context, fpc *types.FeePayContract, walletAddress string) bool {
 	// Get account uses
@@ -324,3 +376,8 @@ func (k Keeper) IsContractManager(senderAddress string, contractInfo *wasmtypes.
 
 	return true, nil
 }
+
+// walletUsageKey returns the usage store key of a wallet on a fee pay contract
+func walletUsageKey(contractAddress string, walletAddress string) []byte {
+	return []byte(contractAddress + "-" + walletAddress)
+}
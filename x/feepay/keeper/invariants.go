@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/feepay/types"
+)
+
+const (
+	// ModuleBalanceInvariantRoute is the route of the module balance invariant
+	ModuleBalanceInvariantRoute = "module-balance"
+	// WalletUsageInvariantRoute is the route of the orphaned wallet usage invariant
+	WalletUsageInvariantRoute = "wallet-usage"
+)
+
+// invariantRoute pairs a FeePay invariant with the route it is registered under.
+type invariantRoute struct {
+	route     string
+	invariant sdk.Invariant
+}
+
+// invariantRoutes returns every FeePay invariant in registration order.
+func invariantRoutes(k Keeper) []invariantRoute {
+	return []invariantRoute{
+		{ModuleBalanceInvariantRoute, ModuleBalanceInvariant(k)},
+		{WalletUsageInvariantRoute, WalletUsageInvariant(k)},
+	}
+}
+
+// RegisterInvariants registers all FeePay invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for _, r := range invariantRoutes(k) {
+		ir.RegisterRoute(types.ModuleName, r.route, r.invariant)
+	}
+}
+
+// AllInvariants runs all invariants of the FeePay module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, r := range invariantRoutes(k) {
+			if res, stop := r.invariant(ctx); stop {
+				return res, stop
+			}
+		}
+
+		return "", false
+	}
+}
+
+// CheckInvariants runs every FeePay invariant against the given context and
+// reports the outcome of each, without halting on a broken invariant.
+func (k Keeper) CheckInvariants(ctx sdk.Context) []types.InvariantResult {
+	routes := invariantRoutes(k)
+	results := make([]types.InvariantResult, 0, len(routes))
+	for _, r := range routes {
+		msg, broken := r.invariant(ctx)
+		results = append(results, types.InvariantResult{
+			Route:   r.route,
+			Broken:  broken,
+			Message: msg,
+		})
+	}
+
+	return results
+}
+
+// ModuleBalanceInvariant checks that the module account holds enough of the bond
+// denom to cover the ledger balance of every registered fee pay contract. The
+// module account is allowed to receive funds, so it may hold more than the sum
+// of the contract balances, but never less.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		ledger := sdkmath.ZeroInt()
+		for _, c := range k.GetAllContracts(ctx) {
+			ledger = ledger.Add(sdkmath.NewIntFromUint64(c.Balance))
+		}
+
+		balance := k.GetModuleBalance(ctx)
+
+		broken := balance.Amount.LT(ledger)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, ModuleBalanceInvariantRoute,
+			fmt.Sprintf("\tsum of contract balances: %s%s\n\tmodule account balance: %s\n", ledger, k.bondDenom, balance),
+		), broken
+	}
+}
+
+// WalletUsageInvariant checks that every wallet usage entry belongs to a registered
+// fee pay contract and is stored under the key derived from its contents.
+func WalletUsageInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.IterateWalletUsages(ctx, func(key []byte, usage types.FeePayWalletUsage) bool {
+			switch {
+			case !k.IsContractRegistered(ctx, usage.ContractAddress):
+				count++
+				msg += fmt.Sprintf("\twallet %s has usage on unregistered contract %s\n", usage.WalletAddress, usage.ContractAddress)
+			case string(key) != string(walletUsageKey(usage.ContractAddress, usage.WalletAddress)):
+				count++
+				msg += fmt.Sprintf("\twallet usage for %s on %s stored under mismatched key %s\n", usage.WalletAddress, usage.ContractAddress, key)
+			}
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, WalletUsageInvariantRoute,
+			fmt.Sprintf("found %d orphaned wallet usage entries\n%s", count, msg),
+		), broken
+	}
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/feepay/keeper"
+	"github.com/CosmosContracts/juno/v26/x/feepay/types"
+)
+
+func (s *IntegrationTestSuite) TestInvariants() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, wallet := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000)), sdk.NewCoin("ujuno", sdk.NewInt(100_000_000))))
+
+	contract := s.InstantiateContract(sender.String(), "")
+	s.registerFeePayContract(sender.String(), contract, 0, 5)
+
+	_, err := s.app.AppKeepers.FeePayKeeper.FundFeePayContract(s.ctx, &types.MsgFundFeePayContract{
+		SenderAddress:   sender.String(),
+		ContractAddress: contract,
+		Amount:          sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(1_000_000))),
+	})
+	s.Require().NoError(err)
+
+	fpc, err := s.app.AppKeepers.FeePayKeeper.GetContract(s.ctx, contract)
+	s.Require().NoError(err)
+	s.Require().NoError(s.app.AppKeepers.FeePayKeeper.IncrementContractUses(s.ctx, fpc, wallet.String(), 1))
+
+	for _, tc := range []struct {
+		desc         string
+		malleate     func(ctx sdk.Context)
+		brokenModBal bool
+		brokenUsages bool
+	}{
+		{
+			desc:     "Success - Consistent State",
+			malleate: func(_ sdk.Context) {},
+		},
+		{
+			desc: "Success - Module Holds Surplus Funds",
+			malleate: func(ctx sdk.Context) {
+				err := s.FundAccount(ctx, s.app.AppKeepers.AccountKeeper.GetModuleAddress(types.ModuleName), sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(500))))
+				s.Require().NoError(err)
+			},
+		},
+		{
+			desc: "Fail - Contract Balance Exceeds Module Balance",
+			malleate: func(ctx sdk.Context) {
+				fpc, err := s.app.AppKeepers.FeePayKeeper.GetContract(ctx, contract)
+				s.Require().NoError(err)
+				s.app.AppKeepers.FeePayKeeper.SetContractBalance(ctx, fpc, fpc.Balance+1)
+			},
+			brokenModBal: true,
+		},
+		{
+			desc: "Fail - Orphaned Wallet Usage",
+			malleate: func(ctx sdk.Context) {
+				_, _, unregistered := testdata.KeyTestPubAddr()
+				err := s.app.AppKeepers.FeePayKeeper.IncrementContractUses(ctx, &types.FeePayContract{ContractAddress: unregistered.String()}, wallet.String(), 1)
+				s.Require().NoError(err)
+			},
+			brokenUsages: true,
+		},
+	} {
+		tc := tc
+
+		s.Run(tc.desc, func() {
+			ctx, _ := s.ctx.CacheContext()
+			tc.malleate(ctx)
+
+			_, broken := keeper.ModuleBalanceInvariant(s.app.AppKeepers.FeePayKeeper)(ctx)
+			s.Require().Equal(tc.brokenModBal, broken)
+
+			_, broken = keeper.WalletUsageInvariant(s.app.AppKeepers.FeePayKeeper)(ctx)
+			s.Require().Equal(tc.brokenUsages, broken)
+
+			_, broken = keeper.AllInvariants(s.app.AppKeepers.FeePayKeeper)(ctx)
+			s.Require().Equal(tc.brokenModBal || tc.brokenUsages, broken)
+
+			querier := keeper.NewQuerier(s.app.AppKeepers.FeePayKeeper)
+			res, err := querier.Invariants(ctx, &types.QueryInvariantsRequest{})
+			s.Require().NoError(err)
+			s.Require().Len(res.Invariants, 2)
+			s.Require().Equal(keeper.ModuleBalanceInvariantRoute, res.Invariants[0].Route)
+			s.Require().Equal(tc.brokenModBal, res.Invariants[0].Broken)
+			s.Require().Equal(keeper.WalletUsageInvariantRoute, res.Invariants[1].Route)
+			s.Require().Equal(tc.brokenUsages, res.Invariants[1].Broken)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestUnregisterRemovesWalletUsages() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	contract := s.InstantiateContract(sender.String(), "")
+	s.registerFeePayContract(sender.String(), contract, 0, 5)
+
+	fpc, err := s.app.AppKeepers.FeePayKeeper.GetContract(s.ctx, contract)
+	s.Require().NoError(err)
+
+	for i := 0; i < 3; i++ {
+		_, _, wallet := testdata.KeyTestPubAddr()
+		s.Require().NoError(s.app.AppKeepers.FeePayKeeper.IncrementContractUses(s.ctx, fpc, wallet.String(), 1))
+	}
+
+	_, err = s.app.AppKeepers.FeePayKeeper.UnregisterFeePayContract(s.ctx, &types.MsgUnregisterFeePayContract{
+		SenderAddress:   sender.String(),
+		ContractAddress: contract,
+	})
+	s.Require().NoError(err)
+
+	_, broken := keeper.WalletUsageInvariant(s.app.AppKeepers.FeePayKeeper)(s.ctx)
+	s.Require().False(broken)
+}
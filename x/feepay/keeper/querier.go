@@ -120,3 +120,12 @@ func (q Querier) Params(
 	params := q.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// Invariants runs the feepay module invariants and returns their results
+func (q Querier) Invariants(
+	c context.Context,
+	_ *types.QueryInvariantsRequest,
+) (*types.QueryInvariantsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryInvariantsResponse{Invariants: q.CheckInvariants(ctx)}, nil
+}
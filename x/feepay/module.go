@@ -21,6 +21,7 @@ import (
 
 	"github.com/CosmosContracts/juno/v26/x/feepay/client/cli"
 	"github.com/CosmosContracts/juno/v26/x/feepay/keeper"
+	"github.com/CosmosContracts/juno/v26/x/feepay/simulation"
 	"github.com/CosmosContracts/juno/v26/x/feepay/types"
 )
 
@@ -35,7 +36,9 @@ var (
 const ConsensusVersion = 1
 
 // AppModuleBasic type for the fees module
-type AppModuleBasic struct{}
+type AppModuleBasic struct {
+	cdc codec.Codec
+}
 
 // Name returns the fees module's name.
 func (AppModuleBasic) Name() string {
@@ -108,11 +111,12 @@ type AppModule struct {
 
 // NewAppModule creates a new AppModule Object
 func NewAppModule(
+	cdc codec.Codec,
 	k keeper.Keeper,
 	ak authkeeper.AccountKeeper,
 ) AppModule {
 	return AppModule{
-		AppModuleBasic: AppModuleBasic{},
+		AppModuleBasic: AppModuleBasic{cdc: cdc},
 		keeper:         k,
 		ak:             ak,
 	}
@@ -124,7 +128,9 @@ func (AppModule) Name() string {
 }
 
 // RegisterInvariants registers the fees module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // NewHandler returns nil - fees module doesn't expose tx gRPC endpoints
 func (am AppModule) NewHandler() sdk.Handler {
@@ -182,7 +188,8 @@ func (am AppModule) ProposalContents(_ module.SimulationState) []simtypes.Weight
 }
 
 // RegisterStoreDecoder registers a decoder for fees module's types.
-func (am AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {
+func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(am.cdc)
 }
 
 // WeightedOperations returns fees module weighted operations
@@ -0,0 +1,63 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/CosmosContracts/juno/v26/app"
+	"github.com/CosmosContracts/juno/v26/x/feepay/keeper"
+	"github.com/CosmosContracts/juno/v26/x/feepay/simulation"
+	"github.com/CosmosContracts/juno/v26/x/feepay/types"
+)
+
+// TestDecodeStore tests the decoding of the store
+func TestDecodeStore(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	params := types.Params{EnableFeepay: true}
+	contract := types.FeePayContract{
+		ContractAddress: "juno14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9skjuwg8",
+		Balance:         1_000_000,
+		WalletLimit:     5,
+	}
+	usage := types.FeePayWalletUsage{
+		ContractAddress: contract.ContractAddress,
+		WalletAddress:   "juno1hj5fveer5cjtn4wd6wstzugjfdxzl0xps73ftl",
+		Uses:            2,
+	}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: append(keeper.StoreKeyContracts, []byte(contract.ContractAddress)...), Value: cdc.MustMarshal(&contract)},
+			{Key: append(keeper.StoreKeyContractUses, []byte(usage.ContractAddress+"-"+usage.WalletAddress)...), Value: cdc.MustMarshal(&usage)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"FeePayContract", fmt.Sprintf("%v\n%v", contract, contract)},
+		{"FeePayWalletUsage", fmt.Sprintf("%v\n%v", usage, usage)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -11,4 +11,5 @@ var (
 	ErrInvalidJunoFundAmount    = errorsmod.Register(ModuleName, 4, "fee pay contracts only accept juno funds")
 	ErrFeePayDisabled           = errorsmod.Register(ModuleName, 5, "the FeePay module is disabled")
 	ErrDeductFees               = errorsmod.Register(ModuleName, 6, "error deducting fees")
+	ErrNotFeePayTx              = errorsmod.Register(ModuleName, 7, "not a fee pay transaction")
 )
@@ -1,12 +1,17 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, feePayContracts []FeePayContract) GenesisState {
+func NewGenesisState(params Params, feePayContracts []FeePayContract, feePayWalletUsages []FeePayWalletUsage) GenesisState {
 	return GenesisState{
-		Params:          params,
-		FeePayContracts: feePayContracts,
+		Params:             params,
+		FeePayContracts:    feePayContracts,
+		FeePayWalletUsages: feePayWalletUsages,
 	}
 }
 
@@ -17,7 +22,8 @@ func DefaultGenesisState() *GenesisState {
 		Params: Params{
 			EnableFeepay: true,
 		},
-		FeePayContracts: []FeePayContract{},
+		FeePayContracts:    []FeePayContract{},
+		FeePayWalletUsages: []FeePayWalletUsage{},
 	}
 }
 
@@ -25,11 +31,38 @@ func DefaultGenesisState() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// Loop through all fee pay contracts and validate they
-	// have a valid bech32 address
+	// have a valid bech32 address and are only registered once
+	seenContract := make(map[string]bool)
 	for _, contract := range gs.FeePayContracts {
 		if _, err := sdk.AccAddressFromBech32(contract.ContractAddress); err != nil {
 			return err
 		}
+
+		if seenContract[contract.ContractAddress] {
+			return fmt.Errorf("contract duplicated on genesis '%s'", contract.ContractAddress)
+		}
+
+		seenContract[contract.ContractAddress] = true
+	}
+
+	// Loop through all wallet usages and validate they belong
+	// to a fee pay contract in genesis and are only set once
+	seenUsage := make(map[string]bool)
+	for _, usage := range gs.FeePayWalletUsages {
+		if _, err := sdk.AccAddressFromBech32(usage.WalletAddress); err != nil {
+			return err
+		}
+
+		if !seenContract[usage.ContractAddress] {
+			return fmt.Errorf("wallet usage for unregistered contract on genesis '%s'", usage.ContractAddress)
+		}
+
+		key := usage.ContractAddress + "-" + usage.WalletAddress
+		if seenUsage[key] {
+			return fmt.Errorf("wallet usage duplicated on genesis for contract '%s' and wallet '%s'", usage.ContractAddress, usage.WalletAddress)
+		}
+
+		seenUsage[key] = true
 	}
 
 	return nil
@@ -29,6 +29,9 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// fee_pay_contracts are the feepay module contracts
 	FeePayContracts []FeePayContract `protobuf:"bytes,2,rep,name=fee_pay_contracts,json=feePayContracts,proto3" json:"fee_pay_contracts"`
+	// fee_pay_wallet_usages are the number of times each wallet has
+	// interacted with a feepay module contract
+	FeePayWalletUsages []FeePayWalletUsage `protobuf:"bytes,3,rep,name=fee_pay_wallet_usages,json=feePayWalletUsages,proto3" json:"fee_pay_wallet_usages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +81,13 @@ func (m *GenesisState) GetFeePayContracts() []FeePayContract {
 	return nil
 }
 
+func (m *GenesisState) GetFeePayWalletUsages() []FeePayWalletUsage {
+	if m != nil {
+		return m.FeePayWalletUsages
+	}
+	return nil
+}
+
 // Params defines the feepay module params
 type Params struct {
 	// enable_feepay defines a parameter to enable the feepay module
@@ -132,25 +142,27 @@ func init() {
 func init() { proto.RegisterFile("juno/feepay/v1/genesis.proto", fileDescriptor_ac1bd21601b5f553) }
 
 var fileDescriptor_ac1bd21601b5f553 = []byte{
-	// 274 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x2a, 0xcd, 0xcb,
-	0xd7, 0x4f, 0x4b, 0x4d, 0x2d, 0x48, 0xac, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x03, 0xc9, 0xea, 0x41, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0xa4, 0xd1, 0x54, 0x43, 0x65, 0xc0, 0x8a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0x34, 0x8d, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x68, 0x70,
-	0x49, 0x62, 0x49, 0xaa, 0x90, 0x09, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3,
-	0x02, 0xa3, 0x06, 0xb7, 0x91, 0x98, 0x1e, 0xaa, 0x25, 0x7a, 0x01, 0x60, 0x59, 0x27, 0x96, 0x13,
-	0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x6a, 0x85, 0x02, 0xb8, 0x04, 0xd3, 0x52, 0x53, 0xe3, 0x0b, 0x12,
-	0x2b, 0xe3, 0x93, 0xf3, 0xf3, 0x4a, 0x8a, 0x12, 0x93, 0x4b, 0x8a, 0x25, 0x98, 0x14, 0x98, 0x35,
-	0xb8, 0x8d, 0xe4, 0xd0, 0x0d, 0x70, 0x4b, 0x4d, 0x0d, 0x48, 0xac, 0x74, 0x86, 0x2a, 0x83, 0x1a,
-	0xc4, 0x9f, 0x86, 0x22, 0x5a, 0xac, 0xa4, 0xcb, 0xc5, 0x06, 0xb1, 0x49, 0x48, 0x99, 0x8b, 0x37,
-	0x35, 0x2f, 0x31, 0x29, 0x27, 0x35, 0x1e, 0x62, 0x06, 0xd8, 0x61, 0x1c, 0x41, 0x3c, 0x10, 0x41,
-	0x37, 0xb0, 0x98, 0x93, 0xc7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24,
-	0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9,
-	0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x3b, 0xe7, 0x17, 0xe7, 0xe6,
-	0x17, 0xc3, 0x2d, 0xd1, 0x07, 0x87, 0x57, 0x05, 0x2c, 0xc4, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93,
-	0xd8, 0xc0, 0x01, 0x63, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xde, 0x9d, 0xea, 0xfc, 0x7b, 0x01,
-	0x00, 0x00,
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xd1, 0x4a, 0xf3, 0x30,
+	0x14, 0xc7, 0x9b, 0x6f, 0x1f, 0x43, 0xb2, 0xa9, 0x18, 0x54, 0xc6, 0x94, 0x38, 0xe7, 0xcd, 0x6e,
+	0x4c, 0xd8, 0xf4, 0x09, 0x36, 0x98, 0x5e, 0x8e, 0x89, 0x08, 0xbb, 0x29, 0xd9, 0x38, 0xad, 0x93,
+	0xad, 0x29, 0x4d, 0x3a, 0xed, 0x5b, 0xf8, 0x58, 0xbb, 0xdc, 0xa5, 0x57, 0x22, 0xed, 0x1b, 0xf8,
+	0x04, 0xd2, 0xa4, 0x13, 0x56, 0xbc, 0x3b, 0xfc, 0xff, 0x3f, 0x7e, 0xe1, 0xe4, 0xe0, 0xf3, 0x97,
+	0x38, 0x90, 0xdc, 0x03, 0x08, 0x45, 0xc2, 0x57, 0x5d, 0xee, 0x43, 0x00, 0x6a, 0xae, 0x58, 0x18,
+	0x49, 0x2d, 0xc9, 0x41, 0xde, 0x32, 0xdb, 0xb2, 0x55, 0xb7, 0x79, 0x56, 0xa2, 0x8b, 0xc6, 0xc0,
+	0xcd, 0x63, 0x5f, 0xfa, 0xd2, 0x8c, 0x3c, 0x9f, 0x6c, 0xda, 0xfe, 0x46, 0xb8, 0x7e, 0x67, 0xa5,
+	0x0f, 0x5a, 0x68, 0x20, 0xb7, 0xb8, 0x1a, 0x8a, 0x48, 0x2c, 0x55, 0x03, 0xb5, 0x50, 0xa7, 0xd6,
+	0x3b, 0x65, 0xbb, 0x8f, 0xb0, 0x91, 0x69, 0xfb, 0xff, 0xd7, 0x9f, 0x17, 0xce, 0xb8, 0x60, 0xc9,
+	0x08, 0x1f, 0x79, 0x00, 0x6e, 0x28, 0x12, 0x77, 0x26, 0x03, 0x1d, 0x89, 0x99, 0x56, 0x8d, 0x7f,
+	0xad, 0x4a, 0xa7, 0xd6, 0xa3, 0x65, 0xc1, 0x10, 0x60, 0x24, 0x92, 0x41, 0x81, 0x15, 0xa2, 0x43,
+	0x6f, 0x27, 0x55, 0x64, 0x82, 0x4f, 0xb6, 0xc6, 0x57, 0xb1, 0x58, 0x80, 0x76, 0x63, 0x25, 0x7c,
+	0x50, 0x8d, 0x8a, 0xb1, 0x5e, 0xfe, 0x6d, 0x7d, 0x32, 0xe8, 0x63, 0x4e, 0x16, 0x62, 0xe2, 0x95,
+	0x0b, 0xd5, 0xbe, 0xc6, 0x55, 0xbb, 0x05, 0xb9, 0xc2, 0xfb, 0x10, 0x88, 0xe9, 0x02, 0x5c, 0x6b,
+	0x32, 0x4b, 0xef, 0x8d, 0xeb, 0x36, 0x1c, 0x9a, 0xac, 0x7f, 0xbf, 0x4e, 0x29, 0xda, 0xa4, 0x14,
+	0x7d, 0xa5, 0x14, 0xbd, 0x67, 0xd4, 0xd9, 0x64, 0xd4, 0xf9, 0xc8, 0xa8, 0x33, 0x61, 0xfe, 0x5c,
+	0x3f, 0xc7, 0x53, 0x36, 0x93, 0x4b, 0x3e, 0x90, 0x6a, 0x29, 0xd5, 0xef, 0x02, 0xdc, 0xdc, 0xe2,
+	0x6d, 0x7b, 0x0d, 0x9d, 0x84, 0xa0, 0xa6, 0x55, 0xf3, 0xe9, 0x37, 0x3f, 0x03, 0x00, 0xb7, 0xc2,
+	0x43, 0xfe, 0xd7, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -173,6 +185,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeePayWalletUsages) > 0 {
+		for iNdEx := len(m.FeePayWalletUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeePayWalletUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.FeePayContracts) > 0 {
 		for iNdEx := len(m.FeePayContracts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -258,6 +284,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FeePayWalletUsages) > 0 {
+		for _, e := range m.FeePayWalletUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -375,6 +407,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayWalletUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayWalletUsages = append(m.FeePayWalletUsages, FeePayWalletUsage{})
+			if err := m.FeePayWalletUsages[len(m.FeePayWalletUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -508,6 +508,153 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryInvariantsRequest is the request type for the Query/Invariants RPC method.
+type QueryInvariantsRequest struct {
+}
+
+func (m *QueryInvariantsRequest) Reset()         { *m = QueryInvariantsRequest{} }
+func (m *QueryInvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsRequest) ProtoMessage()    {}
+func (*QueryInvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6539df905bf35ca, []int{10}
+}
+func (m *QueryInvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsRequest.Merge(m, src)
+}
+func (m *QueryInvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsRequest proto.InternalMessageInfo
+
+// InvariantResult is the outcome of a single FeePay invariant check.
+type InvariantResult struct {
+	// The registered route of the invariant.
+	Route string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// Whether the invariant is broken.
+	Broken bool `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+	// The invariant message, describing the state that was checked.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6539df905bf35ca, []int{11}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
+func (m *InvariantResult) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *InvariantResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// QueryInvariantsResponse is the response type for the Query/Invariants RPC method.
+type QueryInvariantsResponse struct {
+	// The result of every registered FeePay invariant
+	Invariants []InvariantResult `protobuf:"bytes,1,rep,name=invariants,proto3" json:"invariants"`
+}
+
+func (m *QueryInvariantsResponse) Reset()         { *m = QueryInvariantsResponse{} }
+func (m *QueryInvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsResponse) ProtoMessage()    {}
+func (*QueryInvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6539df905bf35ca, []int{12}
+}
+func (m *QueryInvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsResponse.Merge(m, src)
+}
+func (m *QueryInvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsResponse proto.InternalMessageInfo
+
+func (m *QueryInvariantsResponse) GetInvariants() []InvariantResult {
+	if m != nil {
+		return m.Invariants
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryFeePayContract)(nil), "juno.feepay.v1.QueryFeePayContract")
 	proto.RegisterType((*QueryFeePayContractResponse)(nil), "juno.feepay.v1.QueryFeePayContractResponse")
@@ -519,55 +666,65 @@ func init() {
 	proto.RegisterType((*QueryFeePayWalletIsEligibleResponse)(nil), "juno.feepay.v1.QueryFeePayWalletIsEligibleResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "juno.feepay.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "juno.feepay.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryInvariantsRequest)(nil), "juno.feepay.v1.QueryInvariantsRequest")
+	proto.RegisterType((*InvariantResult)(nil), "juno.feepay.v1.InvariantResult")
+	proto.RegisterType((*QueryInvariantsResponse)(nil), "juno.feepay.v1.QueryInvariantsResponse")
 }
 
 func init() { proto.RegisterFile("juno/feepay/v1/query.proto", fileDescriptor_d6539df905bf35ca) }
 
 var fileDescriptor_d6539df905bf35ca = []byte{
-	// 680 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x4f, 0xd4, 0x40,
-	0x14, 0xdf, 0x22, 0x6e, 0x70, 0x8c, 0x0b, 0x8e, 0x04, 0x49, 0xc1, 0x62, 0x8a, 0x88, 0x8a, 0x76,
-	0xb2, 0xa0, 0x77, 0x81, 0xf0, 0x2f, 0xc6, 0x64, 0x6d, 0x62, 0x4c, 0x3c, 0x48, 0x66, 0x97, 0xb7,
-	0xb5, 0x5a, 0x3a, 0x65, 0xa7, 0x8b, 0x6e, 0x08, 0x17, 0xcf, 0x1e, 0x4c, 0x3c, 0xf8, 0x21, 0xfc,
-	0x04, 0x7c, 0x03, 0xbc, 0x91, 0x78, 0xf1, 0x64, 0x0c, 0xf8, 0x41, 0x4c, 0x67, 0xa6, 0xc5, 0x8e,
-	0x65, 0xd9, 0x3d, 0x78, 0x9b, 0x7d, 0xf3, 0x9b, 0xdf, 0xfb, 0xbd, 0xf7, 0x7e, 0xaf, 0x8b, 0xcc,
-	0x37, 0xed, 0x90, 0x91, 0x26, 0x40, 0x44, 0x3b, 0x64, 0xb7, 0x4a, 0x76, 0xda, 0xd0, 0xea, 0x38,
-	0x51, 0x8b, 0xc5, 0x0c, 0x57, 0x92, 0x3b, 0x47, 0xde, 0x39, 0xbb, 0x55, 0xf3, 0x5e, 0x83, 0xf1,
-	0x6d, 0xc6, 0x49, 0x9d, 0x72, 0x90, 0x40, 0xb2, 0x5b, 0xad, 0x43, 0x4c, 0xab, 0x24, 0xa2, 0x9e,
-	0x1f, 0xd2, 0xd8, 0x67, 0xa1, 0x7c, 0x6b, 0x4e, 0x6a, 0xbc, 0x1e, 0x84, 0xc0, 0x7d, 0xae, 0x6e,
-	0x27, 0xb4, 0x5b, 0x95, 0x43, 0x5e, 0x8e, 0x7a, 0xcc, 0x63, 0xe2, 0x48, 0x92, 0x53, 0x4a, 0xe8,
-	0x31, 0xe6, 0x05, 0x40, 0x68, 0xe4, 0x13, 0x1a, 0x86, 0x2c, 0x16, 0xd9, 0x14, 0xa1, 0xfd, 0x18,
-	0x5d, 0x7b, 0x96, 0x08, 0x5a, 0x05, 0xa8, 0xd1, 0xce, 0x32, 0x0b, 0xe3, 0x16, 0x6d, 0xc4, 0xf8,
-	0x2e, 0x1a, 0x69, 0xa8, 0xf3, 0x26, 0xdd, 0xda, 0x6a, 0x01, 0xe7, 0xe3, 0xc6, 0x4d, 0xe3, 0xce,
-	0x25, 0x77, 0x38, 0x8d, 0x2f, 0xca, 0xb0, 0xed, 0xa1, 0x89, 0x02, 0x06, 0x17, 0x78, 0xc4, 0x42,
-	0x0e, 0x78, 0x1d, 0x8d, 0x34, 0x01, 0x36, 0x23, 0xda, 0xd9, 0x4c, 0x5f, 0x0a, 0xa6, 0xcb, 0xf3,
-	0x96, 0x93, 0x6f, 0x93, 0xa3, 0x31, 0x54, 0x9a, 0xb9, 0xdf, 0xf6, 0x2b, 0x34, 0x5a, 0x90, 0x88,
-	0xe3, 0x55, 0x84, 0x4e, 0xbb, 0xa8, 0xb8, 0x6f, 0x3b, 0xb2, 0xe5, 0x4e, 0xd2, 0x72, 0x47, 0xce,
-	0x46, 0xb5, 0xdc, 0xa9, 0x51, 0x0f, 0x5c, 0xd8, 0x69, 0x03, 0x8f, 0xdd, 0xbf, 0x5e, 0xda, 0x07,
-	0x06, 0x9a, 0x2c, 0x4a, 0x90, 0x95, 0x52, 0x43, 0x57, 0xf5, 0x52, 0x92, 0xae, 0x5c, 0x38, 0xbf,
-	0x96, 0xa5, 0xc1, 0xc3, 0x9f, 0x53, 0x25, 0x77, 0xb8, 0xa9, 0x49, 0x5f, 0xcb, 0x49, 0x1f, 0x10,
-	0xd2, 0x67, 0xcf, 0x95, 0x2e, 0xe5, 0xe4, 0xb4, 0xbf, 0x45, 0xd7, 0x0b, 0xa4, 0x3f, 0xe7, 0xc0,
-	0xfb, 0x18, 0x25, 0x9e, 0x41, 0x95, 0x77, 0x34, 0x08, 0xe0, 0x14, 0x38, 0x20, 0x80, 0x57, 0x64,
-	0x34, 0x9d, 0xf8, 0x23, 0x34, 0x75, 0x46, 0xb2, 0xac, 0x55, 0x18, 0x0d, 0xb6, 0x39, 0xc8, 0x44,
-	0x83, 0xae, 0x38, 0xdb, 0x2c, 0x67, 0x94, 0x17, 0x82, 0x72, 0x83, 0xaf, 0x04, 0xbe, 0xe7, 0xd7,
-	0x03, 0xf8, 0x0f, 0x3a, 0x17, 0xd1, 0x74, 0x97, 0x84, 0x99, 0x56, 0x13, 0x0d, 0x81, 0x8a, 0x89,
-	0x84, 0x43, 0x6e, 0xf6, 0xdb, 0x1e, 0x45, 0x58, 0x50, 0xd4, 0x68, 0x8b, 0x6e, 0x73, 0xe5, 0x1a,
-	0xfb, 0x89, 0x5a, 0x9a, 0x34, 0xaa, 0x88, 0x1e, 0xa2, 0x72, 0x24, 0x22, 0xca, 0x84, 0x63, 0xba,
-	0x29, 0x24, 0x5e, 0x99, 0x41, 0x61, 0xe7, 0xbf, 0x96, 0xd1, 0x45, 0xc1, 0x86, 0xbf, 0x18, 0xa8,
-	0xa2, 0xed, 0xe1, 0xb4, 0x4e, 0x51, 0xd0, 0x78, 0x73, 0xae, 0x07, 0x50, 0x2a, 0xd2, 0x5e, 0xf8,
-	0xf0, 0xfd, 0xf7, 0xe7, 0x81, 0x07, 0x78, 0x8e, 0x68, 0x9f, 0x92, 0xb4, 0xc9, 0x64, 0x4f, 0x1f,
-	0xc3, 0x3e, 0xfe, 0x68, 0xa0, 0x61, 0x7d, 0xed, 0x6e, 0xf5, 0x90, 0x95, 0x9b, 0xf7, 0x7b, 0x41,
-	0x65, 0xe2, 0x66, 0x84, 0xb8, 0x29, 0x7c, 0x43, 0x17, 0x47, 0x83, 0xe0, 0x74, 0xe7, 0xf0, 0x81,
-	0x81, 0x70, 0x81, 0xd3, 0x67, 0x7b, 0xc8, 0x95, 0x00, 0x4d, 0xd2, 0x23, 0x30, 0xd3, 0xb5, 0x21,
-	0x74, 0x2d, 0xe3, 0xc5, 0x3e, 0x9a, 0x46, 0x12, 0xd3, 0x93, 0xbd, 0xbc, 0x51, 0xf7, 0xf1, 0x37,
-	0x03, 0x8d, 0x9d, 0xb1, 0x01, 0xdd, 0xe6, 0xa8, 0x83, 0xcd, 0x85, 0x3e, 0xc0, 0x59, 0x1d, 0x4f,
-	0x45, 0x1d, 0x6b, 0x78, 0xa5, 0x9f, 0x3a, 0xd2, 0x65, 0xf8, 0xb7, 0x96, 0x1d, 0x54, 0x96, 0x96,
-	0xc6, 0x76, 0xa1, 0x9a, 0xdc, 0xd6, 0x98, 0xd3, 0x5d, 0x31, 0x4a, 0xa1, 0x25, 0x14, 0x8e, 0xe3,
-	0x31, 0x5d, 0xa1, 0xdc, 0x96, 0xa5, 0xf5, 0xc3, 0x63, 0xcb, 0x38, 0x3a, 0xb6, 0x8c, 0x5f, 0xc7,
-	0x96, 0xf1, 0xe9, 0xc4, 0x2a, 0x1d, 0x9d, 0x58, 0xa5, 0x1f, 0x27, 0x56, 0xe9, 0xa5, 0xe3, 0xf9,
-	0xf1, 0xeb, 0x76, 0xdd, 0x69, 0xb0, 0x6d, 0xb2, 0x2c, 0xbe, 0xa0, 0x99, 0xbf, 0x24, 0xd7, 0xfb,
-	0x94, 0x2d, 0xee, 0x44, 0xc0, 0xeb, 0x65, 0xf1, 0x07, 0xb8, 0xf0, 0x27, 0x00, 0x00, 0xff, 0xff,
-	0x44, 0xa8, 0x44, 0xd2, 0xc9, 0x07, 0x00, 0x00,
+	// 794 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0xeb, 0x44,
+	0x14, 0x8d, 0xfb, 0x91, 0x96, 0x8b, 0x48, 0xca, 0x34, 0x0a, 0x91, 0x5b, 0x1c, 0xe4, 0xd2, 0x16,
+	0x28, 0xd8, 0x4a, 0x0b, 0x7b, 0xda, 0xaa, 0x5f, 0x42, 0x48, 0xc1, 0x12, 0x42, 0xb0, 0xa0, 0x4c,
+	0xd2, 0x89, 0x31, 0x75, 0x3c, 0xae, 0xc7, 0x09, 0x44, 0x55, 0x17, 0xb0, 0x66, 0x81, 0xc4, 0x82,
+	0x9f, 0xc1, 0xba, 0xff, 0xa0, 0x6f, 0x57, 0xe9, 0x6d, 0xde, 0xea, 0xe9, 0xa9, 0x7d, 0x3f, 0xe4,
+	0x29, 0x33, 0x63, 0xa7, 0x9e, 0xba, 0x6d, 0xb2, 0x78, 0x3b, 0xcf, 0x9d, 0x33, 0xe7, 0x9c, 0x7b,
+	0xe7, 0xde, 0x49, 0x40, 0xff, 0xad, 0x17, 0x50, 0xbb, 0x43, 0x48, 0x88, 0x07, 0x76, 0xbf, 0x61,
+	0x9f, 0xf5, 0x48, 0x34, 0xb0, 0xc2, 0x88, 0xc6, 0x14, 0x95, 0x86, 0x7b, 0x96, 0xd8, 0xb3, 0xfa,
+	0x0d, 0xfd, 0xb3, 0x36, 0x65, 0x5d, 0xca, 0xec, 0x16, 0x66, 0x44, 0x00, 0xed, 0x7e, 0xa3, 0x45,
+	0x62, 0xdc, 0xb0, 0x43, 0xec, 0x7a, 0x01, 0x8e, 0x3d, 0x1a, 0x88, 0xb3, 0xfa, 0xb2, 0xc2, 0xeb,
+	0x92, 0x80, 0x30, 0x8f, 0xc9, 0xdd, 0x25, 0x65, 0x57, 0x6a, 0x88, 0xcd, 0x8a, 0x4b, 0x5d, 0xca,
+	0x3f, 0xed, 0xe1, 0x57, 0x42, 0xe8, 0x52, 0xea, 0xfa, 0xc4, 0xc6, 0xa1, 0x67, 0xe3, 0x20, 0xa0,
+	0x31, 0x57, 0x93, 0x84, 0xe6, 0xd7, 0xb0, 0xf8, 0xdd, 0xd0, 0xd0, 0x3e, 0x21, 0x4d, 0x3c, 0xd8,
+	0xa5, 0x41, 0x1c, 0xe1, 0x76, 0x8c, 0x3e, 0x85, 0x85, 0xb6, 0xfc, 0x3e, 0xc6, 0x27, 0x27, 0x11,
+	0x61, 0xac, 0xa6, 0x7d, 0xa4, 0x7d, 0xf2, 0x8e, 0x53, 0x4e, 0xe2, 0xdb, 0x22, 0x6c, 0xba, 0xb0,
+	0x94, 0xc3, 0xe0, 0x10, 0x16, 0xd2, 0x80, 0x11, 0x74, 0x08, 0x0b, 0x1d, 0x42, 0x8e, 0x43, 0x3c,
+	0x38, 0x4e, 0x4e, 0x72, 0xa6, 0x77, 0x37, 0x0d, 0x2b, 0x5b, 0x26, 0x4b, 0x61, 0x28, 0x75, 0x32,
+	0x6b, 0xf3, 0x67, 0xa8, 0xe4, 0x08, 0x31, 0xb4, 0x0f, 0x30, 0xaa, 0xa2, 0xe4, 0x5e, 0xb3, 0x44,
+	0xc9, 0xad, 0x61, 0xc9, 0x2d, 0x71, 0x37, 0xb2, 0xe4, 0x56, 0x13, 0xbb, 0xc4, 0x21, 0x67, 0x3d,
+	0xc2, 0x62, 0xe7, 0xce, 0x49, 0xf3, 0x52, 0x83, 0xe5, 0x3c, 0x81, 0x34, 0x95, 0x26, 0xbc, 0xaf,
+	0xa6, 0x32, 0xac, 0xca, 0xf4, 0xd3, 0xb9, 0xec, 0xcc, 0x5c, 0xbd, 0xac, 0x17, 0x9c, 0x72, 0x47,
+	0xb1, 0x7e, 0x90, 0xb1, 0x3e, 0xc5, 0xad, 0xaf, 0x3f, 0x69, 0x5d, 0xd8, 0xc9, 0x78, 0x3f, 0x85,
+	0x0f, 0x72, 0xac, 0x7f, 0xcf, 0x08, 0x9b, 0xe0, 0x2a, 0xd1, 0x2a, 0x94, 0x7e, 0xc7, 0xbe, 0x4f,
+	0x46, 0xc0, 0x29, 0x0e, 0x7c, 0x4f, 0x44, 0x93, 0x1b, 0xff, 0x0a, 0xea, 0x0f, 0x88, 0xa5, 0xa5,
+	0x42, 0x30, 0xd3, 0x63, 0x44, 0x08, 0xcd, 0x38, 0xfc, 0xdb, 0xa4, 0x99, 0x46, 0xf9, 0x81, 0x53,
+	0x1e, 0xb1, 0x3d, 0xdf, 0x73, 0xbd, 0x96, 0x4f, 0xde, 0x82, 0xcf, 0x6d, 0x58, 0x79, 0x44, 0x30,
+	0xf5, 0xaa, 0xc3, 0x3c, 0x91, 0x31, 0x2e, 0x38, 0xef, 0xa4, 0x6b, 0xb3, 0x02, 0x88, 0x53, 0x34,
+	0x71, 0x84, 0xbb, 0x4c, 0x76, 0x8d, 0xf9, 0x0d, 0x2c, 0x66, 0xa2, 0x92, 0xe8, 0x4b, 0x28, 0x86,
+	0x3c, 0x22, 0x9b, 0xb0, 0xaa, 0x36, 0x85, 0xc0, 0xcb, 0x66, 0x90, 0x58, 0xb3, 0x06, 0x55, 0x4e,
+	0x76, 0x14, 0xf4, 0x71, 0xe4, 0xe1, 0x20, 0x4e, 0x65, 0x7e, 0x84, 0x72, 0x1a, 0x74, 0x08, 0xeb,
+	0xf9, 0x31, 0xaa, 0xc0, 0x6c, 0x44, 0x7b, 0x31, 0x91, 0x95, 0x11, 0x0b, 0x54, 0x85, 0x62, 0x2b,
+	0xa2, 0xa7, 0x44, 0xb4, 0xd0, 0xbc, 0x23, 0x57, 0xa8, 0x06, 0x73, 0x5d, 0xc2, 0x18, 0x76, 0x49,
+	0x6d, 0x9a, 0xe3, 0x93, 0xa5, 0xf9, 0x8b, 0xec, 0x97, 0xbb, 0xa2, 0x32, 0x8b, 0x3d, 0x00, 0x2f,
+	0x8d, 0xca, 0xf6, 0xae, 0xab, 0x99, 0x28, 0xbe, 0x64, 0x4a, 0x77, 0x0e, 0x6e, 0xfe, 0x3f, 0x07,
+	0xb3, 0x5c, 0x02, 0xfd, 0xa7, 0x41, 0x49, 0x79, 0x5e, 0x56, 0x54, 0xbe, 0x9c, 0x7e, 0xd2, 0x37,
+	0xc6, 0x00, 0x25, 0xae, 0xcd, 0xad, 0xbf, 0x9e, 0xbf, 0xfe, 0x77, 0xea, 0x0b, 0xb4, 0x61, 0x2b,
+	0x2f, 0x64, 0xd2, 0x3b, 0xf6, 0xb9, 0xda, 0x5d, 0x17, 0xe8, 0x6f, 0x0d, 0xca, 0xea, 0x6b, 0xf2,
+	0xf1, 0x18, 0xaa, 0x4c, 0xff, 0x7c, 0x1c, 0x54, 0x6a, 0x6e, 0x95, 0x9b, 0xab, 0xa3, 0x0f, 0x55,
+	0x73, 0xd8, 0xf7, 0x47, 0x4f, 0x09, 0xba, 0xd4, 0x00, 0xe5, 0x0c, 0xf0, 0xfa, 0x18, 0x5a, 0x43,
+	0xa0, 0x6e, 0x8f, 0x09, 0x4c, 0x7d, 0x1d, 0x71, 0x5f, 0xbb, 0x68, 0x7b, 0x82, 0xa2, 0xd9, 0xc3,
+	0x59, 0xb6, 0xcf, 0xb3, 0xf3, 0x77, 0x81, 0x9e, 0x69, 0x50, 0x7d, 0x60, 0xb0, 0x1f, 0xbb, 0x47,
+	0x15, 0xac, 0x6f, 0x4d, 0x00, 0x4e, 0xf3, 0xf8, 0x96, 0xe7, 0x71, 0x80, 0xf6, 0x26, 0xc9, 0x23,
+	0x99, 0xf1, 0xfb, 0xb9, 0x9c, 0x41, 0x51, 0x4c, 0x2a, 0x32, 0x73, 0xdd, 0x64, 0x1e, 0x03, 0x7d,
+	0xe5, 0x51, 0x8c, 0x74, 0x68, 0x70, 0x87, 0x35, 0x54, 0x55, 0x1d, 0x8a, 0x47, 0x00, 0xfd, 0xa9,
+	0x01, 0x8c, 0x66, 0x11, 0xad, 0xe5, 0x72, 0xde, 0x7b, 0x21, 0xf4, 0xf5, 0x27, 0x71, 0x52, 0xdf,
+	0xe4, 0xfa, 0xcb, 0x48, 0x57, 0xf5, 0x47, 0x13, 0xbb, 0x73, 0x78, 0x75, 0x63, 0x68, 0xd7, 0x37,
+	0x86, 0xf6, 0xea, 0xc6, 0xd0, 0xfe, 0xb9, 0x35, 0x0a, 0xd7, 0xb7, 0x46, 0xe1, 0xc5, 0xad, 0x51,
+	0xf8, 0xc9, 0x72, 0xbd, 0xf8, 0xd7, 0x5e, 0xcb, 0x6a, 0xd3, 0xae, 0xbd, 0xcb, 0x7f, 0x9c, 0xd2,
+	0x1e, 0x17, 0x7c, 0x7f, 0x24, 0x8c, 0xf1, 0x20, 0x24, 0xac, 0x55, 0xe4, 0xff, 0x2d, 0xb6, 0xde,
+	0x0c, 0x00, 0x1b, 0xd3, 0x29, 0x33, 0x24, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -592,6 +749,8 @@ type QueryClient interface {
 	FeePayWalletIsEligible(ctx context.Context, in *QueryFeePayWalletIsEligible, opts ...grpc.CallOption) (*QueryFeePayWalletIsEligibleResponse, error)
 	// Params retrieves the FeePay module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Invariants runs the FeePay module invariants without halting the chain
+	Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
 }
 
 type queryClient struct {
@@ -647,6 +806,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/Invariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// FeePayContract queries a single fee pay contract by address
@@ -659,6 +827,8 @@ type QueryServer interface {
 	FeePayWalletIsEligible(context.Context, *QueryFeePayWalletIsEligible) (*QueryFeePayWalletIsEligibleResponse, error)
 	// Params retrieves the FeePay module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Invariants runs the FeePay module invariants without halting the chain
+	Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -680,6 +850,9 @@ func (*UnimplementedQueryServer) FeePayWalletIsEligible(ctx context.Context, req
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) Invariants(ctx context.Context, req *QueryInvariantsRequest) (*QueryInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -775,6 +948,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feepay.v1.Query/Invariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "juno.feepay.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -799,6 +990,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "juno/feepay/v1/query.proto",
@@ -1144,6 +1339,113 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Invariants) > 0 {
+		for iNdEx := len(m.Invariants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Invariants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1291,6 +1593,50 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Invariants) > 0 {
+		for _, e := range m.Invariants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2171,6 +2517,274 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInvariantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invariants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invariants = append(m.Invariants, InvariantResult{})
+			if err := m.Invariants[len(m.Invariants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,20 +3,18 @@ package ante
 import (
 	"encoding/json"
 
-	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
-
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	feeshare "github.com/CosmosContracts/juno/v26/x/feeshare/types"
 )
 
 // FeeSharePayoutDecorator Run his after we already deduct the fee from the account with
-// the ante.NewDeductFeeDecorator() decorator. We pull funds from the FeeCollector ModuleAccount
+// the ante.NewDeductFeeDecorator() decorator. It clears the contract executions
+// tracked so far, so only contracts run by this tx are paid by the FeeSharePostDecorator.
 type FeeSharePayoutDecorator struct {
 	bankKeeper     BankKeeper
 	feesharekeeper FeeShareKeeper
@@ -30,93 +28,66 @@ func NewFeeSharePayoutDecorator(bk BankKeeper, fs FeeShareKeeper) FeeSharePayout
 }
 
 func (fsd FeeSharePayoutDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	feeTx, ok := tx.(sdk.FeeTx)
-	if !ok {
+	if _, ok := tx.(sdk.FeeTx); !ok {
 		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
-	err = fsd.FeeSharePayout(ctx, fsd.bankKeeper, feeTx.GetFee(), fsd.feesharekeeper, tx.GetMsgs())
-	if err != nil {
-		return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
-	}
+	fsd.feesharekeeper.ResetContractExecutions(ctx)
 
 	return next(ctx, tx, simulate)
 }
 
-// FeePayLogic takes the total fees and splits them based on the governance params
-// and the number of contracts we are executing on.
-// This returns the amount of fees each contract developer should get.
-// tested in ante_test.go
-func FeePayLogic(fees sdk.Coins, govPercent sdk.Dec, numPairs int) sdk.Coins {
-	var splitFees sdk.Coins
-	for _, c := range fees.Sort() {
-		rewardAmount := govPercent.MulInt(c.Amount).QuoInt64(int64(numPairs)).RoundInt()
-		if !rewardAmount.IsZero() {
-			splitFees = splitFees.Add(sdk.NewCoin(c.Denom, rewardAmount))
-		}
-	}
-	return splitFees
+// FeeSharePostDecorator pays out the fee share of a transaction once its messages
+// have executed, so every contract the transaction ran is known. We pull funds
+// from the FeeCollector ModuleAccount.
+//
+// Failed transactions do not pay out any fee share: the contract executions
+// they recorded are reverted along with their messages, and the fees stay in
+// the FeeCollector for the stakers.
+type FeeSharePostDecorator struct {
+	bankKeeper     BankKeeper
+	feesharekeeper FeeShareKeeper
 }
 
-type FeeSharePayoutEventOutput struct {
-	WithdrawAddress sdk.AccAddress `json:"withdraw_address"`
-	FeesPaid        sdk.Coins      `json:"fees_paid"`
+func NewFeeSharePostDecorator(bk BankKeeper, fs FeeShareKeeper) FeeSharePostDecorator {
+	return FeeSharePostDecorator{
+		bankKeeper:     bk,
+		feesharekeeper: fs,
+	}
 }
 
-// Loop through all messages and add the withdraw address to the list of addresses to pay
-// if the contract opted-in to fee sharing
-func addNewFeeSharePayoutsForMsgs(ctx sdk.Context, fsk FeeShareKeeper, toPay *[]sdk.AccAddress, msgs []sdk.Msg) error {
-	for _, msg := range msgs {
-
-		// Check if an authz message, loop through all inner messages, and recursively call this function
-		if authzMsg, ok := msg.(*authz.MsgExec); ok {
-
-			innerMsgs, err := authzMsg.GetMessages()
-			if err != nil {
-				return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
-			}
-
-			// Recursively call this function with the inner messages
-			err = addNewFeeSharePayoutsForMsgs(ctx, fsk, toPay, innerMsgs)
-			if err != nil {
-				return err
-			}
-		}
-
-		// If an execute contract message, check if the contract opted-in to fee sharing,
-		// and if so, add the withdraw address to the list of addresses to pay
-		if execContractMsg, ok := msg.(*wasmtypes.MsgExecuteContract); ok {
-			contractAddr, err := sdk.AccAddressFromBech32(execContractMsg.Contract)
-			if err != nil {
-				return err
-			}
-
-			shareData, _ := fsk.GetFeeShare(ctx, contractAddr)
+func (fsd FeeSharePostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
+	// baseapp only runs the post handlers of successful transactions, but
+	// don't rely on it
+	if !success {
+		return next(ctx, tx, simulate, success)
+	}
 
-			withdrawAddr := shareData.GetWithdrawerAddr()
-			if withdrawAddr != nil && !withdrawAddr.Empty() {
-				*toPay = append(*toPay, withdrawAddr)
-			}
-		}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
 
+	err = FeeSharePayout(ctx, fsd.bankKeeper, feeTx.GetFee(), fsd.feesharekeeper, tx.GetMsgs())
+	if err != nil {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
 	}
 
-	return nil
+	return next(ctx, tx, simulate, success)
 }
 
-// FeeSharePayout takes the total fees and redistributes 50% (or param set) to the contract developers
-// provided they opted-in to payments.
-func (fsd FeeSharePayoutDecorator) FeeSharePayout(ctx sdk.Context, bankKeeper BankKeeper, totalFees sdk.Coins, fsk FeeShareKeeper, msgs []sdk.Msg) error {
-	params := fsk.GetParams(ctx)
-	if !params.EnableFeeShare {
-		return nil
-	}
+type FeeSharePayoutEventOutput struct {
+	WithdrawAddress sdk.AccAddress `json:"withdraw_address"`
+	FeesPaid        sdk.Coins      `json:"fees_paid"`
+}
 
-	// Get valid withdraw addresses from contracts
-	toPay := make([]sdk.AccAddress, 0)
+// FeeSharePayout takes the total fees and redistributes 50% (or param set, or the
+// contract's own ratio) to the developers of the executed contracts, split
+// according to the distribution mode.
+func FeeSharePayout(ctx sdk.Context, bankKeeper BankKeeper, totalFees sdk.Coins, fsk FeeShareKeeper, msgs []sdk.Msg) error {
+	params := fsk.GetParams(ctx)
 
-	// Add fee share payouts for each msg
-	err := addNewFeeSharePayoutsForMsgs(ctx, fsk, &toPay, msgs)
+	toPay, contractFees, err := fsk.FeeSharePayouts(ctx, totalFees, msgs)
 	if err != nil {
 		return err
 	}
@@ -126,42 +97,56 @@ func (fsd FeeSharePayoutDecorator) FeeSharePayout(ctx sdk.Context, bankKeeper Ba
 		return nil
 	}
 
-	// Get only allowed governance fees to be paid (helps for taxes)
-	var fees sdk.Coins
-	if len(params.AllowedDenoms) == 0 {
-		// If empty, we allow all denoms to be used as payment
-		fees = totalFees
-	} else {
-		for _, fee := range totalFees.Sort() {
-			for _, allowed := range params.AllowedDenoms {
-				if fee.Denom == allowed {
-					fees = fees.Add(fee)
-				}
-			}
-		}
-	}
+	feesPaidOutput := make([]FeeSharePayoutEventOutput, 0, len(toPay))
 
-	numPairs := len(toPay)
+	// With accrual enabled the fees are moved to the module account once and
+	// recorded against each withdrawer, to be claimed later with MsgClaimFeeShare
+	var accrued sdk.Coins
 
-	feesPaidOutput := make([]FeeSharePayoutEventOutput, numPairs)
-	if numPairs > 0 {
-		govPercent := params.DeveloperShares
-		splitFees := FeePayLogic(fees, govPercent, numPairs)
+	// pay each contract its portion of the fees, split by weight between each
+	// contract's withdraw addresses
+	for i, share := range toPay {
+		for _, w := range share.WithdrawerShares() {
+			withdrawAddr := w.GetWithdrawerAddr()
+			if withdrawAddr == nil {
+				continue
+			}
+
+			withdrawerFees := feeshare.WeightedFees(contractFees[i], w.Weight)
+			if withdrawerFees.IsZero() {
+				continue
+			}
 
-		// pay fees evenly between all withdraw addresses
-		for i, withdrawAddr := range toPay {
-			err := bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, withdrawAddr, splitFees)
-			feesPaidOutput[i] = FeeSharePayoutEventOutput{
+			feesPaidOutput = append(feesPaidOutput, FeeSharePayoutEventOutput{
 				WithdrawAddress: withdrawAddr,
-				FeesPaid:        splitFees,
+				FeesPaid:        withdrawerFees,
+			})
+
+			if params.EnableAccrual {
+				fsk.AccrueFeeShare(ctx, withdrawAddr, withdrawerFees)
+				accrued = accrued.Add(withdrawerFees...)
+				continue
 			}
 
+			err := bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, withdrawAddr, withdrawerFees)
 			if err != nil {
 				return errorsmod.Wrapf(feeshare.ErrFeeSharePayment, "failed to pay fees to contract developer: %s", err.Error())
 			}
 		}
 	}
 
+	eventType := feeshare.EventTypePayoutFeeShare
+	if params.EnableAccrual {
+		eventType = feeshare.EventTypeAccrueFeeShare
+
+		if !accrued.IsZero() {
+			err := bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, feeshare.ModuleName, accrued)
+			if err != nil {
+				return errorsmod.Wrapf(feeshare.ErrFeeSharePayment, "failed to accrue fees for contract developers: %s", err.Error())
+			}
+		}
+	}
+
 	bz, err := json.Marshal(feesPaidOutput)
 	if err != nil {
 		return errorsmod.Wrapf(feeshare.ErrFeeSharePayment, "failed to marshal feesPaidOutput: %s", err.Error())
@@ -169,7 +154,7 @@ func (fsd FeeSharePayoutDecorator) FeeSharePayout(ctx sdk.Context, bankKeeper Ba
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			feeshare.EventTypePayoutFeeShare,
+			eventType,
 			sdk.NewAttribute(feeshare.AttributeWithdrawPayouts, string(bz))),
 	)
 
@@ -28,6 +28,9 @@ var (
 	EmptyAnte = func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
 		return ctx, nil
 	}
+	EmptyPost = func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}
 )
 
 type AnteTestSuite struct {
@@ -57,6 +60,16 @@ func TestAnteSuite(t *testing.T) {
 	suite.Run(t, new(AnteTestSuite))
 }
 
+// handleTx runs a tx through the fee share ante and post decorators, as if its
+// messages executed successfully.
+func (s *AnteTestSuite) handleTx(tx sdk.Tx) {
+	_, err := ante.NewFeeSharePayoutDecorator(s.bankKeeper, s.feeshareKeeper).AnteHandle(s.ctx, tx, false, EmptyAnte)
+	s.Require().NoError(err)
+
+	_, err = ante.NewFeeSharePostDecorator(s.bankKeeper, s.feeshareKeeper).PostHandle(s.ctx, tx, false, true, EmptyPost)
+	s.Require().NoError(err)
+}
+
 func (s *AnteTestSuite) TestAnteHandle() {
 	// Mint coins to FeeCollector to cover fees
 	err := s.FundModule(s.ctx, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(1_000_000))))
@@ -90,10 +103,8 @@ func (s *AnteTestSuite) TestAnteHandle() {
 	}
 	tx := NewMockTx(deployer, executeMsg)
 
-	// Run normal msg through ante handle
-	ante := ante.NewFeeSharePayoutDecorator(s.bankKeeper, s.feeshareKeeper)
-	_, err = ante.AnteHandle(s.ctx, tx, false, EmptyAnte)
-	s.Require().NoError(err)
+	// Run normal msg through ante and post handle
+	s.handleTx(tx)
 
 	// Check that the receiver account was paid
 	receiverBal := s.bankKeeper.GetBalance(s.ctx, receiver, "ujuno")
@@ -101,8 +112,7 @@ func (s *AnteTestSuite) TestAnteHandle() {
 
 	// Create & handle authz msg
 	authzMsg := authz.NewMsgExec(deployer, []sdk.Msg{executeMsg})
-	_, err = ante.AnteHandle(s.ctx, NewMockTx(deployer, &authzMsg), false, EmptyAnte)
-	s.Require().NoError(err)
+	s.handleTx(NewMockTx(deployer, &authzMsg))
 
 	// Check that the receiver account was paid
 	receiverBal = s.bankKeeper.GetBalance(s.ctx, receiver, "ujuno")
@@ -110,14 +120,319 @@ func (s *AnteTestSuite) TestAnteHandle() {
 
 	// Create & handle authz msg with nested authz msg
 	nestedAuthzMsg := authz.NewMsgExec(deployer, []sdk.Msg{&authzMsg})
-	_, err = ante.AnteHandle(s.ctx, NewMockTx(deployer, &nestedAuthzMsg), false, EmptyAnte)
-	s.Require().NoError(err)
+	s.handleTx(NewMockTx(deployer, &nestedAuthzMsg))
 
 	// Check that the receiver account was paid
 	receiverBal = s.bankKeeper.GetBalance(s.ctx, receiver, "ujuno")
 	s.Require().Equal(sdk.NewInt(750).Int64(), receiverBal.Amount.Int64())
 }
 
+func (s *AnteTestSuite) TestAnteHandleWeightedWithdrawers() {
+	// Mint coins to FeeCollector to cover fees
+	err := s.FundModule(s.ctx, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(1_000_000))))
+	s.Require().NoError(err)
+
+	// Create & fund deployer
+	_, _, deployer := testdata.KeyTestPubAddr()
+	err = s.FundAccount(s.ctx, deployer, sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(100_000_000))))
+	s.Require().NoError(err)
+
+	// Create funds receiver accounts
+	_, _, receiverA := testdata.KeyTestPubAddr()
+	_, _, receiverB := testdata.KeyTestPubAddr()
+	_, _, receiverC := testdata.KeyTestPubAddr()
+
+	// Address used to mock a contract
+	_, _, contractAddr := testdata.KeyTestPubAddr()
+
+	// Register contract with Fee Share, splitting the fees between withdrawers
+	s.feeshareKeeper.SetFeeShare(s.ctx, feesharetypes.NewWeightedFeeShare(contractAddr, deployer, []feesharetypes.WeightedWithdrawer{
+		{WithdrawerAddress: receiverA.String(), Weight: sdk.NewDecWithPrec(40, 2)},
+		{WithdrawerAddress: receiverB.String(), Weight: sdk.NewDecWithPrec(59, 2)},
+		{WithdrawerAddress: receiverC.String(), Weight: sdk.NewDecWithPrec(1, 2)},
+	}))
+
+	// Create execute msg
+	executeMsg := &wasmtypes.MsgExecuteContract{
+		Sender:   deployer.String(),
+		Contract: contractAddr.String(),
+		Msg:      []byte("{}"),
+		Funds:    sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(0))),
+	}
+
+	// Run msg through ante and post handle
+	s.handleTx(NewMockTx(deployer, executeMsg))
+
+	// Check that each receiver was paid its weighted portion of the 250ujuno share,
+	// truncating the remainder
+	s.Require().Equal(int64(100), s.bankKeeper.GetBalance(s.ctx, receiverA, "ujuno").Amount.Int64())
+	s.Require().Equal(int64(147), s.bankKeeper.GetBalance(s.ctx, receiverB, "ujuno").Amount.Int64())
+	s.Require().Equal(int64(2), s.bankKeeper.GetBalance(s.ctx, receiverC, "ujuno").Amount.Int64())
+}
+
+func (s *AnteTestSuite) TestPostHandleGasWeighted() {
+	// Mint coins to FeeCollector to cover fees
+	err := s.FundModule(s.ctx, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(1_000_000))))
+	s.Require().NoError(err)
+
+	params := s.feeshareKeeper.GetParams(s.ctx)
+	params.DistributionMode = feesharetypes.DistributionModeGasWeighted
+	s.Require().NoError(s.feeshareKeeper.SetParams(s.ctx, params))
+
+	// Create & fund deployer
+	_, _, deployer := testdata.KeyTestPubAddr()
+	err = s.FundAccount(s.ctx, deployer, sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(100_000_000))))
+	s.Require().NoError(err)
+
+	// Register two mock contracts with Fee Share
+	_, _, receiverA := testdata.KeyTestPubAddr()
+	_, _, receiverB := testdata.KeyTestPubAddr()
+	_, _, contractA := testdata.KeyTestPubAddr()
+	_, _, contractB := testdata.KeyTestPubAddr()
+	s.feeshareKeeper.SetFeeShare(s.ctx, feesharetypes.NewFeeShare(contractA, deployer, receiverA))
+	s.feeshareKeeper.SetFeeShare(s.ctx, feesharetypes.NewFeeShare(contractB, deployer, receiverB))
+
+	executeMsg := func(contract sdk.AccAddress) *wasmtypes.MsgExecuteContract {
+		return &wasmtypes.MsgExecuteContract{
+			Sender:   deployer.String(),
+			Contract: contract.String(),
+			Msg:      []byte("{}"),
+		}
+	}
+
+	// Contract A is executed twice, but is only paid once for its total gas
+	tx := NewMockTx(deployer, executeMsg(contractA), executeMsg(contractB), executeMsg(contractA))
+
+	anteDecorator := ante.NewFeeSharePayoutDecorator(s.bankKeeper, s.feeshareKeeper)
+	postDecorator := ante.NewFeeSharePostDecorator(s.bankKeeper, s.feeshareKeeper)
+
+	// Gas tracked before the transaction starts is discarded by the ante handler,
+	// which does not pay out anything itself
+	s.feeshareKeeper.TrackContractExecution(s.ctx, contractB, 1_000)
+	_, err = anteDecorator.AnteHandle(s.ctx, tx, false, EmptyAnte)
+	s.Require().NoError(err)
+	s.Require().Zero(s.feeshareKeeper.GetContractGas(s.ctx, contractB))
+	s.Require().True(s.bankKeeper.GetBalance(s.ctx, receiverA, "ujuno").IsZero())
+	s.Require().True(s.bankKeeper.GetBalance(s.ctx, receiverB, "ujuno").IsZero())
+
+	// Mock the gas used while executing the contracts
+	s.feeshareKeeper.TrackContractExecution(s.ctx, contractA, 300)
+	s.feeshareKeeper.TrackContractExecution(s.ctx, contractB, 100)
+	s.feeshareKeeper.TrackContractExecution(s.ctx, contractA, 100)
+
+	// Nothing is paid out for failed transactions
+	_, err = postDecorator.PostHandle(s.ctx, tx, false, false, EmptyPost)
+	s.Require().NoError(err)
+	s.Require().True(s.bankKeeper.GetBalance(s.ctx, receiverA, "ujuno").IsZero())
+
+	// The 250ujuno share is split 400:100 between the contracts
+	_, err = postDecorator.PostHandle(s.ctx, tx, false, true, EmptyPost)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(200), s.bankKeeper.GetBalance(s.ctx, receiverA, "ujuno").Amount.Int64())
+	s.Require().Equal(int64(50), s.bankKeeper.GetBalance(s.ctx, receiverB, "ujuno").Amount.Int64())
+
+	// Without any tracked gas the share is split evenly
+	_, err = anteDecorator.AnteHandle(s.ctx, NewMockTx(deployer, executeMsg(contractA), executeMsg(contractB)), false, EmptyAnte)
+	s.Require().NoError(err)
+	_, err = postDecorator.PostHandle(s.ctx, NewMockTx(deployer, executeMsg(contractA), executeMsg(contractB)), false, true, EmptyPost)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(325), s.bankKeeper.GetBalance(s.ctx, receiverA, "ujuno").Amount.Int64())
+	s.Require().Equal(int64(175), s.bankKeeper.GetBalance(s.ctx, receiverB, "ujuno").Amount.Int64())
+
+}
+
+func (s *AnteTestSuite) TestPostHandleFailedTx() {
+	// Mint coins to FeeCollector to cover fees
+	err := s.FundModule(s.ctx, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(1_000_000))))
+	s.Require().NoError(err)
+
+	_, _, deployer := testdata.KeyTestPubAddr()
+	_, _, receiver := testdata.KeyTestPubAddr()
+	_, _, contractAddr := testdata.KeyTestPubAddr()
+	s.feeshareKeeper.SetFeeShare(s.ctx, feesharetypes.NewFeeShare(contractAddr, deployer, receiver))
+
+	tx := NewMockTx(deployer, &wasmtypes.MsgExecuteContract{
+		Sender:   deployer.String(),
+		Contract: contractAddr.String(),
+		Msg:      []byte("{}"),
+	})
+
+	feeCollector := s.app.AppKeepers.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBal := s.bankKeeper.GetBalance(s.ctx, feeCollector, "ujuno")
+
+	_, err = ante.NewFeeSharePayoutDecorator(s.bankKeeper, s.feeshareKeeper).AnteHandle(s.ctx, tx, false, EmptyAnte)
+	s.Require().NoError(err)
+	s.feeshareKeeper.TrackContractExecution(s.ctx, contractAddr, 100)
+
+	// The fees of a failed transaction stay in the fee collector
+	_, err = ante.NewFeeSharePostDecorator(s.bankKeeper, s.feeshareKeeper).PostHandle(s.ctx, tx, false, false, EmptyPost)
+	s.Require().NoError(err)
+	s.Require().True(s.bankKeeper.GetBalance(s.ctx, receiver, "ujuno").IsZero())
+	s.Require().Equal(feeCollectorBal, s.bankKeeper.GetBalance(s.ctx, feeCollector, "ujuno"))
+}
+
+func (s *AnteTestSuite) TestPostHandleIndirectExecutions() {
+	// Mint coins to FeeCollector to cover fees
+	err := s.FundModule(s.ctx, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(1_000_000))))
+	s.Require().NoError(err)
+
+	// Create & fund deployer
+	_, _, deployer := testdata.KeyTestPubAddr()
+	err = s.FundAccount(s.ctx, deployer, sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(100_000_000))))
+	s.Require().NoError(err)
+
+	// Register mock contracts with Fee Share, the router is executed by the tx
+	// and calls the dex through a sub-message
+	_, _, routerReceiver := testdata.KeyTestPubAddr()
+	_, _, dexReceiver := testdata.KeyTestPubAddr()
+	_, _, router := testdata.KeyTestPubAddr()
+	_, _, dex := testdata.KeyTestPubAddr()
+	_, _, unregistered := testdata.KeyTestPubAddr()
+	s.feeshareKeeper.SetFeeShare(s.ctx, feesharetypes.NewFeeShare(router, deployer, routerReceiver))
+	s.feeshareKeeper.SetFeeShare(s.ctx, feesharetypes.NewFeeShare(dex, deployer, dexReceiver))
+
+	tx := NewMockTx(deployer, &wasmtypes.MsgExecuteContract{
+		Sender:   deployer.String(),
+		Contract: router.String(),
+		Msg:      []byte("{}"),
+	})
+
+	_, err = ante.NewFeeSharePayoutDecorator(s.bankKeeper, s.feeshareKeeper).AnteHandle(s.ctx, tx, false, EmptyAnte)
+	s.Require().NoError(err)
+
+	// Mock the executions of the contracts
+	s.feeshareKeeper.TrackContractExecution(s.ctx, router, 100)
+	s.feeshareKeeper.TrackContractExecution(s.ctx, dex, 300)
+	s.feeshareKeeper.TrackContractExecution(s.ctx, unregistered, 600)
+
+	_, err = ante.NewFeeSharePostDecorator(s.bankKeeper, s.feeshareKeeper).PostHandle(s.ctx, tx, false, true, EmptyPost)
+	s.Require().NoError(err)
+
+	// The 250ujuno share is split evenly between the registered contracts
+	s.Require().Equal(int64(125), s.bankKeeper.GetBalance(s.ctx, routerReceiver, "ujuno").Amount.Int64())
+	s.Require().Equal(int64(125), s.bankKeeper.GetBalance(s.ctx, dexReceiver, "ujuno").Amount.Int64())
+}
+
+func (s *AnteTestSuite) TestPostHandleAccrual() {
+	// Mint coins to FeeCollector to cover fees
+	err := s.FundModule(s.ctx, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(1_000_000))))
+	s.Require().NoError(err)
+
+	params := s.feeshareKeeper.GetParams(s.ctx)
+	params.EnableAccrual = true
+	s.Require().NoError(s.feeshareKeeper.SetParams(s.ctx, params))
+
+	// Create & fund deployer
+	_, _, deployer := testdata.KeyTestPubAddr()
+	err = s.FundAccount(s.ctx, deployer, sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(100_000_000))))
+	s.Require().NoError(err)
+
+	// Register a mock contract with Fee Share
+	_, _, receiver := testdata.KeyTestPubAddr()
+	_, _, contractAddr := testdata.KeyTestPubAddr()
+	s.feeshareKeeper.SetFeeShare(s.ctx, feesharetypes.NewFeeShare(contractAddr, deployer, receiver))
+
+	executeMsg := &wasmtypes.MsgExecuteContract{
+		Sender:   deployer.String(),
+		Contract: contractAddr.String(),
+		Msg:      []byte("{}"),
+	}
+
+	// Fees accrue in the module account instead of being paid to the withdrawer
+	s.handleTx(NewMockTx(deployer, executeMsg))
+	s.handleTx(NewMockTx(deployer, executeMsg))
+
+	moduleAddr := s.app.AppKeepers.AccountKeeper.GetModuleAddress(feesharetypes.ModuleName)
+	s.Require().True(s.bankKeeper.GetBalance(s.ctx, receiver, "ujuno").IsZero())
+	s.Require().Equal(int64(500), s.bankKeeper.GetBalance(s.ctx, moduleAddr, "ujuno").Amount.Int64())
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(500))), s.feeshareKeeper.GetAccruedFeeShare(s.ctx, receiver))
+}
+
+func (s *AnteTestSuite) TestPostHandleContractRatio() {
+	// Mint coins to FeeCollector to cover fees
+	err := s.FundModule(s.ctx, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(1_000_000))))
+	s.Require().NoError(err)
+
+	// Create & fund deployer
+	_, _, deployer := testdata.KeyTestPubAddr()
+	err = s.FundAccount(s.ctx, deployer, sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(100_000_000))))
+	s.Require().NoError(err)
+
+	// Register two mock contracts with Fee Share, overriding the developer share
+	// of contract A
+	_, _, receiverA := testdata.KeyTestPubAddr()
+	_, _, receiverB := testdata.KeyTestPubAddr()
+	_, _, contractA := testdata.KeyTestPubAddr()
+	_, _, contractB := testdata.KeyTestPubAddr()
+	s.feeshareKeeper.SetFeeShare(s.ctx, feesharetypes.NewFeeShare(contractA, deployer, receiverA))
+	s.feeshareKeeper.SetFeeShare(s.ctx, feesharetypes.NewFeeShare(contractB, deployer, receiverB))
+	s.feeshareKeeper.SetContractRatio(s.ctx, feesharetypes.NewContractFeeShareRatio(contractA, sdk.NewDecWithPrec(90, 2)))
+
+	executeMsg := func(contract sdk.AccAddress) *wasmtypes.MsgExecuteContract {
+		return &wasmtypes.MsgExecuteContract{
+			Sender:   deployer.String(),
+			Contract: contract.String(),
+			Msg:      []byte("{}"),
+		}
+	}
+
+	s.handleTx(NewMockTx(deployer, executeMsg(contractA), executeMsg(contractB)))
+
+	// Contract A earns 90% and contract B the 50% param of their half of the fees
+	s.Require().Equal(int64(225), s.bankKeeper.GetBalance(s.ctx, receiverA, "ujuno").Amount.Int64())
+	s.Require().Equal(int64(125), s.bankKeeper.GetBalance(s.ctx, receiverB, "ujuno").Amount.Int64())
+
+	// The override also applies in gas weighted mode
+	params := s.feeshareKeeper.GetParams(s.ctx)
+	params.DistributionMode = feesharetypes.DistributionModeGasWeighted
+	s.Require().NoError(s.feeshareKeeper.SetParams(s.ctx, params))
+
+	tx := NewMockTx(deployer, executeMsg(contractA), executeMsg(contractB))
+	_, err = ante.NewFeeSharePayoutDecorator(s.bankKeeper, s.feeshareKeeper).AnteHandle(s.ctx, tx, false, EmptyAnte)
+	s.Require().NoError(err)
+	s.feeshareKeeper.TrackContractExecution(s.ctx, contractA, 100)
+	s.feeshareKeeper.TrackContractExecution(s.ctx, contractB, 100)
+	_, err = ante.NewFeeSharePostDecorator(s.bankKeeper, s.feeshareKeeper).PostHandle(s.ctx, tx, false, true, EmptyPost)
+	s.Require().NoError(err)
+
+	s.Require().Equal(int64(450), s.bankKeeper.GetBalance(s.ctx, receiverA, "ujuno").Amount.Int64())
+	s.Require().Equal(int64(250), s.bankKeeper.GetBalance(s.ctx, receiverB, "ujuno").Amount.Int64())
+}
+
+func (s *AnteTestSuite) TestGasWeightedFees() {
+	fees := sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(500)), sdk.NewCoin("utoken", sdk.NewInt(3)))
+	half := sdk.NewDecWithPrec(50, 2)
+
+	s.Require().Equal(
+		sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(250)), sdk.NewCoin("utoken", sdk.NewInt(1))),
+		feesharetypes.GasWeightedFees(fees, half, 1_000, 1_000),
+	)
+	s.Require().Equal(
+		sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(83))),
+		feesharetypes.GasWeightedFees(fees, half, 1, 3),
+	)
+	s.Require().Equal(
+		sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(166)), sdk.NewCoin("utoken", sdk.NewInt(1))),
+		feesharetypes.GasWeightedFees(fees, half, 2, 3),
+	)
+	s.Require().True(feesharetypes.GasWeightedFees(fees, half, 0, 1_000).IsZero())
+	s.Require().True(feesharetypes.GasWeightedFees(fees, half, 0, 0).IsZero())
+}
+
+func (s *AnteTestSuite) TestWeightedFees() {
+	fees := sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(250)), sdk.NewCoin("utoken", sdk.NewInt(3)))
+
+	s.Require().Equal(fees, feesharetypes.WeightedFees(fees, sdk.OneDec()))
+	s.Require().Equal(
+		sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(125)), sdk.NewCoin("utoken", sdk.NewInt(1))),
+		feesharetypes.WeightedFees(fees, sdk.NewDecWithPrec(50, 2)),
+	)
+	s.Require().Equal(
+		sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(2))),
+		feesharetypes.WeightedFees(fees, sdk.NewDecWithPrec(1, 2)),
+	)
+}
+
 func (s *AnteTestSuite) TestFeeLogic() {
 	// We expect all to pass
 	feeCoins := sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(500)), sdk.NewCoin("utoken", sdk.NewInt(250)))
@@ -202,7 +517,7 @@ func (s *AnteTestSuite) TestFeeLogic() {
 	}
 
 	for _, tc := range testCases {
-		coins := ante.FeePayLogic(tc.incomingFee, tc.govPercent, tc.numContracts)
+		coins := feesharetypes.FeePayLogic(tc.incomingFee, tc.govPercent, tc.numContracts)
 
 		for _, coin := range coins {
 			for _, expectedCoin := range tc.expectedFeePayment {
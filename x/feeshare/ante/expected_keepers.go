@@ -11,9 +11,12 @@ import (
 type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 }
 
 type FeeShareKeeper interface {
 	GetParams(ctx sdk.Context) revtypes.Params
-	GetFeeShare(ctx sdk.Context, contract sdk.Address) (revtypes.FeeShare, bool)
+	FeeSharePayouts(ctx sdk.Context, totalFees sdk.Coins, msgs []sdk.Msg) ([]revtypes.FeeShare, []sdk.Coins, error)
+	ResetContractExecutions(ctx sdk.Context)
+	AccrueFeeShare(ctx sdk.Context, withdrawer sdk.AccAddress, fees sdk.Coins)
 }
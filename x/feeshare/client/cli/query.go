@@ -29,6 +29,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryDeployerFeeShares(),
 		GetCmdQueryWithdrawerFeeShares(),
+		GetCmdQueryAccruedFeeShare(),
+		GetCmdQueryAccruedFeeShares(),
+		GetCmdQueryContractFeeShareRatios(),
 	)
 
 	return feesQueryCmd
@@ -223,3 +226,114 @@ func GetCmdQueryWithdrawerFeeShares() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryAccruedFeeShare implements a command to return the unclaimed fees
+// accrued by a withdraw address
+func GetCmdQueryAccruedFeeShare() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accrued [withdraw_address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the unclaimed fees accrued by a withdraw address",
+		Long:    "Query the unclaimed fees accrued by a withdraw address",
+		Example: fmt.Sprintf("%s query feeshare accrued <withdrawer-address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAccruedFeeShareRequest{
+				WithdrawerAddress: args[0],
+			}
+
+			if err := req.ValidateBasic(); err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccruedFeeShare(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAccruedFeeShares implements a command to return the unclaimed fees
+// of every withdraw address
+func GetCmdQueryAccruedFeeShares() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accrued-fee-shares",
+		Short: "Query the unclaimed fees of every withdraw address",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAccruedFeeSharesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AccruedFeeShares(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryContractFeeShareRatios implements a command to return the
+// developer shares set by governance for individual contracts
+func GetCmdQueryContractFeeShareRatios() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-ratios",
+		Short: "Query the developer shares set by governance for individual contracts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryContractFeeShareRatiosRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ContractFeeShareRatios(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
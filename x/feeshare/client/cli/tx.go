@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -28,6 +29,7 @@ func NewTxCmd() *cobra.Command {
 		NewRegisterFeeShare(),
 		NewCancelFeeShare(),
 		NewUpdateFeeShare(),
+		NewClaimFeeShare(),
 	)
 	return txCmd
 }
@@ -36,9 +38,9 @@ func NewTxCmd() *cobra.Command {
 // contract for fee distribution
 func NewRegisterFeeShare() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "register [contract_bech32] [withdraw_bech32]",
+		Use:   "register [contract_bech32] [withdraw_bech32|withdraw_bech32:weight,...]",
 		Short: "Register a contract for fee distribution. Only the contract admin can register a contract.",
-		Long:  "Register a contract for feeshare distribution. **NOTE** Please ensure, that the admin of the contract (or the DAO/factory that deployed the contract) is an account that is owned by your project, to avoid that an individual admin who leaves your project becomes malicious.",
+		Long:  "Register a contract for feeshare distribution. The withdrawer may be a single address or a comma separated list of address:weight pairs whose weights sum to 1, e.g. juno1...:0.6,juno1...:0.4. **NOTE** Please ensure, that the admin of the contract (or the DAO/factory that deployed the contract) is an account that is owned by your project, to avoid that an individual admin who leaves your project becomes malicious.",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
@@ -49,12 +51,16 @@ func NewRegisterFeeShare() *cobra.Command {
 			deployer := cliCtx.GetFromAddress()
 
 			contract := args[0]
-			withdrawer := args[1]
+			withdrawer, withdrawers, err := parseWithdrawers(args[1])
+			if err != nil {
+				return err
+			}
 
 			msg := &types.MsgRegisterFeeShare{
 				ContractAddress:   contract,
 				DeployerAddress:   deployer.String(),
 				WithdrawerAddress: withdrawer,
+				Withdrawers:       withdrawers,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -108,9 +114,9 @@ func NewCancelFeeShare() *cobra.Command {
 // address of a contract for fee distribution
 func NewUpdateFeeShare() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update [contract_bech32] [new_withdraw_bech32]",
+		Use:   "update [contract_bech32] [new_withdraw_bech32|withdraw_bech32:weight,...]",
 		Short: "Update withdrawer address for a contract registered for feeshare distribution.",
-		Long:  "Update withdrawer address for a contract registered for feeshare distribution. \nOnly the contract admin can update the withdrawer address.",
+		Long:  "Update withdrawer address for a contract registered for feeshare distribution. The withdrawer may be a single address or a comma separated list of address:weight pairs whose weights sum to 1. \nOnly the contract admin can update the withdrawer address.",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
@@ -125,12 +131,16 @@ func NewUpdateFeeShare() *cobra.Command {
 				return fmt.Errorf("invalid contract bech32 address %w", err)
 			}
 
-			withdrawer := args[1]
+			withdrawer, withdrawers, err := parseWithdrawers(args[1])
+			if err != nil {
+				return err
+			}
 
 			msg := &types.MsgUpdateFeeShare{
 				ContractAddress:   contract,
 				DeployerAddress:   deployer.String(),
 				WithdrawerAddress: withdrawer,
+				Withdrawers:       withdrawers,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -144,3 +154,59 @@ func NewUpdateFeeShare() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewClaimFeeShare returns a CLI command handler for claiming the fees accrued
+// by the sender
+func NewClaimFeeShare() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim",
+		Short: "Claim the fee share accrued by your withdraw address",
+		Long:  "Claim the fee share accrued by your withdraw address. Fees only accrue while the enable_accrual param is set, otherwise they are paid out with every transaction.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimFeeShare(cliCtx.GetFromAddress())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseWithdrawers parses the withdrawer argument, which is either a single
+// bech32 address or a comma separated list of address:weight pairs.
+func parseWithdrawers(arg string) (string, []types.WeightedWithdrawer, error) {
+	if !strings.Contains(arg, ":") {
+		return arg, nil, nil
+	}
+
+	var withdrawers []types.WeightedWithdrawer
+	for _, pair := range strings.Split(arg, ",") {
+		addr, weight, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok {
+			return "", nil, fmt.Errorf("invalid withdrawer %q, expected address:weight", pair)
+		}
+
+		w, err := sdk.NewDecFromStr(weight)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid weight for withdrawer %s: %w", addr, err)
+		}
+
+		withdrawers = append(withdrawers, types.WeightedWithdrawer{
+			WithdrawerAddress: addr,
+			Weight:            w,
+		})
+	}
+
+	return "", withdrawers, nil
+}
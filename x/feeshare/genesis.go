@@ -1,6 +1,8 @@
 package feeshare
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/CosmosContracts/juno/v26/x/feeshare/keeper"
@@ -20,22 +22,40 @@ func InitGenesis(
 	for _, share := range data.FeeShare {
 		contract := share.GetContractAddr()
 		deployer := share.GetDeployerAddr()
-		withdrawer := share.GetWithdrawerAddr()
 
 		// Set initial contracts receiving transaction fees
 		k.SetFeeShare(ctx, share)
 		k.SetDeployerMap(ctx, deployer, contract)
+		k.SetWithdrawerMaps(ctx, share)
+	}
+
+	// Ensure the module account can back every accrued fee share
+	var ledger sdk.Coins
+	for _, accrued := range data.AccruedFeeShares {
+		ledger = ledger.Add(accrued.Amount...)
+	}
+
+	if balance := k.GetModuleBalance(ctx); !balance.IsAllGTE(ledger) {
+		panic(fmt.Errorf("accrued fee shares (%s) exceed the module account balance (%s)", ledger, balance))
+	}
+
+	// Set the fees accrued by withdrawers that have not been claimed yet
+	for _, accrued := range data.AccruedFeeShares {
+		k.SetAccruedFeeShare(ctx, accrued)
+	}
 
-		if len(withdrawer) != 0 {
-			k.SetWithdrawerMap(ctx, withdrawer, contract)
-		}
+	// Set the developer shares overridden by governance for contracts
+	for _, ratio := range data.ContractFeeShareRatios {
+		k.SetContractRatio(ctx, ratio)
 	}
 }
 
 // ExportGenesis export module state
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		FeeShare: k.GetFeeShares(ctx),
+		Params:                 k.GetParams(ctx),
+		FeeShare:               k.GetFeeShares(ctx),
+		AccruedFeeShares:       k.GetAllAccruedFeeShares(ctx),
+		ContractFeeShareRatios: k.GetContractRatios(ctx),
 	}
 }
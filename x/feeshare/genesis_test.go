@@ -9,6 +9,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/CosmosContracts/juno/v26/app"
 	"github.com/CosmosContracts/juno/v26/x/feeshare"
@@ -84,6 +85,36 @@ func (suite *GenesisTestSuite) TestFeeShareInitGenesis() {
 			},
 			false,
 		},
+		{
+			"custom genesis - accrued fee shares not backed by the module account",
+			types.GenesisState{
+				Params: types.Params{
+					EnableFeeShare:  true,
+					DeveloperShares: sdk.NewDecWithPrec(50, 2),
+					AllowedDenoms:   []string{"ujuno"},
+					EnableAccrual:   true,
+				},
+				AccruedFeeShares: []types.AccruedFeeShare{
+					types.NewAccruedFeeShare(sdk.AccAddress("withdrawer_address_1"), sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(100)))),
+					types.NewAccruedFeeShare(sdk.AccAddress("withdrawer_address_2"), sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(200)))),
+				},
+			},
+			true,
+		},
+		{
+			"custom genesis - contract fee share ratios",
+			types.GenesisState{
+				Params: types.Params{
+					EnableFeeShare:  true,
+					DeveloperShares: sdk.NewDecWithPrec(50, 2),
+					AllowedDenoms:   []string{"ujuno"},
+				},
+				ContractFeeShareRatios: []types.ContractFeeShareRatio{
+					types.NewContractFeeShareRatio(sdk.AccAddress("contract_address_001"), sdk.NewDecWithPrec(90, 2)),
+				},
+			},
+			false,
+		},
 		{
 			"custom genesis - feeshare enabled, all denoms allowed",
 			types.GenesisState{
@@ -112,7 +143,39 @@ func (suite *GenesisTestSuite) TestFeeShareInitGenesis() {
 
 				params := suite.app.AppKeepers.FeeShareKeeper.GetParams(suite.ctx)
 				suite.Require().Equal(tc.genesis.Params, params)
+
+				exported := feeshare.ExportGenesis(suite.ctx, suite.app.AppKeepers.FeeShareKeeper)
+				suite.Require().ElementsMatch(tc.genesis.AccruedFeeShares, exported.AccruedFeeShares)
+				suite.Require().ElementsMatch(tc.genesis.ContractFeeShareRatios, exported.ContractFeeShareRatios)
 			}
 		})
 	}
 }
+
+func (suite *GenesisTestSuite) TestFeeShareInitGenesisAccruedBacking() {
+	genesis := suite.genesis
+	genesis.Params.EnableAccrual = true
+	genesis.AccruedFeeShares = []types.AccruedFeeShare{
+		types.NewAccruedFeeShare(sdk.AccAddress("withdrawer_address_1"), sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(100)))),
+		types.NewAccruedFeeShare(sdk.AccAddress("withdrawer_address_2"), sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(200)))),
+	}
+
+	// The module account must hold the sum of the accrued fee shares
+	backing := sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(299)))
+	bankKeeper := suite.app.AppKeepers.BankKeeper
+	suite.Require().NoError(bankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, backing))
+	suite.Require().NoError(bankKeeper.SendCoinsFromModuleToModule(suite.ctx, minttypes.ModuleName, types.ModuleName, backing))
+	suite.Require().Panics(func() {
+		feeshare.InitGenesis(suite.ctx, suite.app.AppKeepers.FeeShareKeeper, genesis)
+	})
+
+	backing = sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(1)))
+	suite.Require().NoError(bankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, backing))
+	suite.Require().NoError(bankKeeper.SendCoinsFromModuleToModule(suite.ctx, minttypes.ModuleName, types.ModuleName, backing))
+	suite.Require().NotPanics(func() {
+		feeshare.InitGenesis(suite.ctx, suite.app.AppKeepers.FeeShareKeeper, genesis)
+	})
+
+	exported := feeshare.ExportGenesis(suite.ctx, suite.app.AppKeepers.FeeShareKeeper)
+	suite.Require().ElementsMatch(genesis.AccruedFeeShares, exported.AccruedFeeShares)
+}
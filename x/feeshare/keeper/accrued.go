@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/feeshare/types"
+)
+
+// GetAccruedFeeShare returns the fees accrued by a withdrawer that have not
+// been claimed yet.
+func (k Keeper) GetAccruedFeeShare(ctx sdk.Context, withdrawer sdk.AccAddress) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccrued)
+	bz := store.Get(withdrawer.Bytes())
+	if len(bz) == 0 {
+		return sdk.NewCoins()
+	}
+
+	var accrued types.AccruedFeeShare
+	k.cdc.MustUnmarshal(bz, &accrued)
+	return accrued.Amount
+}
+
+// SetAccruedFeeShare stores the fees accrued by a withdrawer. An empty amount
+// removes the withdrawer from the ledger.
+func (k Keeper) SetAccruedFeeShare(ctx sdk.Context, accrued types.AccruedFeeShare) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccrued)
+	key := accrued.GetWithdrawerAddr()
+
+	if accrued.Amount.IsZero() {
+		store.Delete(key.Bytes())
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&accrued)
+	store.Set(key.Bytes(), bz)
+}
+
+// AccrueFeeShare adds fees to the amount a withdrawer is able to claim.
+func (k Keeper) AccrueFeeShare(ctx sdk.Context, withdrawer sdk.AccAddress, fees sdk.Coins) {
+	total := k.GetAccruedFeeShare(ctx, withdrawer).Add(fees...)
+	k.SetAccruedFeeShare(ctx, types.NewAccruedFeeShare(withdrawer, total))
+}
+
+// GetAllAccruedFeeShares returns the unclaimed fees of every withdrawer.
+func (k Keeper) GetAllAccruedFeeShares(ctx sdk.Context) []types.AccruedFeeShare {
+	accrued := []types.AccruedFeeShare{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixAccrued)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var a types.AccruedFeeShare
+		k.cdc.MustUnmarshal(iterator.Value(), &a)
+
+		accrued = append(accrued, a)
+	}
+
+	return accrued
+}
+
+// GetModuleBalance returns the balances held by the FeeShare module account,
+// which back the accrued fee shares.
+func (k Keeper) GetModuleBalance(ctx sdk.Context) sdk.Coins {
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	return k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+}
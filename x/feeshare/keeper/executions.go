@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/feeshare/types"
+)
+
+// contractGasStore returns the transient store tracking the contracts executed in
+// the current transaction, along with the wasm gas each of them used. Tracking
+// never consumes gas, so the cost of a transaction does not depend on it.
+func (k Keeper) contractGasStore(ctx sdk.Context) prefix.Store {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	return prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixContractGas)
+}
+
+// TrackContractExecution records that a contract was executed in the current
+// transaction, adding the wasm gas used to the contract's total. Executions
+// deeper than the MaxExecutionDepth param are not tracked.
+func (k Keeper) TrackContractExecution(ctx sdk.Context, contract sdk.Address, gasUsed uint64) {
+	params := k.GetParams(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+	if !params.EnableFeeShare {
+		return
+	}
+
+	// the call depth is only set for contracts reached through sub-messages
+	if depth, ok := wasmtypes.CallDepth(ctx); ok && depth > params.MaxExecutionDepth {
+		return
+	}
+
+	total := k.GetContractGas(ctx, contract) + gasUsed
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, total)
+	k.contractGasStore(ctx).Set(contract.Bytes(), bz)
+}
+
+// GetContractGas returns the wasm gas used by a contract in the current
+// transaction.
+func (k Keeper) GetContractGas(ctx sdk.Context, contract sdk.Address) uint64 {
+	bz := k.contractGasStore(ctx).Get(contract.Bytes())
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetExecutedContracts returns every contract executed in the current
+// transaction, ordered by address.
+func (k Keeper) GetExecutedContracts(ctx sdk.Context) []sdk.AccAddress {
+	iterator := k.contractGasStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var contracts []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		contracts = append(contracts, sdk.AccAddress(iterator.Key()))
+	}
+
+	return contracts
+}
+
+// ResetContractExecutions clears the tracked contract executions. It is called
+// at the start of each transaction so contracts run by begin and end blockers,
+// or by earlier transactions of the block, are not attributed to it.
+func (k Keeper) ResetContractExecutions(ctx sdk.Context) {
+	store := k.contractGasStore(ctx)
+
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
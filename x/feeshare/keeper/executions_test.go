@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/feeshare/keeper"
+	"github.com/CosmosContracts/juno/v26/x/feeshare/types"
+)
+
+func (s *IntegrationTestSuite) TestContractExecutionTracking() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	routerAddress := s.InstantiateContract(sender.String(), "")
+	router := sdk.MustAccAddressFromBech32(routerAddress)
+
+	dexAddress := s.InstantiateContract(sender.String(), "")
+	dex := sdk.MustAccAddressFromBech32(dexAddress)
+
+	execute := func(ctx sdk.Context, contract string, msg string) {
+		_, err := s.wasmMsgServer.ExecuteContract(sdk.WrapSDKContext(ctx), &wasmtypes.MsgExecuteContract{
+			Sender:   sender.String(),
+			Contract: contract,
+			Msg:      []byte(msg),
+		})
+		s.Require().NoError(err)
+	}
+	changeOwner := func(owner string) string {
+		return fmt.Sprintf(`{"change_owner":{"owner":"%s"}}`, owner)
+	}
+
+	// let the router execute the dex
+	execute(s.ctx, dexAddress, changeOwner(routerAddress))
+
+	k := s.app.AppKeepers.FeeShareKeeper
+	k.ResetContractExecutions(s.ctx)
+
+	// Contracts executed directly are tracked along with their gas, which relies
+	// on the wasm keeper handing the engine its query handler
+	ctx, _ := s.ctx.CacheContext()
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	execute(ctx, routerAddress, changeOwner(sender.String()))
+	gasUsed := k.GetContractGas(ctx, router)
+	s.Require().NotZero(gasUsed)
+	s.Require().Equal([]sdk.AccAddress{router}, k.GetExecutedContracts(ctx))
+
+	execute(ctx, routerAddress, changeOwner(sender.String()))
+	s.Require().Equal(2*gasUsed, k.GetContractGas(ctx, router))
+
+	k.ResetContractExecutions(ctx)
+	s.Require().Zero(k.GetContractGas(ctx, router))
+	s.Require().Empty(k.GetExecutedContracts(ctx))
+
+	// Tracking does not consume gas
+	ctxDisabled, _ := s.ctx.CacheContext()
+	params := k.GetParams(ctxDisabled)
+	params.EnableFeeShare = false
+	s.Require().NoError(k.SetParams(ctxDisabled, params))
+	ctxDisabled = ctxDisabled.WithGasMeter(sdk.NewInfiniteGasMeter())
+	execute(ctxDisabled, routerAddress, changeOwner(sender.String()))
+	s.Require().Empty(k.GetExecutedContracts(ctxDisabled))
+
+	ctx, _ = s.ctx.CacheContext()
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	execute(ctx, routerAddress, changeOwner(sender.String()))
+	s.Require().Equal(ctxDisabled.GasMeter().GasConsumed(), ctx.GasMeter().GasConsumed())
+
+	// Contracts executed through sub-messages are tracked
+	reflect := fmt.Sprintf(
+		`{"reflect_msg":{"msgs":[{"wasm":{"execute":{"contract_addr":"%s","msg":"%s","funds":[]}}}]}}`,
+		dexAddress, base64.StdEncoding.EncodeToString([]byte(changeOwner(routerAddress))),
+	)
+
+	ctx, _ = s.ctx.CacheContext()
+	execute(ctx, routerAddress, reflect)
+	s.Require().ElementsMatch([]sdk.AccAddress{router, dex}, k.GetExecutedContracts(ctx))
+
+	// unless they are deeper than the max execution depth
+	ctx, _ = s.ctx.CacheContext()
+	params = k.GetParams(ctx)
+	params.MaxExecutionDepth = 0
+	s.Require().NoError(k.SetParams(ctx, params))
+	execute(ctx, routerAddress, reflect)
+	s.Require().Equal([]sdk.AccAddress{router}, k.GetExecutedContracts(ctx))
+
+	ctx, _ = s.ctx.CacheContext()
+	params.MaxExecutionDepth = 1
+	s.Require().NoError(k.SetParams(ctx, params))
+	k.TrackContractExecution(wasmtypes.WithCallDepth(ctx, 2), dex, 100)
+	s.Require().Empty(k.GetExecutedContracts(ctx))
+	k.TrackContractExecution(wasmtypes.WithCallDepth(ctx, 1), dex, 100)
+	s.Require().Equal([]sdk.AccAddress{dex}, k.GetExecutedContracts(ctx))
+}
+
+type wrappedQuerier struct {
+	wasmvm.Querier
+}
+
+func (s *IntegrationTestSuite) TestExecutionTrackingEngineQuerier() {
+	_, _, contract := testdata.KeyTestPubAddr()
+	env := wasmvmtypes.Env{Contract: wasmvmtypes.ContractInfo{Address: contract.String()}}
+
+	k := s.app.AppKeepers.FeeShareKeeper
+	engine := keeper.NewExecutionTrackingEngine(&wasmtesting.MockWasmEngine{
+		ExecuteFn: func(wasmvm.Checksum, wasmvmtypes.Env, wasmvmtypes.MessageInfo, []byte, wasmvm.KVStore, wasmvm.GoAPI, wasmvm.Querier, wasmvm.GasMeter, uint64, wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+			return &wasmvmtypes.Response{}, 100, nil
+		},
+	}, &k)
+
+	// The context is taken from the query handler built by the wasm keeper
+	ctx, _ := s.ctx.CacheContext()
+	_, _, err := engine.Execute(nil, env, wasmvmtypes.MessageInfo{}, nil, nil, wasmvm.GoAPI{}, wasmkeeper.QueryHandler{Ctx: ctx}, nil, 0, wasmvmtypes.UFraction{})
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.AccAddress{contract}, k.GetExecutedContracts(ctx))
+	s.Require().Equal(uint64(100), k.GetContractGas(ctx, contract))
+
+	// Any other querier, even one wrapping the query handler, fails the
+	// execution instead of skipping the fee share
+	_, _, err = engine.Execute(nil, env, wasmvmtypes.MessageInfo{}, nil, nil, wasmvm.GoAPI{}, wrappedQuerier{wasmkeeper.QueryHandler{Ctx: ctx}}, nil, 0, wasmvmtypes.UFraction{})
+	s.Require().ErrorIs(err, types.ErrContractExecutionTracking)
+}
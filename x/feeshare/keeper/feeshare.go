@@ -120,6 +120,28 @@ func (k Keeper) DeleteWithdrawerMap(
 	store.Delete(key)
 }
 
+// SetWithdrawerMaps stores a contract-by-withdrawer mapping for every account
+// receiving the transaction fees of a FeeShare
+func (k Keeper) SetWithdrawerMaps(ctx sdk.Context, feeshare types.FeeShare) {
+	contract := feeshare.GetContractAddr()
+	for _, w := range feeshare.WithdrawerShares() {
+		if withdrawer := w.GetWithdrawerAddr(); len(withdrawer) != 0 {
+			k.SetWithdrawerMap(ctx, withdrawer, contract)
+		}
+	}
+}
+
+// DeleteWithdrawerMaps deletes the contract-by-withdrawer mapping of every
+// account receiving the transaction fees of a FeeShare
+func (k Keeper) DeleteWithdrawerMaps(ctx sdk.Context, feeshare types.FeeShare) {
+	contract := feeshare.GetContractAddr()
+	for _, w := range feeshare.WithdrawerShares() {
+		if withdrawer := w.GetWithdrawerAddr(); len(withdrawer) != 0 {
+			k.DeleteWithdrawerMap(ctx, withdrawer, contract)
+		}
+	}
+}
+
 // IsFeeShareRegistered checks if a contract was registered for receiving
 // transaction fees
 func (k Keeper) IsFeeShareRegistered(
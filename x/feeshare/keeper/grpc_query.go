@@ -177,3 +177,90 @@ func (q Querier) WithdrawerFeeShares( // nolint: dupl
 		Pagination:        pageRes,
 	}, nil
 }
+
+// AccruedFeeShare returns the unclaimed fees accrued by a given withdraw address
+func (q Querier) AccruedFeeShare(
+	c context.Context,
+	req *types.QueryAccruedFeeShareRequest,
+) (*types.QueryAccruedFeeShareResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	withdrawer, err := sdk.AccAddressFromBech32(req.WithdrawerAddress)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for withdraw addr %s, should be bech32 ('juno...')", req.WithdrawerAddress,
+		)
+	}
+
+	return &types.QueryAccruedFeeShareResponse{
+		Amount: q.GetAccruedFeeShare(ctx, withdrawer),
+	}, nil
+}
+
+// AccruedFeeShares returns the unclaimed fees of every withdrawer
+func (q Querier) AccruedFeeShares(
+	c context.Context,
+	req *types.QueryAccruedFeeSharesRequest,
+) (*types.QueryAccruedFeeSharesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var accrued []types.AccruedFeeShare
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyPrefixAccrued)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var a types.AccruedFeeShare
+		if err := q.cdc.Unmarshal(value, &a); err != nil {
+			return err
+		}
+		accrued = append(accrued, a)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAccruedFeeSharesResponse{
+		AccruedFeeShares: accrued,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// ContractFeeShareRatios returns the developer shares set by governance for
+// individual contracts
+func (q Querier) ContractFeeShareRatios(
+	c context.Context,
+	req *types.QueryContractFeeShareRatiosRequest,
+) (*types.QueryContractFeeShareRatiosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var ratios []types.ContractFeeShareRatio
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyPrefixContractRatio)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var ratio types.ContractFeeShareRatio
+		if err := q.cdc.Unmarshal(value, &ratio); err != nil {
+			return err
+		}
+		ratios = append(ratios, ratio)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryContractFeeShareRatiosResponse{
+		Ratios:     ratios,
+		Pagination: pageRes,
+	}, nil
+}
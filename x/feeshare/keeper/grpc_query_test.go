@@ -258,3 +258,42 @@ func (s *IntegrationTestSuite) TestWithdrawerFeeShares() {
 		s.Require().ElementsMatch(nullify.Fill(contractAddressList), nullify.Fill(resp.ContractAddresses))
 	})
 }
+
+func (s *IntegrationTestSuite) TestAccruedFeeShares() {
+	s.SetupTest()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	var accruedList []types.AccruedFeeShare
+	for i := int64(1); i <= 3; i++ {
+		_, _, withdrawer := testdata.KeyTestPubAddr()
+		accrued := types.NewAccruedFeeShare(withdrawer, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(i*100))))
+		s.app.AppKeepers.FeeShareKeeper.SetAccruedFeeShare(s.ctx, accrued)
+		accruedList = append(accruedList, accrued)
+	}
+
+	// Single withdrawer
+	resp, err := s.queryClient.AccruedFeeShare(goCtx, &types.QueryAccruedFeeShareRequest{
+		WithdrawerAddress: accruedList[1].WithdrawerAddress,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(accruedList[1].Amount, resp.Amount)
+
+	// Unknown withdrawers have nothing accrued
+	_, _, unknown := testdata.KeyTestPubAddr()
+	resp, err = s.queryClient.AccruedFeeShare(goCtx, &types.QueryAccruedFeeShareRequest{
+		WithdrawerAddress: unknown.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().True(resp.Amount.IsZero())
+
+	_, err = s.queryClient.AccruedFeeShare(goCtx, &types.QueryAccruedFeeShareRequest{})
+	s.Require().Error(err)
+
+	// All withdrawers
+	allResp, err := s.queryClient.AccruedFeeShares(goCtx, &types.QueryAccruedFeeSharesRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(len(accruedList), int(allResp.Pagination.Total))
+	s.Require().ElementsMatch(accruedList, allResp.AccruedFeeShares)
+}
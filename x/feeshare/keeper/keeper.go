@@ -17,8 +17,9 @@ import (
 // Keeper of this module maintains collections of feeshares for contracts
 // registered to receive transaction fees.
 type Keeper struct {
-	storeKey storetypes.StoreKey
-	cdc      codec.BinaryCodec
+	storeKey     storetypes.StoreKey
+	transientKey storetypes.StoreKey
+	cdc          codec.BinaryCodec
 
 	bankKeeper    revtypes.BankKeeper
 	wasmKeeper    wasmkeeper.Keeper
@@ -34,6 +35,7 @@ type Keeper struct {
 // NewKeeper creates new instances of the fees Keeper
 func NewKeeper(
 	storeKey storetypes.StoreKey,
+	transientKey storetypes.StoreKey,
 	cdc codec.BinaryCodec,
 	bk revtypes.BankKeeper,
 	wk wasmkeeper.Keeper,
@@ -43,6 +45,7 @@ func NewKeeper(
 ) Keeper {
 	return Keeper{
 		storeKey:         storeKey,
+		transientKey:     transientKey,
 		cdc:              cdc,
 		bankKeeper:       bk,
 		wasmKeeper:       wk,
@@ -5,6 +5,7 @@ import (
 
 	"github.com/CosmosContracts/juno/v26/x/feeshare/exported"
 	v2 "github.com/CosmosContracts/juno/v26/x/feeshare/migrations/v2"
+	v3 "github.com/CosmosContracts/juno/v26/x/feeshare/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place state migrations.
@@ -27,3 +28,9 @@ func NewMigrator(k Keeper, ss exported.Subspace) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates the x/feeshare module state from the consensus version 2 to
+// version 3. Specifically, it sets the max execution depth param to its default.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
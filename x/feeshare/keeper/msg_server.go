@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
@@ -112,10 +114,18 @@ func (k Keeper) RegisterFeeShare(
 		return nil, errorsmod.Wrapf(types.ErrFeeShareAlreadyRegistered, "contract is already registered %s", contract)
 	}
 
-	// Get the withdraw address of the contract
-	withdrawer, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
-	if err != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdrawer address %s", msg.WithdrawerAddress)
+	// Get the withdraw address of the contract, unless fees are split between
+	// weighted withdrawers
+	var withdrawer sdk.AccAddress
+	if len(msg.Withdrawers) != 0 {
+		if err := types.ValidateWithdrawers(msg.Withdrawers); err != nil {
+			return nil, err
+		}
+	} else {
+		withdrawer, err = sdk.AccAddressFromBech32(msg.WithdrawerAddress)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdrawer address %s", msg.WithdrawerAddress)
+		}
 	}
 
 	// ensure msg.DeployerAddress is  valid
@@ -128,7 +138,7 @@ func (k Keeper) RegisterFeeShare(
 
 	if k.GetIfContractWasCreatedFromFactory(ctx, msgSender, k.wasmKeeper.GetContractInfo(ctx, contract)) {
 		// Anyone is allowed to register a contract to itself if it was created from a factory contract
-		if msg.WithdrawerAddress != msg.ContractAddress {
+		if len(msg.Withdrawers) != 0 || msg.WithdrawerAddress != msg.ContractAddress {
 			return nil, errorsmod.Wrapf(types.ErrFeeShareInvalidWithdrawer, "withdrawer address must be the same as the contract address if it is from a factory contract withdraw:%s contract:%s", msg.WithdrawerAddress, msg.ContractAddress)
 		}
 
@@ -147,25 +157,32 @@ func (k Keeper) RegisterFeeShare(
 	}
 
 	// prevent storing the same address for deployer and withdrawer
-	feeshare := types.NewFeeShare(contract, deployer, withdrawer)
+	var feeshare types.FeeShare
+	if len(msg.Withdrawers) != 0 {
+		feeshare = types.NewWeightedFeeShare(contract, deployer, msg.Withdrawers)
+	} else {
+		feeshare = types.NewFeeShare(contract, deployer, withdrawer)
+	}
 	k.SetFeeShare(ctx, feeshare)
 	k.SetDeployerMap(ctx, deployer, contract)
-	k.SetWithdrawerMap(ctx, withdrawer, contract)
+	k.SetWithdrawerMaps(ctx, feeshare)
 
 	k.Logger(ctx).Debug(
 		"registering contract for transaction fees",
 		"contract", msg.ContractAddress,
 		"deployer", msg.DeployerAddress,
 		"withdraw", msg.WithdrawerAddress,
+		"withdrawers", len(msg.Withdrawers),
 	)
 
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
 				types.EventTypeRegisterFeeShare,
-				// sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress), // SDK v47
-				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
-				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, msg.WithdrawerAddress),
+				withdrawerAttributes(feeshare,
+					// sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress), // SDK v47
+					sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+				)...,
 			),
 		},
 	)
@@ -202,43 +219,51 @@ func (k Keeper) UpdateFeeShare(
 		)
 	}
 
-	// feeshare with the given withdraw address is already registered
-	if msg.WithdrawerAddress == feeshare.WithdrawerAddress {
-		return nil, errorsmod.Wrapf(types.ErrFeeShareAlreadyRegistered, "feeshare with withdraw address %s is already registered", msg.WithdrawerAddress)
-	}
-
 	// Check that the person who signed the message is the wasm contract admin, if so return the deployer address
 	_, err = k.GetContractAdminOrCreatorAddress(ctx, contract, msg.DeployerAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	withdrawAddr, err := sdk.AccAddressFromBech32(feeshare.WithdrawerAddress)
-	if err != nil {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInvalidAddress,
-			"invalid withdrawer address (%s)", err,
-		)
+	// Build the updated feeshare, either with a single withdrawer or with the
+	// replacement list of weighted withdrawers
+	updated := feeshare
+	if len(msg.Withdrawers) != 0 {
+		if err := types.ValidateWithdrawers(msg.Withdrawers); err != nil {
+			return nil, err
+		}
+
+		updated.WithdrawerAddress = ""
+		updated.Withdrawers = msg.Withdrawers
+	} else {
+		newWithdrawAddr, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid WithdrawerAddress %s", msg.WithdrawerAddress)
+		}
+
+		updated.WithdrawerAddress = newWithdrawAddr.String()
+		updated.Withdrawers = nil
 	}
-	newWithdrawAddr, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
-	if err != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid WithdrawerAddress %s", msg.WithdrawerAddress)
+
+	// feeshare with the given withdrawers is already registered
+	if withdrawersEqual(feeshare.WithdrawerShares(), updated.WithdrawerShares()) {
+		return nil, errorsmod.Wrapf(types.ErrFeeShareAlreadyRegistered, "feeshare with withdrawers %s is already registered", formatWithdrawers(updated.WithdrawerShares()))
 	}
 
-	k.DeleteWithdrawerMap(ctx, withdrawAddr, contract)
-	k.SetWithdrawerMap(ctx, newWithdrawAddr, contract)
+	k.DeleteWithdrawerMaps(ctx, feeshare)
+	k.SetWithdrawerMaps(ctx, updated)
 
 	// update feeshare
-	feeshare.WithdrawerAddress = newWithdrawAddr.String()
-	k.SetFeeShare(ctx, feeshare)
+	k.SetFeeShare(ctx, updated)
 
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
 				types.EventTypeUpdateFeeShare,
-				// sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress), // SDK v47
-				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
-				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, msg.WithdrawerAddress),
+				withdrawerAttributes(updated,
+					// sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress), // SDK v47
+					sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+				)...,
 			),
 		},
 	)
@@ -281,14 +306,7 @@ func (k Keeper) CancelFeeShare(
 		contract,
 	)
 
-	withdrawAddr := fee.GetWithdrawerAddr()
-	if withdrawAddr != nil {
-		k.DeleteWithdrawerMap(
-			ctx,
-			withdrawAddr,
-			contract,
-		)
-	}
+	k.DeleteWithdrawerMaps(ctx, fee)
 
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
@@ -303,6 +321,90 @@ func (k Keeper) CancelFeeShare(
 	return &types.MsgCancelFeeShareResponse{}, nil
 }
 
+// ClaimFeeShare sends the fees accrued by a withdrawer from the module account
+// to the withdrawer. Claims are allowed while the module is disabled so that
+// accrued fees are never locked.
+func (k Keeper) ClaimFeeShare(
+	goCtx context.Context,
+	msg *types.MsgClaimFeeShare,
+) (*types.MsgClaimFeeShareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	withdrawer, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdrawer address %s", msg.WithdrawerAddress)
+	}
+
+	amount := k.GetAccruedFeeShare(ctx, withdrawer)
+	if amount.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrFeeShareNothingAccrued, "withdrawer %s", msg.WithdrawerAddress)
+	}
+
+	k.SetAccruedFeeShare(ctx, types.NewAccruedFeeShare(withdrawer, sdk.NewCoins()))
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawer, amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeClaimFeeShare,
+				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, msg.WithdrawerAddress),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			),
+		},
+	)
+
+	return &types.MsgClaimFeeShareResponse{Amount: amount}, nil
+}
+
+// SetContractFeeShareRatio overrides the developer share of a single contract.
+// An unset developer share removes the override.
+func (k Keeper) SetContractFeeShareRatio(
+	goCtx context.Context,
+	msg *types.MsgSetContractFeeShareRatio,
+) (*types.MsgSetContractFeeShareRatioResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := sdk.AccAddressFromBech32(msg.ContractAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+
+	developerShares := ""
+	if msg.DeveloperShares == nil {
+		if _, found := k.GetContractRatio(ctx, contract); !found {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "no fee share ratio set for contract %s", msg.ContractAddress)
+		}
+
+		k.DeleteContractRatio(ctx, contract)
+	} else {
+		if !k.wasmKeeper.HasContractInfo(ctx, contract) {
+			return nil, errorsmod.Wrapf(types.ErrFeeShareNoContractDeployed, "contract %s", msg.ContractAddress)
+		}
+
+		k.SetContractRatio(ctx, types.NewContractFeeShareRatio(contract, *msg.DeveloperShares))
+		developerShares = msg.DeveloperShares.String()
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSetContractFeeShareRatio,
+				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyDeveloperShares, developerShares),
+			),
+		},
+	)
+
+	return &types.MsgSetContractFeeShareRatioResponse{}, nil
+}
+
 func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.authority != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
@@ -315,3 +417,38 @@ func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// withdrawerAttributes appends a withdrawer address attribute for every account
+// receiving the transaction fees of a FeeShare.
+func withdrawerAttributes(feeshare types.FeeShare, attrs ...sdk.Attribute) []sdk.Attribute {
+	for _, w := range feeshare.WithdrawerShares() {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, w.WithdrawerAddress))
+	}
+	return attrs
+}
+
+// formatWithdrawers returns the withdrawers of a list along with their weights,
+// for error messages.
+func formatWithdrawers(withdrawers []types.WeightedWithdrawer) string {
+	formatted := make([]string, len(withdrawers))
+	for i, w := range withdrawers {
+		formatted[i] = fmt.Sprintf("%s:%s", w.WithdrawerAddress, w.Weight)
+	}
+	return strings.Join(formatted, ",")
+}
+
+// withdrawersEqual returns true if both lists pay the same withdrawers the same
+// weights in the same order.
+func withdrawersEqual(a, b []types.WeightedWithdrawer) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].WithdrawerAddress != b[i].WithdrawerAddress || !a[i].Weight.Equal(b[i].Weight) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/CosmosContracts/juno/v26/x/feeshare/types"
@@ -398,3 +399,216 @@ func (s *IntegrationTestSuite) TestCancelFeeShare() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestWeightedWithdrawersFeeShare() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	contractAddress := s.InstantiateContract(sender.String(), "")
+	contract := sdk.MustAccAddressFromBech32(contractAddress)
+
+	_, _, withdrawerA := testdata.KeyTestPubAddr()
+	_, _, withdrawerB := testdata.KeyTestPubAddr()
+	_, _, withdrawerC := testdata.KeyTestPubAddr()
+
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	// Register with weights that do not sum to 1
+	_, err := s.feeShareMsgServer.RegisterFeeShare(goCtx, &types.MsgRegisterFeeShare{
+		ContractAddress: contractAddress,
+		DeployerAddress: sender.String(),
+		Withdrawers: []types.WeightedWithdrawer{
+			{WithdrawerAddress: withdrawerA.String(), Weight: sdk.NewDecWithPrec(50, 2)},
+			{WithdrawerAddress: withdrawerB.String(), Weight: sdk.NewDecWithPrec(40, 2)},
+		},
+	})
+	s.Require().ErrorIs(err, types.ErrFeeShareInvalidWithdrawer)
+
+	// Register split between two withdrawers
+	_, err = s.feeShareMsgServer.RegisterFeeShare(goCtx, &types.MsgRegisterFeeShare{
+		ContractAddress: contractAddress,
+		DeployerAddress: sender.String(),
+		Withdrawers: []types.WeightedWithdrawer{
+			{WithdrawerAddress: withdrawerA.String(), Weight: sdk.NewDecWithPrec(50, 2)},
+			{WithdrawerAddress: withdrawerB.String(), Weight: sdk.NewDecWithPrec(50, 2)},
+		},
+	})
+	s.Require().NoError(err)
+
+	feeshare, found := s.app.AppKeepers.FeeShareKeeper.GetFeeShare(s.ctx, contract)
+	s.Require().True(found)
+	s.Require().Empty(feeshare.WithdrawerAddress)
+	s.Require().Len(feeshare.Withdrawers, 2)
+	s.Require().True(s.app.AppKeepers.FeeShareKeeper.IsWithdrawerMapSet(s.ctx, withdrawerA, contract))
+	s.Require().True(s.app.AppKeepers.FeeShareKeeper.IsWithdrawerMapSet(s.ctx, withdrawerB, contract))
+
+	// Replace the withdrawers list
+	_, err = s.feeShareMsgServer.UpdateFeeShare(goCtx, &types.MsgUpdateFeeShare{
+		ContractAddress: contractAddress,
+		DeployerAddress: sender.String(),
+		Withdrawers: []types.WeightedWithdrawer{
+			{WithdrawerAddress: withdrawerB.String(), Weight: sdk.NewDecWithPrec(30, 2)},
+			{WithdrawerAddress: withdrawerC.String(), Weight: sdk.NewDecWithPrec(70, 2)},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().False(s.app.AppKeepers.FeeShareKeeper.IsWithdrawerMapSet(s.ctx, withdrawerA, contract))
+	s.Require().True(s.app.AppKeepers.FeeShareKeeper.IsWithdrawerMapSet(s.ctx, withdrawerB, contract))
+	s.Require().True(s.app.AppKeepers.FeeShareKeeper.IsWithdrawerMapSet(s.ctx, withdrawerC, contract))
+
+	// Updating to the same withdrawers list fails
+	_, err = s.feeShareMsgServer.UpdateFeeShare(goCtx, &types.MsgUpdateFeeShare{
+		ContractAddress: contractAddress,
+		DeployerAddress: sender.String(),
+		Withdrawers: []types.WeightedWithdrawer{
+			{WithdrawerAddress: withdrawerB.String(), Weight: sdk.NewDecWithPrec(30, 2)},
+			{WithdrawerAddress: withdrawerC.String(), Weight: sdk.NewDecWithPrec(70, 2)},
+		},
+	})
+	s.Require().ErrorIs(err, types.ErrFeeShareAlreadyRegistered)
+	s.Require().ErrorContains(err, withdrawerB.String())
+	s.Require().ErrorContains(err, withdrawerC.String())
+
+	// Go back to a single withdrawer
+	_, err = s.feeShareMsgServer.UpdateFeeShare(goCtx, &types.MsgUpdateFeeShare{
+		ContractAddress:   contractAddress,
+		DeployerAddress:   sender.String(),
+		WithdrawerAddress: withdrawerA.String(),
+	})
+	s.Require().NoError(err)
+
+	feeshare, found = s.app.AppKeepers.FeeShareKeeper.GetFeeShare(s.ctx, contract)
+	s.Require().True(found)
+	s.Require().Equal(withdrawerA.String(), feeshare.WithdrawerAddress)
+	s.Require().Empty(feeshare.Withdrawers)
+	s.Require().True(s.app.AppKeepers.FeeShareKeeper.IsWithdrawerMapSet(s.ctx, withdrawerA, contract))
+	s.Require().False(s.app.AppKeepers.FeeShareKeeper.IsWithdrawerMapSet(s.ctx, withdrawerB, contract))
+	s.Require().False(s.app.AppKeepers.FeeShareKeeper.IsWithdrawerMapSet(s.ctx, withdrawerC, contract))
+
+	// Split again, then cancel and ensure every index is removed
+	_, err = s.feeShareMsgServer.UpdateFeeShare(goCtx, &types.MsgUpdateFeeShare{
+		ContractAddress: contractAddress,
+		DeployerAddress: sender.String(),
+		Withdrawers: []types.WeightedWithdrawer{
+			{WithdrawerAddress: withdrawerB.String(), Weight: sdk.NewDecWithPrec(30, 2)},
+			{WithdrawerAddress: withdrawerC.String(), Weight: sdk.NewDecWithPrec(70, 2)},
+		},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.feeShareMsgServer.CancelFeeShare(goCtx, &types.MsgCancelFeeShare{
+		ContractAddress: contractAddress,
+		DeployerAddress: sender.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().False(s.app.AppKeepers.FeeShareKeeper.IsWithdrawerMapSet(s.ctx, withdrawerB, contract))
+	s.Require().False(s.app.AppKeepers.FeeShareKeeper.IsWithdrawerMapSet(s.ctx, withdrawerC, contract))
+}
+
+func (s *IntegrationTestSuite) TestClaimFeeShare() {
+	_, _, withdrawer := testdata.KeyTestPubAddr()
+	accrued := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000)))
+
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	msg := types.NewMsgClaimFeeShare(withdrawer)
+
+	// Nothing to claim yet
+	_, err := s.feeShareMsgServer.ClaimFeeShare(goCtx, msg)
+	s.Require().ErrorIs(err, types.ErrFeeShareNothingAccrued)
+
+	// Mock fees accrued by the post handler
+	s.Require().NoError(s.FundAccount(s.ctx, withdrawer, accrued))
+	s.Require().NoError(s.bankKeeper.SendCoinsFromAccountToModule(s.ctx, withdrawer, types.ModuleName, accrued))
+	s.app.AppKeepers.FeeShareKeeper.AccrueFeeShare(s.ctx, withdrawer, accrued)
+
+	// Claims are allowed while the module is disabled
+	params := s.app.AppKeepers.FeeShareKeeper.GetParams(s.ctx)
+	params.EnableFeeShare = false
+	s.Require().NoError(s.app.AppKeepers.FeeShareKeeper.SetParams(s.ctx, params))
+
+	res, err := s.feeShareMsgServer.ClaimFeeShare(goCtx, msg)
+	s.Require().NoError(err)
+	s.Require().Equal(accrued, res.Amount)
+	s.Require().Equal(accrued, s.app.AppKeepers.BankKeeper.GetAllBalances(s.ctx, withdrawer))
+	s.Require().True(s.app.AppKeepers.FeeShareKeeper.GetAccruedFeeShare(s.ctx, withdrawer).IsZero())
+
+	// The ledger entry is removed once claimed
+	_, err = s.feeShareMsgServer.ClaimFeeShare(goCtx, msg)
+	s.Require().ErrorIs(err, types.ErrFeeShareNothingAccrued)
+}
+
+func (s *IntegrationTestSuite) TestSetContractFeeShareRatio() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	contractAddress := s.InstantiateContract(sender.String(), "")
+	contract := sdk.MustAccAddressFromBech32(contractAddress)
+	authority := s.app.AppKeepers.FeeShareKeeper.GetAuthority()
+	ratio := sdk.NewDecWithPrec(90, 2)
+
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	for _, tc := range []struct {
+		desc string
+		msg  *types.MsgSetContractFeeShareRatio
+		err  error
+	}{
+		{
+			desc: "invalid authority",
+			msg: &types.MsgSetContractFeeShareRatio{
+				Authority:       sender.String(),
+				ContractAddress: contractAddress,
+				DeveloperShares: &ratio,
+			},
+			err: govtypes.ErrInvalidSigner,
+		},
+		{
+			desc: "not a contract",
+			msg: &types.MsgSetContractFeeShareRatio{
+				Authority:       authority,
+				ContractAddress: sender.String(),
+				DeveloperShares: &ratio,
+			},
+			err: types.ErrFeeShareNoContractDeployed,
+		},
+		{
+			desc: "remove unset ratio",
+			msg: &types.MsgSetContractFeeShareRatio{
+				Authority:       authority,
+				ContractAddress: contractAddress,
+			},
+			err: sdkerrors.ErrNotFound,
+		},
+	} {
+		s.Run(tc.desc, func() {
+			_, err := s.feeShareMsgServer.SetContractFeeShareRatio(goCtx, tc.msg)
+			s.Require().ErrorIs(err, tc.err)
+		})
+	}
+
+	// Set the ratio of the contract
+	_, err := s.feeShareMsgServer.SetContractFeeShareRatio(goCtx, &types.MsgSetContractFeeShareRatio{
+		Authority:       authority,
+		ContractAddress: contractAddress,
+		DeveloperShares: &ratio,
+	})
+	s.Require().NoError(err)
+
+	stored, found := s.app.AppKeepers.FeeShareKeeper.GetContractRatio(s.ctx, contract)
+	s.Require().True(found)
+	s.Require().Equal(ratio, stored)
+
+	resp, err := s.queryClient.ContractFeeShareRatios(goCtx, &types.QueryContractFeeShareRatiosRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ContractFeeShareRatio{types.NewContractFeeShareRatio(contract, sdk.NewDecWithPrec(90, 2))}, resp.Ratios)
+
+	// Remove it again
+	_, err = s.feeShareMsgServer.SetContractFeeShareRatio(goCtx, &types.MsgSetContractFeeShareRatio{
+		Authority:       authority,
+		ContractAddress: contractAddress,
+	})
+	s.Require().NoError(err)
+
+	_, found = s.app.AppKeepers.FeeShareKeeper.GetContractRatio(s.ctx, contract)
+	s.Require().False(found)
+}
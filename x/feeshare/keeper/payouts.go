@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/CosmosContracts/juno/v26/x/feeshare/types"
+)
+
+// FeeSharePayouts returns the contracts to pay out of the total fees, along
+// with the fees owed to each of them. It returns no contracts if fee sharing
+// is disabled.
+func (k Keeper) FeeSharePayouts(ctx sdk.Context, totalFees sdk.Coins, msgs []sdk.Msg) ([]types.FeeShare, []sdk.Coins, error) {
+	params := k.GetParams(ctx)
+	if !params.EnableFeeShare {
+		return nil, nil, nil
+	}
+
+	// Get FeeShares of the executed contracts
+	toPay := make([]types.FeeShare, 0)
+
+	// Add fee share payouts for each msg
+	err := k.addNewFeeSharePayoutsForMsgs(ctx, &toPay, msgs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Add fee share payouts for contracts executed indirectly
+	k.addNewFeeSharePayoutsForExecutions(ctx, &toPay)
+
+	if len(toPay) == 0 {
+		return nil, nil, nil
+	}
+
+	// Get only allowed governance fees to be paid (helps for taxes)
+	var fees sdk.Coins
+	if len(params.AllowedDenoms) == 0 {
+		// If empty, we allow all denoms to be used as payment
+		fees = totalFees
+	} else {
+		for _, fee := range totalFees.Sort() {
+			for _, allowed := range params.AllowedDenoms {
+				if fee.Denom == allowed {
+					fees = fees.Add(fee)
+				}
+			}
+		}
+	}
+
+	toPay, contractFees := k.splitContractFees(ctx, params, fees, toPay)
+	return toPay, contractFees, nil
+}
+
+// splitContractFees returns the contracts to pay along with the fees owed to
+// each of them, using each contract's own developer share when set. In gas
+// weighted mode every contract is paid once, in proportion to the gas it used;
+// if no gas was tracked the fees are split evenly instead.
+func (k Keeper) splitContractFees(ctx sdk.Context, params types.Params, fees sdk.Coins, toPay []types.FeeShare) ([]types.FeeShare, []sdk.Coins) {
+	if params.DistributionMode == types.DistributionModeGasWeighted {
+		var (
+			unique   []types.FeeShare
+			gasUsed  []uint64
+			totalGas uint64
+			seen     = make(map[string]bool, len(toPay))
+		)
+
+		for _, share := range toPay {
+			if seen[share.ContractAddress] {
+				continue
+			}
+			seen[share.ContractAddress] = true
+
+			gas := k.GetContractGas(ctx, share.GetContractAddr())
+			unique = append(unique, share)
+			gasUsed = append(gasUsed, gas)
+			totalGas += gas
+		}
+
+		if totalGas != 0 {
+			contractFees := make([]sdk.Coins, len(unique))
+			for i := range unique {
+				contractFees[i] = types.GasWeightedFees(fees, k.developerShares(ctx, params, unique[i]), gasUsed[i], totalGas)
+			}
+			return unique, contractFees
+		}
+	}
+
+	// pay fees evenly between all contracts
+	contractFees := make([]sdk.Coins, len(toPay))
+	for i, share := range toPay {
+		contractFees[i] = types.FeePayLogic(fees, k.developerShares(ctx, params, share), len(toPay))
+	}
+	return toPay, contractFees
+}
+
+// developerShares returns the developer share governance set for a contract,
+// falling back to the DeveloperShares param.
+func (k Keeper) developerShares(ctx sdk.Context, params types.Params, share types.FeeShare) sdk.Dec {
+	if ratio, found := k.GetContractRatio(ctx, share.GetContractAddr()); found {
+		return ratio
+	}
+	return params.DeveloperShares
+}
+
+// Loop through all messages and add the FeeShare of every contract that opted-in
+// to fee sharing to the list of FeeShares to pay
+func (k Keeper) addNewFeeSharePayoutsForMsgs(ctx sdk.Context, toPay *[]types.FeeShare, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+
+		// Check if an authz message, loop through all inner messages, and recursively call this function
+		if authzMsg, ok := msg.(*authz.MsgExec); ok {
+
+			innerMsgs, err := authzMsg.GetMessages()
+			if err != nil {
+				return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+			}
+
+			// Recursively call this function with the inner messages
+			err = k.addNewFeeSharePayoutsForMsgs(ctx, toPay, innerMsgs)
+			if err != nil {
+				return err
+			}
+		}
+
+		// If an execute contract message, check if the contract opted-in to fee sharing,
+		// and if so, add its FeeShare to the list of FeeShares to pay
+		if execContractMsg, ok := msg.(*wasmtypes.MsgExecuteContract); ok {
+			contractAddr, err := sdk.AccAddressFromBech32(execContractMsg.Contract)
+			if err != nil {
+				return err
+			}
+
+			shareData, found := k.GetFeeShare(ctx, contractAddr)
+			if found && len(shareData.WithdrawerShares()) != 0 {
+				*toPay = append(*toPay, shareData)
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// Add the FeeShare of every registered contract executed during the tx, through
+// sub-messages, IBC hooks, migrations or sudo calls, that is not yet in the list
+// of FeeShares to pay
+func (k Keeper) addNewFeeSharePayoutsForExecutions(ctx sdk.Context, toPay *[]types.FeeShare) {
+	paying := make(map[string]bool, len(*toPay))
+	for _, share := range *toPay {
+		paying[share.ContractAddress] = true
+	}
+
+	for _, contractAddr := range k.GetExecutedContracts(ctx) {
+		if paying[contractAddr.String()] {
+			continue
+		}
+
+		shareData, found := k.GetFeeShare(ctx, contractAddr)
+		if found && len(shareData.WithdrawerShares()) != 0 {
+			*toPay = append(*toPay, shareData)
+			paying[shareData.ContractAddress] = true
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/feeshare/types"
+)
+
+// GetContractRatio returns the developer share set by governance for a
+// contract, if any.
+func (k Keeper) GetContractRatio(ctx sdk.Context, contract sdk.Address) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractRatio)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return sdk.Dec{}, false
+	}
+
+	var ratio types.ContractFeeShareRatio
+	k.cdc.MustUnmarshal(bz, &ratio)
+	return ratio.DeveloperShares, true
+}
+
+// SetContractRatio stores the developer share overriding the
+// developer_shares param for a contract.
+func (k Keeper) SetContractRatio(ctx sdk.Context, ratio types.ContractFeeShareRatio) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractRatio)
+	key := ratio.GetContractAddr()
+	bz := k.cdc.MustMarshal(&ratio)
+	store.Set(key.Bytes(), bz)
+}
+
+// DeleteContractRatio removes the developer share override of a
+// contract.
+func (k Keeper) DeleteContractRatio(ctx sdk.Context, contract sdk.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractRatio)
+	store.Delete(contract.Bytes())
+}
+
+// GetContractRatios returns the developer share overrides of every
+// contract.
+func (k Keeper) GetContractRatios(ctx sdk.Context) []types.ContractFeeShareRatio {
+	ratios := []types.ContractFeeShareRatio{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixContractRatio)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ratio types.ContractFeeShareRatio
+		k.cdc.MustUnmarshal(iterator.Value(), &ratio)
+
+		ratios = append(ratios, ratio)
+	}
+
+	return ratios
+}
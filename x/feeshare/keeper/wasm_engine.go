@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/feeshare/types"
+)
+
+// WithContractExecutionTracking returns a wasm keeper option recording every
+// contract executed in a transaction along with the gas it used, so fees can be
+// shared with contracts reached through sub-messages, IBC hooks, migrations and
+// sudo calls.
+//
+// The keeper is passed by reference as the wasm keeper must be created before the
+// feeshare keeper.
+func WithContractExecutionTracking(k *Keeper) wasmkeeper.Option {
+	return wasmkeeper.WithWasmEngineDecorator(func(old wasmtypes.WasmEngine) wasmtypes.WasmEngine {
+		return NewExecutionTrackingEngine(old, k)
+	})
+}
+
+// NewExecutionTrackingEngine decorates a wasm engine so it reports each contract
+// execution to the feeshare keeper.
+func NewExecutionTrackingEngine(engine wasmtypes.WasmEngine, k *Keeper) wasmtypes.WasmEngine {
+	return executionTrackingEngine{WasmEngine: engine, keeper: k}
+}
+
+// executionTrackingEngine decorates a wasm engine, reporting each contract
+// execution to the feeshare keeper.
+type executionTrackingEngine struct {
+	wasmtypes.WasmEngine
+	keeper *Keeper
+}
+
+func (e executionTrackingEngine) Instantiate(
+	checksum wasmvm.Checksum,
+	env wasmvmtypes.Env,
+	info wasmvmtypes.MessageInfo,
+	initMsg []byte,
+	store wasmvm.KVStore,
+	goapi wasmvm.GoAPI,
+	querier wasmvm.Querier,
+	gasMeter wasmvm.GasMeter,
+	gasLimit uint64,
+	deserCost wasmvmtypes.UFraction,
+) (*wasmvmtypes.Response, uint64, error) {
+	res, gasUsed, err := e.WasmEngine.Instantiate(checksum, env, info, initMsg, store, goapi, querier, gasMeter, gasLimit, deserCost)
+	if trackErr := e.track(querier, env.Contract.Address, gasUsed); trackErr != nil {
+		return nil, gasUsed, trackErr
+	}
+	return res, gasUsed, err
+}
+
+func (e executionTrackingEngine) Execute(
+	code wasmvm.Checksum,
+	env wasmvmtypes.Env,
+	info wasmvmtypes.MessageInfo,
+	executeMsg []byte,
+	store wasmvm.KVStore,
+	goapi wasmvm.GoAPI,
+	querier wasmvm.Querier,
+	gasMeter wasmvm.GasMeter,
+	gasLimit uint64,
+	deserCost wasmvmtypes.UFraction,
+) (*wasmvmtypes.Response, uint64, error) {
+	res, gasUsed, err := e.WasmEngine.Execute(code, env, info, executeMsg, store, goapi, querier, gasMeter, gasLimit, deserCost)
+	if trackErr := e.track(querier, env.Contract.Address, gasUsed); trackErr != nil {
+		return nil, gasUsed, trackErr
+	}
+	return res, gasUsed, err
+}
+
+func (e executionTrackingEngine) Migrate(
+	checksum wasmvm.Checksum,
+	env wasmvmtypes.Env,
+	migrateMsg []byte,
+	store wasmvm.KVStore,
+	goapi wasmvm.GoAPI,
+	querier wasmvm.Querier,
+	gasMeter wasmvm.GasMeter,
+	gasLimit uint64,
+	deserCost wasmvmtypes.UFraction,
+) (*wasmvmtypes.Response, uint64, error) {
+	res, gasUsed, err := e.WasmEngine.Migrate(checksum, env, migrateMsg, store, goapi, querier, gasMeter, gasLimit, deserCost)
+	if trackErr := e.track(querier, env.Contract.Address, gasUsed); trackErr != nil {
+		return nil, gasUsed, trackErr
+	}
+	return res, gasUsed, err
+}
+
+func (e executionTrackingEngine) Sudo(
+	checksum wasmvm.Checksum,
+	env wasmvmtypes.Env,
+	sudoMsg []byte,
+	store wasmvm.KVStore,
+	goapi wasmvm.GoAPI,
+	querier wasmvm.Querier,
+	gasMeter wasmvm.GasMeter,
+	gasLimit uint64,
+	deserCost wasmvmtypes.UFraction,
+) (*wasmvmtypes.Response, uint64, error) {
+	res, gasUsed, err := e.WasmEngine.Sudo(checksum, env, sudoMsg, store, goapi, querier, gasMeter, gasLimit, deserCost)
+	if trackErr := e.track(querier, env.Contract.Address, gasUsed); trackErr != nil {
+		return nil, gasUsed, trackErr
+	}
+	return res, gasUsed, err
+}
+
+func (e executionTrackingEngine) Reply(
+	checksum wasmvm.Checksum,
+	env wasmvmtypes.Env,
+	reply wasmvmtypes.Reply,
+	store wasmvm.KVStore,
+	goapi wasmvm.GoAPI,
+	querier wasmvm.Querier,
+	gasMeter wasmvm.GasMeter,
+	gasLimit uint64,
+	deserCost wasmvmtypes.UFraction,
+) (*wasmvmtypes.Response, uint64, error) {
+	res, gasUsed, err := e.WasmEngine.Reply(checksum, env, reply, store, goapi, querier, gasMeter, gasLimit, deserCost)
+	if trackErr := e.track(querier, env.Contract.Address, gasUsed); trackErr != nil {
+		return nil, gasUsed, trackErr
+	}
+	return res, gasUsed, err
+}
+
+// track records the execution of a contract. The engine is not handed the sdk
+// context, so it is taken from the query handler the wasm keeper builds for
+// every call. Any other querier fails the call rather than silently leaving the
+// contract out of the fee share.
+func (e executionTrackingEngine) track(querier wasmvm.Querier, contract string, gasUsed uint64) error {
+	var ctx sdk.Context
+	switch q := querier.(type) {
+	case wasmkeeper.QueryHandler:
+		ctx = q.Ctx
+	case *wasmkeeper.QueryHandler:
+		ctx = q.Ctx
+	default:
+		return errorsmod.Wrapf(types.ErrContractExecutionTracking, "unsupported wasm querier %T", querier)
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return err
+	}
+
+	e.keeper.TrackContractExecution(ctx, contractAddr, gasUsed)
+	return nil
+}
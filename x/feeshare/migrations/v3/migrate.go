@@ -0,0 +1,41 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/feeshare/types"
+)
+
+const (
+	ModuleName = "feeshare"
+)
+
+// ParamsKey Feeshare/types/keys.go -> prefixParams
+var ParamsKey = []byte{0x04}
+
+// Migrate migrates the x/feeshare module state from the consensus version 2 to
+// version 3. Specifically, it sets the max execution depth param, which caps
+// how deep into sub-messages executed contracts earn a fee share, to its
+// default value.
+func Migrate(
+	_ sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	var currParams types.Params
+	bz := store.Get(ParamsKey)
+	if err := cdc.Unmarshal(bz, &currParams); err != nil {
+		return err
+	}
+
+	currParams.MaxExecutionDepth = types.DefaultMaxExecutionDepth
+
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, cdc.MustMarshal(&currParams))
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/CosmosContracts/juno/v26/x/feeshare"
+	v3 "github.com/CosmosContracts/juno/v26/x/feeshare/migrations/v3"
+	"github.com/CosmosContracts/juno/v26/x/feeshare/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feeshare.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(v3.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params as stored before the max execution depth was introduced
+	oldParams := types.NewParams(true, sdk.NewDecWithPrec(50, 2), []string{"ujuno"}, types.DistributionModeEven, 0, false)
+	store.Set(v3.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v3.Migrate(ctx, store, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v3.ParamsKey), &res))
+
+	expected := oldParams
+	expected.MaxExecutionDepth = types.DefaultMaxExecutionDepth
+	require.Equal(t, expected, res)
+}
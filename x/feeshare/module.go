@@ -33,7 +33,7 @@ var (
 )
 
 // ConsensusVersion defines the current x/feeshare module consensus version.
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 // AppModuleBasic type for the fees module
 type AppModuleBasic struct{}
@@ -152,6 +152,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the fees module.
@@ -27,6 +27,8 @@ const (
 	cancelFeeShareName   = "juno/MsgCancelFeeShare"
 	registerFeeShareName = "juno/MsgRegisterFeeShare"
 	updateFeeShareName   = "juno/MsgUpdateFeeShare"
+	claimFeeShareName    = "juno/MsgClaimFeeShare"
+	setContractRatioName = "juno/MsgSetContractFeeShareRatio"
 	updateFeeShareParams = "juno/MsgUpdateParams"
 )
 
@@ -49,6 +51,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRegisterFeeShare{},
 		&MsgCancelFeeShare{},
 		&MsgUpdateFeeShare{},
+		&MsgClaimFeeShare{},
+		&MsgSetContractFeeShareRatio{},
 		&MsgUpdateParams{},
 	)
 
@@ -62,5 +66,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCancelFeeShare{}, cancelFeeShareName, nil)
 	cdc.RegisterConcrete(&MsgRegisterFeeShare{}, registerFeeShareName, nil)
 	cdc.RegisterConcrete(&MsgUpdateFeeShare{}, updateFeeShareName, nil)
+	cdc.RegisterConcrete(&MsgClaimFeeShare{}, claimFeeShareName, nil)
+	cdc.RegisterConcrete(&MsgSetContractFeeShareRatio{}, setContractRatioName, nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateFeeShareParams, nil)
 }
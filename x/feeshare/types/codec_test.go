@@ -23,11 +23,13 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 	RegisterInterfaces(registry)
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
-	suite.Require().Equal(4, len(impls))
+	suite.Require().Equal(6, len(impls))
 	suite.Require().ElementsMatch([]string{
 		"/juno.feeshare.v1.MsgRegisterFeeShare",
 		"/juno.feeshare.v1.MsgCancelFeeShare",
 		"/juno.feeshare.v1.MsgUpdateFeeShare",
+		"/juno.feeshare.v1.MsgClaimFeeShare",
+		"/juno.feeshare.v1.MsgSetContractFeeShareRatio",
 		"/juno.feeshare.v1.MsgUpdateParams",
 	}, impls)
 }
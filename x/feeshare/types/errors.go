@@ -11,4 +11,6 @@ var (
 	ErrFeeShareContractNotRegistered = errorsmod.Register(ModuleName, 4, "no feeshare registered for contract")
 	ErrFeeSharePayment               = errorsmod.Register(ModuleName, 5, "feeshare payment error")
 	ErrFeeShareInvalidWithdrawer     = errorsmod.Register(ModuleName, 6, "invalid withdrawer address")
+	ErrFeeShareNothingAccrued        = errorsmod.Register(ModuleName, 7, "no accrued fee share to claim")
+	ErrContractExecutionTracking     = errorsmod.Register(ModuleName, 8, "failed to track contract execution")
 )
@@ -5,9 +5,15 @@ const (
 	EventTypeCancelFeeShare   = "cancel_feeshare"
 	EventTypeUpdateFeeShare   = "update_feeshare"
 
+	EventTypeSetContractFeeShareRatio = "set_contract_feeshare_ratio"
+
 	EventTypePayoutFeeShare = "payout_feeshare"
+	EventTypeAccrueFeeShare = "accrue_feeshare"
+	EventTypeClaimFeeShare  = "claim_feeshare"
 
 	AttributeKeyContract          = "contract"
 	AttributeKeyWithdrawerAddress = "withdrawer_address"
 	AttributeWithdrawPayouts      = "payouts"
+	AttributeKeyAmount            = "amount"
+	AttributeKeyDeveloperShares   = "developer_shares"
 )
@@ -7,6 +7,10 @@ import (
 	sdkerror "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// MaxWithdrawers is the maximum number of weighted withdrawers a FeeShare can
+// split its transaction fees between.
+const MaxWithdrawers = 10
+
 // NewFeeShare returns an instance of FeeShare.
 func NewFeeShare(contract sdk.Address, deployer, withdrawer sdk.AccAddress) FeeShare {
 	return FeeShare{
@@ -16,6 +20,16 @@ func NewFeeShare(contract sdk.Address, deployer, withdrawer sdk.AccAddress) FeeS
 	}
 }
 
+// NewWeightedFeeShare returns an instance of FeeShare that splits the transaction
+// fees between weighted withdrawers.
+func NewWeightedFeeShare(contract sdk.Address, deployer sdk.AccAddress, withdrawers []WeightedWithdrawer) FeeShare {
+	return FeeShare{
+		ContractAddress: contract.String(),
+		DeployerAddress: deployer.String(),
+		Withdrawers:     withdrawers,
+	}
+}
+
 // GetContractAddr returns the contract address
 func (fs FeeShare) GetContractAddr() sdk.Address {
 	contract, err := sdk.AccAddressFromBech32(fs.ContractAddress)
@@ -54,6 +68,14 @@ func (fs FeeShare) Validate() error {
 		return err
 	}
 
+	if len(fs.Withdrawers) != 0 {
+		if fs.WithdrawerAddress != "" {
+			return errorsmod.Wrap(sdkerror.ErrInvalidAddress, "withdrawer address must be empty when withdrawers are set")
+		}
+
+		return ValidateWithdrawers(fs.Withdrawers)
+	}
+
 	if fs.WithdrawerAddress == "" {
 		return errorsmod.Wrap(sdkerror.ErrInvalidAddress, "withdrawer address cannot be empty")
 	}
@@ -64,3 +86,114 @@ func (fs FeeShare) Validate() error {
 
 	return nil
 }
+
+// WithdrawerShares returns every account receiving the transaction fees of the
+// FeeShare with its weight. A single withdrawer address receives all of the fees.
+func (fs FeeShare) WithdrawerShares() []WeightedWithdrawer {
+	if len(fs.Withdrawers) != 0 {
+		return fs.Withdrawers
+	}
+
+	if fs.WithdrawerAddress == "" {
+		return nil
+	}
+
+	return []WeightedWithdrawer{
+		{WithdrawerAddress: fs.WithdrawerAddress, Weight: sdk.OneDec()},
+	}
+}
+
+// GetWithdrawerAddr returns the account address of the weighted withdrawer
+func (w WeightedWithdrawer) GetWithdrawerAddr() sdk.AccAddress {
+	withdrawer, err := sdk.AccAddressFromBech32(w.WithdrawerAddress)
+	if err != nil {
+		return nil
+	}
+	return withdrawer
+}
+
+// ValidateWithdrawers performs a stateless validation of a list of weighted
+// withdrawers. There may be at most MaxWithdrawers unique withdrawers, each with
+// a positive weight, and the weights must sum to 1.
+func ValidateWithdrawers(withdrawers []WeightedWithdrawer) error {
+	if len(withdrawers) == 0 {
+		return errorsmod.Wrap(ErrFeeShareInvalidWithdrawer, "withdrawers cannot be empty")
+	}
+
+	if len(withdrawers) > MaxWithdrawers {
+		return errorsmod.Wrapf(ErrFeeShareInvalidWithdrawer, "too many withdrawers: %d > %d", len(withdrawers), MaxWithdrawers)
+	}
+
+	seen := make(map[string]bool)
+	total := sdk.ZeroDec()
+	for _, w := range withdrawers {
+		if _, err := sdk.AccAddressFromBech32(w.WithdrawerAddress); err != nil {
+			return errorsmod.Wrapf(err, "invalid withdraw address %s", w.WithdrawerAddress)
+		}
+
+		if seen[w.WithdrawerAddress] {
+			return errorsmod.Wrapf(ErrFeeShareInvalidWithdrawer, "duplicate withdrawer %s", w.WithdrawerAddress)
+		}
+		seen[w.WithdrawerAddress] = true
+
+		if w.Weight.IsNil() || !w.Weight.IsPositive() {
+			return errorsmod.Wrapf(ErrFeeShareInvalidWithdrawer, "weight of withdrawer %s must be positive", w.WithdrawerAddress)
+		}
+
+		total = total.Add(w.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return errorsmod.Wrapf(ErrFeeShareInvalidWithdrawer, "withdrawer weights must sum to 1, got %s", total)
+	}
+
+	return nil
+}
+
+// NewAccruedFeeShare returns an instance of AccruedFeeShare
+func NewAccruedFeeShare(withdrawer sdk.AccAddress, amount sdk.Coins) AccruedFeeShare {
+	return AccruedFeeShare{
+		WithdrawerAddress: withdrawer.String(),
+		Amount:            amount,
+	}
+}
+
+// GetWithdrawerAddr returns the withdrawer address the fees accrued to
+func (a AccruedFeeShare) GetWithdrawerAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(a.WithdrawerAddress)
+}
+
+// Validate performs a stateless validation of an AccruedFeeShare
+func (a AccruedFeeShare) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.WithdrawerAddress); err != nil {
+		return errorsmod.Wrapf(ErrFeeShareInvalidWithdrawer, "%s: %s", a.WithdrawerAddress, err)
+	}
+
+	if !a.Amount.IsValid() || a.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerror.ErrInvalidCoins, "invalid accrued fee share amount for %s: %s", a.WithdrawerAddress, a.Amount)
+	}
+
+	return nil
+}
+
+// NewContractFeeShareRatio returns an instance of ContractFeeShareRatio
+func NewContractFeeShareRatio(contract sdk.Address, developerShares sdk.Dec) ContractFeeShareRatio {
+	return ContractFeeShareRatio{
+		ContractAddress: contract.String(),
+		DeveloperShares: developerShares,
+	}
+}
+
+// GetContractAddr returns the contract address the developer share applies to
+func (r ContractFeeShareRatio) GetContractAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.ContractAddress)
+}
+
+// Validate performs a stateless validation of a ContractFeeShareRatio
+func (r ContractFeeShareRatio) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.ContractAddress); err != nil {
+		return err
+	}
+
+	return validateShares(r.DeveloperShares)
+}
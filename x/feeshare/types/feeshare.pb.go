@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -32,8 +35,12 @@ type FeeShare struct {
 	// same as the contracts admin address.
 	DeployerAddress string `protobuf:"bytes,2,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
 	// withdrawer_address is the bech32 address of account receiving the
-	// transaction fees.
+	// transaction fees. It is empty when the fees are split between withdrawers.
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// withdrawers are the accounts receiving a weighted portion of the
+	// transaction fees. When set, the weights sum to 1 and withdrawer_address
+	// is empty.
+	Withdrawers []WeightedWithdrawer `protobuf:"bytes,4,rep,name=withdrawers,proto3" json:"withdrawers"`
 }
 
 func (m *FeeShare) Reset()         { *m = FeeShare{} }
@@ -90,28 +97,208 @@ func (m *FeeShare) GetWithdrawerAddress() string {
 	return ""
 }
 
+func (m *FeeShare) GetWithdrawers() []WeightedWithdrawer {
+	if m != nil {
+		return m.Withdrawers
+	}
+	return nil
+}
+
+// WeightedWithdrawer defines an account receiving a weighted portion of the
+// transaction fees of a contract
+type WeightedWithdrawer struct {
+	// withdrawer_address is the bech32 address of account receiving the
+	// transaction fees.
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// weight is the proportion of the contract's transaction fees paid to the
+	// withdrawer
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *WeightedWithdrawer) Reset()         { *m = WeightedWithdrawer{} }
+func (m *WeightedWithdrawer) String() string { return proto.CompactTextString(m) }
+func (*WeightedWithdrawer) ProtoMessage()    {}
+func (*WeightedWithdrawer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99f121e0df6cb783, []int{1}
+}
+func (m *WeightedWithdrawer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedWithdrawer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedWithdrawer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedWithdrawer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedWithdrawer.Merge(m, src)
+}
+func (m *WeightedWithdrawer) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedWithdrawer) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedWithdrawer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedWithdrawer proto.InternalMessageInfo
+
+func (m *WeightedWithdrawer) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+// AccruedFeeShare defines the fee share earned by a withdrawer that has not been
+// claimed yet
+type AccruedFeeShare struct {
+	// withdrawer_address is the bech32 address of the account the fees accrued to
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// amount is the total of the unclaimed fees
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *AccruedFeeShare) Reset()         { *m = AccruedFeeShare{} }
+func (m *AccruedFeeShare) String() string { return proto.CompactTextString(m) }
+func (*AccruedFeeShare) ProtoMessage()    {}
+func (*AccruedFeeShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99f121e0df6cb783, []int{2}
+}
+func (m *AccruedFeeShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccruedFeeShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccruedFeeShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccruedFeeShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccruedFeeShare.Merge(m, src)
+}
+func (m *AccruedFeeShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccruedFeeShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccruedFeeShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccruedFeeShare proto.InternalMessageInfo
+
+func (m *AccruedFeeShare) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+func (m *AccruedFeeShare) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// ContractFeeShareRatio defines a developer share set by governance for a
+// contract, used instead of the developer_shares param
+type ContractFeeShareRatio struct {
+	// contract_address is the bech32 address of the contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// developer_shares is the percentage of the transaction fees paid out for
+	// the contract
+	DeveloperShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=developer_shares,json=developerShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"developer_shares"`
+}
+
+func (m *ContractFeeShareRatio) Reset()         { *m = ContractFeeShareRatio{} }
+func (m *ContractFeeShareRatio) String() string { return proto.CompactTextString(m) }
+func (*ContractFeeShareRatio) ProtoMessage()    {}
+func (*ContractFeeShareRatio) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99f121e0df6cb783, []int{3}
+}
+func (m *ContractFeeShareRatio) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractFeeShareRatio) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractFeeShareRatio.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractFeeShareRatio) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractFeeShareRatio.Merge(m, src)
+}
+func (m *ContractFeeShareRatio) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractFeeShareRatio) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractFeeShareRatio.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractFeeShareRatio proto.InternalMessageInfo
+
+func (m *ContractFeeShareRatio) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*FeeShare)(nil), "juno.feeshare.v1.FeeShare")
+	proto.RegisterType((*WeightedWithdrawer)(nil), "juno.feeshare.v1.WeightedWithdrawer")
+	proto.RegisterType((*AccruedFeeShare)(nil), "juno.feeshare.v1.AccruedFeeShare")
+	proto.RegisterType((*ContractFeeShareRatio)(nil), "juno.feeshare.v1.ContractFeeShareRatio")
 }
 
 func init() { proto.RegisterFile("juno/feeshare/v1/feeshare.proto", fileDescriptor_99f121e0df6cb783) }
 
 var fileDescriptor_99f121e0df6cb783 = []byte{
-	// 210 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x2a, 0xcd, 0xcb,
-	0xd7, 0x4f, 0x4b, 0x4d, 0x2d, 0xce, 0x48, 0x2c, 0x4a, 0xd5, 0x2f, 0x33, 0x84, 0xb3, 0xf5, 0x0a,
-	0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0x40, 0x0a, 0xf4, 0xe0, 0x82, 0x65, 0x86, 0x4a, 0xfd, 0x8c,
-	0x5c, 0x1c, 0x6e, 0xa9, 0xa9, 0xc1, 0x20, 0xbe, 0x90, 0x26, 0x97, 0x40, 0x72, 0x7e, 0x5e, 0x49,
-	0x51, 0x62, 0x72, 0x49, 0x7c, 0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0xb1, 0x04, 0xa3, 0x02, 0xa3,
-	0x06, 0x67, 0x10, 0x3f, 0x4c, 0xdc, 0x11, 0x22, 0x0c, 0x52, 0x9a, 0x92, 0x5a, 0x90, 0x93, 0x5f,
-	0x99, 0x5a, 0x04, 0x57, 0xca, 0x04, 0x51, 0x0a, 0x13, 0x87, 0x29, 0xd5, 0xe5, 0x12, 0x2a, 0xcf,
-	0x2c, 0xc9, 0x48, 0x29, 0x4a, 0x2c, 0x47, 0x52, 0xcc, 0x0c, 0x56, 0x2c, 0x88, 0x90, 0x81, 0x2a,
-	0x77, 0xf2, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27,
-	0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x83, 0xf4, 0xcc,
-	0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xe7, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0x67,
-	0xa8, 0xab, 0x8a, 0xf5, 0xc1, 0x3e, 0xaf, 0x40, 0xf8, 0xbd, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0xec, 0x6d, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9f, 0xd0, 0x3a, 0x02, 0x19, 0x01,
-	0x00, 0x00,
+	// 431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xbd, 0xb4, 0x8a, 0x60, 0x7b, 0x48, 0xb1, 0x40, 0x2a, 0x3d, 0xd8, 0x95, 0x85, 0x50,
+	0x38, 0x74, 0xb7, 0x81, 0x27, 0xa8, 0x83, 0x7a, 0x40, 0x9c, 0xcc, 0xa1, 0x82, 0x4b, 0xb5, 0x5e,
+	0x0f, 0xb6, 0xa1, 0xf1, 0x5a, 0xbb, 0xeb, 0x84, 0x3e, 0x03, 0x17, 0x5e, 0x80, 0x23, 0x17, 0x9e,
+	0x24, 0xc7, 0x1c, 0x11, 0x12, 0x01, 0x25, 0x2f, 0x82, 0x76, 0xfd, 0x27, 0x11, 0x28, 0x12, 0xe9,
+	0xc9, 0xd6, 0xec, 0x6f, 0xbf, 0xf9, 0x66, 0x66, 0x07, 0xfb, 0xef, 0xab, 0x42, 0xd0, 0x77, 0x00,
+	0x2a, 0x63, 0x12, 0xe8, 0x64, 0xd8, 0xfd, 0x93, 0x52, 0x0a, 0x2d, 0xdc, 0x43, 0x03, 0x90, 0x2e,
+	0x38, 0x19, 0x1e, 0x3f, 0x48, 0x45, 0x2a, 0xec, 0x21, 0x35, 0x7f, 0x35, 0x77, 0xec, 0x71, 0xa1,
+	0xc6, 0x42, 0xd1, 0x98, 0x29, 0x23, 0x13, 0x83, 0x66, 0x43, 0xca, 0x45, 0x5e, 0xd4, 0xe7, 0xc1,
+	0x4f, 0x84, 0xef, 0x5e, 0x00, 0xbc, 0x36, 0x2a, 0xee, 0x53, 0x7c, 0xc8, 0x45, 0xa1, 0x25, 0xe3,
+	0xfa, 0x8a, 0x25, 0x89, 0x04, 0xa5, 0x8e, 0xd0, 0x09, 0x1a, 0xdc, 0x8b, 0xfa, 0x6d, 0xfc, 0xbc,
+	0x0e, 0x1b, 0x34, 0x81, 0xf2, 0x5a, 0xdc, 0x80, 0xec, 0xd0, 0x3b, 0x35, 0xda, 0xc6, 0x5b, 0xf4,
+	0x14, 0xbb, 0xd3, 0x5c, 0x67, 0x89, 0x64, 0xd3, 0x0d, 0x78, 0xcf, 0xc2, 0xf7, 0xd7, 0x27, 0x2d,
+	0xfe, 0x0a, 0x1f, 0xac, 0x83, 0xea, 0x68, 0xff, 0x64, 0x6f, 0x70, 0xf0, 0xec, 0x31, 0xf9, 0xbb,
+	0x5e, 0x72, 0x09, 0x79, 0x9a, 0x69, 0x48, 0x2e, 0x3b, 0x38, 0xdc, 0x9f, 0x2d, 0x7c, 0x27, 0xda,
+	0xbc, 0x1e, 0x7c, 0x42, 0xd8, 0xfd, 0x97, 0xdc, 0xe2, 0x09, 0x6d, 0xf3, 0x74, 0x81, 0x7b, 0x53,
+	0x2b, 0x52, 0xd7, 0x18, 0x12, 0x93, 0xe8, 0xc7, 0xc2, 0x7f, 0x92, 0xe6, 0x3a, 0xab, 0x62, 0xc2,
+	0xc5, 0x98, 0x36, 0x8d, 0xae, 0x3f, 0xa7, 0x2a, 0xf9, 0x40, 0xf5, 0x4d, 0x09, 0x8a, 0xbc, 0x00,
+	0x1e, 0x35, 0xb7, 0x83, 0xaf, 0x08, 0xf7, 0xcf, 0x39, 0x97, 0x15, 0x24, 0x5d, 0xd3, 0x77, 0xb4,
+	0xc2, 0x71, 0x8f, 0x8d, 0x45, 0x55, 0x18, 0x2b, 0xa6, 0x33, 0x8f, 0x48, 0x9d, 0x91, 0x98, 0x09,
+	0x93, 0x66, 0xc2, 0x64, 0x24, 0xf2, 0x22, 0x3c, 0x33, 0x2e, 0xbf, 0xfd, 0xf2, 0x07, 0xff, 0xe1,
+	0xd2, 0x5c, 0x50, 0x51, 0x23, 0x1d, 0x7c, 0x41, 0xf8, 0xe1, 0xa8, 0x99, 0x78, 0x6b, 0x34, 0x62,
+	0x3a, 0x17, 0xbb, 0x3c, 0x91, 0x37, 0xe6, 0x89, 0x4c, 0xe0, 0x5a, 0x94, 0x20, 0xaf, 0xec, 0xd8,
+	0xd4, 0x2d, 0xdb, 0xd7, 0xef, 0x74, 0xac, 0x13, 0x15, 0xbe, 0x9c, 0x2d, 0x3d, 0x34, 0x5f, 0x7a,
+	0xe8, 0xf7, 0xd2, 0x43, 0x9f, 0x57, 0x9e, 0x33, 0x5f, 0x79, 0xce, 0xf7, 0x95, 0xe7, 0xbc, 0x3d,
+	0xdb, 0x90, 0x1c, 0x59, 0xad, 0xb6, 0x0e, 0x45, 0xed, 0x4e, 0x7d, 0x5c, 0x6f, 0x95, 0x4d, 0x10,
+	0xf7, 0xec, 0x22, 0x3c, 0xff, 0x33, 0x00, 0x4a, 0x55, 0x3b, 0xad, 0x73, 0x03, 0x00, 0x00,
 }
 
 func (m *FeeShare) Marshal() (dAtA []byte, err error) {
@@ -134,6 +321,20 @@ func (m *FeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Withdrawers) > 0 {
+		for iNdEx := len(m.Withdrawers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeshare(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.WithdrawerAddress) > 0 {
 		i -= len(m.WithdrawerAddress)
 		copy(dAtA[i:], m.WithdrawerAddress)
@@ -158,6 +359,130 @@ func (m *FeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightedWithdrawer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedWithdrawer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedWithdrawer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeshare(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintFeeshare(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccruedFeeShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccruedFeeShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccruedFeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeshare(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintFeeshare(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractFeeShareRatio) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractFeeShareRatio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractFeeShareRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DeveloperShares.Size()
+		i -= size
+		if _, err := m.DeveloperShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeshare(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintFeeshare(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeeshare(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeeshare(v)
 	base := offset
@@ -187,6 +512,61 @@ func (m *FeeShare) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovFeeshare(uint64(l))
 	}
+	if len(m.Withdrawers) > 0 {
+		for _, e := range m.Withdrawers {
+			l = e.Size()
+			n += 1 + l + sovFeeshare(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WeightedWithdrawer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovFeeshare(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovFeeshare(uint64(l))
+	return n
+}
+
+func (m *AccruedFeeShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovFeeshare(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovFeeshare(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractFeeShareRatio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovFeeshare(uint64(l))
+	}
+	l = m.DeveloperShares.Size()
+	n += 1 + l + sovFeeshare(uint64(l))
 	return n
 }
 
@@ -321,6 +701,388 @@ func (m *FeeShare) Unmarshal(dAtA []byte) error {
 			}
 			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawers = append(m.Withdrawers, WeightedWithdrawer{})
+			if err := m.Withdrawers[len(m.Withdrawers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeshare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedWithdrawer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeshare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedWithdrawer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedWithdrawer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeshare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccruedFeeShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeshare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccruedFeeShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccruedFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeshare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractFeeShareRatio) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeshare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractFeeShareRatio: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractFeeShareRatio: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeeshare(dAtA[iNdEx:])
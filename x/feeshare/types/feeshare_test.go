@@ -81,6 +81,7 @@ func (suite *FeeShareTestSuite) TestFee() {
 				suite.contract.String(),
 				suite.address1.String(),
 				suite.address2.String(),
+				nil,
 			},
 			true,
 		},
@@ -90,6 +91,7 @@ func (suite *FeeShareTestSuite) TestFee() {
 				"juno15u3dt79t6sxxa3x3kpkhzsy56edaa5a66kxmukqjz2sx0hes5sn38g",
 				suite.address1.String(),
 				suite.address2.String(),
+				nil,
 			},
 			false,
 		},
@@ -99,6 +101,7 @@ func (suite *FeeShareTestSuite) TestFee() {
 				suite.contract.String(),
 				"juno1hj5fveer5cjtn4wd6wstzugjfdxzl0xps73ftl",
 				suite.address2.String(),
+				nil,
 			},
 			false,
 		},
@@ -108,9 +111,68 @@ func (suite *FeeShareTestSuite) TestFee() {
 				suite.contract.String(),
 				suite.address1.String(),
 				"juno1hj5fveer5cjtn4wd6wstzugjfdxzl0xps73ftl",
+				nil,
 			},
 			false,
 		},
+		{
+			"Create feeshare- weighted withdrawers pass",
+			NewWeightedFeeShare(suite.contract, suite.address1, []WeightedWithdrawer{
+				{WithdrawerAddress: suite.address1.String(), Weight: sdk.NewDecWithPrec(25, 2)},
+				{WithdrawerAddress: suite.address2.String(), Weight: sdk.NewDecWithPrec(75, 2)},
+			}),
+			true,
+		},
+		{
+			"Create feeshare- weighted withdrawers and withdraw address",
+			FeeShare{
+				suite.contract.String(),
+				suite.address1.String(),
+				suite.address2.String(),
+				[]WeightedWithdrawer{
+					{WithdrawerAddress: suite.address2.String(), Weight: sdk.OneDec()},
+				},
+			},
+			false,
+		},
+		{
+			"Create feeshare- weights do not sum to 1",
+			NewWeightedFeeShare(suite.contract, suite.address1, []WeightedWithdrawer{
+				{WithdrawerAddress: suite.address1.String(), Weight: sdk.NewDecWithPrec(25, 2)},
+				{WithdrawerAddress: suite.address2.String(), Weight: sdk.NewDecWithPrec(70, 2)},
+			}),
+			false,
+		},
+		{
+			"Create feeshare- non positive weight",
+			NewWeightedFeeShare(suite.contract, suite.address1, []WeightedWithdrawer{
+				{WithdrawerAddress: suite.address1.String(), Weight: sdk.OneDec()},
+				{WithdrawerAddress: suite.address2.String(), Weight: sdk.ZeroDec()},
+			}),
+			false,
+		},
+		{
+			"Create feeshare- duplicate withdrawer",
+			NewWeightedFeeShare(suite.contract, suite.address1, []WeightedWithdrawer{
+				{WithdrawerAddress: suite.address2.String(), Weight: sdk.NewDecWithPrec(50, 2)},
+				{WithdrawerAddress: suite.address2.String(), Weight: sdk.NewDecWithPrec(50, 2)},
+			}),
+			false,
+		},
+		{
+			"Create feeshare- too many withdrawers",
+			NewWeightedFeeShare(suite.contract, suite.address1, func() []WeightedWithdrawer {
+				var withdrawers []WeightedWithdrawer
+				for i := 0; i < MaxWithdrawers+1; i++ {
+					withdrawers = append(withdrawers, WeightedWithdrawer{
+						WithdrawerAddress: sdk.AccAddress([]byte{byte(i)}).String(),
+						Weight:            sdk.OneDec().QuoInt64(MaxWithdrawers + 1),
+					})
+				}
+				return withdrawers
+			}()),
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -130,6 +192,7 @@ func (suite *FeeShareTestSuite) TestFeeShareGetters() {
 		contract.String(),
 		suite.address1.String(),
 		suite.address2.String(),
+		nil,
 	}
 	suite.Equal(fs.GetContractAddr(), contract)
 	suite.Equal(fs.GetDeployerAddr(), suite.address1)
@@ -139,8 +202,27 @@ func (suite *FeeShareTestSuite) TestFeeShareGetters() {
 		contract.String(),
 		suite.address1.String(),
 		"",
+		nil,
 	}
 	suite.Equal(fs.GetContractAddr(), contract)
 	suite.Equal(fs.GetDeployerAddr(), suite.address1)
 	suite.Equal(len(fs.GetWithdrawerAddr()), 0)
 }
+
+func (suite *FeeShareTestSuite) TestFeeShareWithdrawerShares() {
+	fs := NewFeeShare(suite.contract, suite.address1, suite.address2)
+	suite.Require().Equal([]WeightedWithdrawer{
+		{WithdrawerAddress: suite.address2.String(), Weight: sdk.OneDec()},
+	}, fs.WithdrawerShares())
+
+	withdrawers := []WeightedWithdrawer{
+		{WithdrawerAddress: suite.address1.String(), Weight: sdk.NewDecWithPrec(40, 2)},
+		{WithdrawerAddress: suite.address2.String(), Weight: sdk.NewDecWithPrec(60, 2)},
+	}
+	fs = NewWeightedFeeShare(suite.contract, suite.address1, withdrawers)
+	suite.Require().Equal(withdrawers, fs.WithdrawerShares())
+	suite.Require().Equal(suite.address2, fs.WithdrawerShares()[1].GetWithdrawerAddr())
+
+	fs = NewFeeShare(suite.contract, suite.address1, nil)
+	suite.Require().Empty(fs.WithdrawerShares())
+}
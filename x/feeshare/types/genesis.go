@@ -3,10 +3,12 @@ package types
 import "fmt"
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, feeshare []FeeShare) GenesisState {
+func NewGenesisState(params Params, feeshare []FeeShare, accrued []AccruedFeeShare, ratios []ContractFeeShareRatio) GenesisState {
 	return GenesisState{
-		Params:   params,
-		FeeShare: feeshare,
+		Params:                 params,
+		FeeShare:               feeshare,
+		AccruedFeeShares:       accrued,
+		ContractFeeShareRatios: ratios,
 	}
 }
 
@@ -35,5 +37,33 @@ func (gs GenesisState) Validate() error {
 		seenContract[fs.ContractAddress] = true
 	}
 
+	seenWithdrawer := make(map[string]bool)
+	for _, accrued := range gs.AccruedFeeShares {
+		// only one ledger entry per withdrawer
+		if seenWithdrawer[accrued.WithdrawerAddress] {
+			return fmt.Errorf("accrued fee share withdrawer duplicated on genesis '%s'", accrued.WithdrawerAddress)
+		}
+
+		if err := accrued.Validate(); err != nil {
+			return err
+		}
+
+		seenWithdrawer[accrued.WithdrawerAddress] = true
+	}
+
+	seenRatio := make(map[string]bool)
+	for _, ratio := range gs.ContractFeeShareRatios {
+		// only one override per contract
+		if seenRatio[ratio.ContractAddress] {
+			return fmt.Errorf("contract fee share ratio duplicated on genesis '%s'", ratio.ContractAddress)
+		}
+
+		if err := ratio.Validate(); err != nil {
+			return err
+		}
+
+		seenRatio[ratio.ContractAddress] = true
+	}
+
 	return gs.Params.Validate()
 }
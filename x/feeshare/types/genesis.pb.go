@@ -24,12 +24,51 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DistributionMode defines how the developer share of a transaction's fees is
+// split between the registered contracts executed in it.
+type DistributionMode int32
+
+const (
+	// DISTRIBUTION_MODE_EVEN splits the developer share evenly between every
+	// executed contract, paid out in the post handler once the transaction's
+	// messages have run.
+	DistributionModeEven DistributionMode = 0
+	// DISTRIBUTION_MODE_GAS_WEIGHTED splits the developer share in proportion to
+	// the wasm gas used by each executed contract, paid out in the post handler
+	// once the transaction's messages have run.
+	DistributionModeGasWeighted DistributionMode = 1
+)
+
+var DistributionMode_name = map[int32]string{
+	0: "DISTRIBUTION_MODE_EVEN",
+	1: "DISTRIBUTION_MODE_GAS_WEIGHTED",
+}
+
+var DistributionMode_value = map[string]int32{
+	"DISTRIBUTION_MODE_EVEN":         0,
+	"DISTRIBUTION_MODE_GAS_WEIGHTED": 1,
+}
+
+func (x DistributionMode) String() string {
+	return proto.EnumName(DistributionMode_name, int32(x))
+}
+
+func (DistributionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9c69943430ab88f7, []int{0}
+}
+
 // GenesisState defines the module's genesis state.
 type GenesisState struct {
 	// params are the feeshare module parameters
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// FeeShare is a slice of active registered contracts for fee distribution
 	FeeShare []FeeShare `protobuf:"bytes,2,rep,name=fee_share,json=feeShare,proto3" json:"fee_share"`
+	// accrued_fee_shares are the fee shares earned by withdrawers that have not
+	// been claimed yet
+	AccruedFeeShares []AccruedFeeShare `protobuf:"bytes,3,rep,name=accrued_fee_shares,json=accruedFeeShares,proto3" json:"accrued_fee_shares"`
+	// contract_fee_share_ratios are the developer shares set by governance for
+	// individual contracts
+	ContractFeeShareRatios []ContractFeeShareRatio `protobuf:"bytes,4,rep,name=contract_fee_share_ratios,json=contractFeeShareRatios,proto3" json:"contract_fee_share_ratios"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +118,20 @@ func (m *GenesisState) GetFeeShare() []FeeShare {
 	return nil
 }
 
+func (m *GenesisState) GetAccruedFeeShares() []AccruedFeeShare {
+	if m != nil {
+		return m.AccruedFeeShares
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractFeeShareRatios() []ContractFeeShareRatio {
+	if m != nil {
+		return m.ContractFeeShareRatios
+	}
+	return nil
+}
+
 // Params defines the feeshare module params
 type Params struct {
 	// enable_feeshare defines a parameter to enable the feeshare module
@@ -91,6 +144,18 @@ type Params struct {
 	// will ONLY be sent to the community pool.
 	// If this list is empty, all denoms are allowed.
 	AllowedDenoms []string `protobuf:"bytes,3,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// distribution_mode defines how the developer share of a transaction's fees
+	// is split between the registered contracts it executed
+	DistributionMode DistributionMode `protobuf:"varint,4,opt,name=distribution_mode,json=distributionMode,proto3,enum=juno.feeshare.v1.DistributionMode" json:"distribution_mode,omitempty"`
+	// max_execution_depth caps the wasm call depth at which executed contracts
+	// earn a fee share. Contracts run directly by a message, an IBC hook or a
+	// sudo call are at depth 0, contracts reached through their sub-messages at
+	// depth 1, and so on.
+	MaxExecutionDepth uint32 `protobuf:"varint,5,opt,name=max_execution_depth,json=maxExecutionDepth,proto3" json:"max_execution_depth,omitempty"`
+	// enable_accrual defines a parameter to accrue the fee shares of withdrawers
+	// in the module account, to be collected with MsgClaimFeeShare, instead of
+	// sending them on every transaction
+	EnableAccrual bool `protobuf:"varint,6,opt,name=enable_accrual,json=enableAccrual,proto3" json:"enable_accrual,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -140,7 +205,29 @@ func (m *Params) GetAllowedDenoms() []string {
 	return nil
 }
 
+func (m *Params) GetDistributionMode() DistributionMode {
+	if m != nil {
+		return m.DistributionMode
+	}
+	return DistributionModeEven
+}
+
+func (m *Params) GetMaxExecutionDepth() uint32 {
+	if m != nil {
+		return m.MaxExecutionDepth
+	}
+	return 0
+}
+
+func (m *Params) GetEnableAccrual() bool {
+	if m != nil {
+		return m.EnableAccrual
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("juno.feeshare.v1.DistributionMode", DistributionMode_name, DistributionMode_value)
 	proto.RegisterType((*GenesisState)(nil), "juno.feeshare.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "juno.feeshare.v1.Params")
 }
@@ -148,29 +235,43 @@ func init() {
 func init() { proto.RegisterFile("juno/feeshare/v1/genesis.proto", fileDescriptor_9c69943430ab88f7) }
 
 var fileDescriptor_9c69943430ab88f7 = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x4e, 0xea, 0x40,
-	0x14, 0x86, 0x3b, 0x70, 0x43, 0x60, 0xb8, 0x97, 0x4b, 0x1a, 0x17, 0x0d, 0x8b, 0xd2, 0x90, 0x68,
-	0xba, 0x71, 0x46, 0x30, 0x71, 0xe7, 0x06, 0x88, 0x26, 0xae, 0x4c, 0x59, 0xe9, 0x86, 0x0c, 0xed,
-	0xa1, 0xa0, 0x6d, 0xa7, 0xe9, 0x0c, 0xa8, 0x0f, 0xe0, 0xde, 0x87, 0xf1, 0x21, 0x58, 0xb2, 0x34,
-	0x2e, 0x88, 0x81, 0x17, 0x31, 0x9d, 0x16, 0x30, 0xb2, 0x9a, 0x33, 0xff, 0x7f, 0xfe, 0xef, 0x9c,
-	0xe4, 0x60, 0xf3, 0x61, 0x16, 0x71, 0x3a, 0x06, 0x10, 0x13, 0x96, 0x00, 0x9d, 0xb7, 0xa9, 0x0f,
-	0x11, 0x88, 0xa9, 0x20, 0x71, 0xc2, 0x25, 0xd7, 0xeb, 0xa9, 0x4f, 0xb6, 0x3e, 0x99, 0xb7, 0x1b,
-	0xcd, 0x83, 0xc4, 0xce, 0x55, 0x91, 0xc6, 0x91, 0xcf, 0x7d, 0xae, 0x4a, 0x9a, 0x56, 0x99, 0xda,
-	0x7a, 0x45, 0xf8, 0xef, 0x75, 0x86, 0x1e, 0x48, 0x26, 0x41, 0xbf, 0xc0, 0xa5, 0x98, 0x25, 0x2c,
-	0x14, 0x06, 0xb2, 0x90, 0x5d, 0xed, 0x18, 0xe4, 0xf7, 0x28, 0x72, 0xab, 0xfc, 0xee, 0x9f, 0xc5,
-	0xaa, 0xa9, 0x39, 0x79, 0xb7, 0x7e, 0x89, 0x2b, 0x63, 0x80, 0xa1, 0x6a, 0x32, 0x0a, 0x56, 0xd1,
-	0xae, 0x76, 0x1a, 0x87, 0xd1, 0x2b, 0x80, 0x41, 0x5a, 0xe7, 0xe1, 0xf2, 0x38, 0xff, 0xb7, 0xde,
-	0x11, 0x2e, 0x65, 0x5c, 0xdd, 0xc6, 0x75, 0x88, 0xd8, 0x28, 0x80, 0xe1, 0x1e, 0x98, 0xee, 0x52,
-	0x76, 0x6a, 0x99, 0xbe, 0x85, 0xe8, 0x77, 0xb8, 0xee, 0xc1, 0x1c, 0x02, 0x1e, 0x43, 0x92, 0x35,
-	0x0a, 0xa3, 0x60, 0x21, 0xbb, 0xd2, 0x25, 0x29, 0xfe, 0x73, 0xd5, 0x3c, 0xf1, 0xa7, 0x72, 0x32,
-	0x1b, 0x11, 0x97, 0x87, 0xd4, 0xe5, 0x22, 0xe4, 0x22, 0x7f, 0x4e, 0x85, 0xf7, 0x48, 0xe5, 0x4b,
-	0x0c, 0x82, 0xf4, 0xc1, 0x75, 0xfe, 0xef, 0x38, 0x8a, 0x2c, 0xf4, 0x63, 0x5c, 0x63, 0x41, 0xc0,
-	0x9f, 0xc0, 0x1b, 0x7a, 0x10, 0xf1, 0x50, 0x18, 0x45, 0xab, 0x68, 0x57, 0x9c, 0x7f, 0xb9, 0xda,
-	0x57, 0x62, 0xf7, 0x66, 0xb1, 0x36, 0xd1, 0x72, 0x6d, 0xa2, 0xaf, 0xb5, 0x89, 0xde, 0x36, 0xa6,
-	0xb6, 0xdc, 0x98, 0xda, 0xc7, 0xc6, 0xd4, 0xee, 0xcf, 0x7e, 0x4c, 0xee, 0xa9, 0x91, 0x3d, 0x1e,
-	0xc9, 0x84, 0xb9, 0x52, 0x50, 0x75, 0xaa, 0xe7, 0xfd, 0xb1, 0xd4, 0x1e, 0xa3, 0x92, 0xba, 0xc8,
-	0xf9, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x4b, 0xe6, 0xac, 0xfc, 0x01, 0x00, 0x00,
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0xd3, 0x10, 0xb5, 0x5b, 0x5a, 0xdc, 0xa5, 0xaa, 0x4c, 0x90, 0x1c, 0x53, 0x09, 0xb0,
+	0x90, 0xb0, 0x69, 0x41, 0xdc, 0x38, 0x34, 0xb5, 0x09, 0x41, 0x6a, 0x8b, 0x9c, 0x96, 0x0a, 0x2e,
+	0xd6, 0xc6, 0x9e, 0x26, 0x86, 0xd8, 0x1b, 0x79, 0x37, 0x21, 0xfc, 0x01, 0xea, 0x09, 0x89, 0x73,
+	0x4f, 0xfc, 0x0b, 0xea, 0xb1, 0x47, 0x84, 0x50, 0x85, 0xda, 0x1f, 0x41, 0x5e, 0xdb, 0x4d, 0xb1,
+	0x39, 0x79, 0xfd, 0xde, 0xbc, 0x37, 0xde, 0x37, 0x1e, 0xa4, 0x7e, 0x18, 0x47, 0xd4, 0x3c, 0x02,
+	0x60, 0x03, 0x12, 0x83, 0x39, 0xd9, 0x30, 0xfb, 0x10, 0x01, 0x0b, 0x98, 0x31, 0x8a, 0x29, 0xa7,
+	0x58, 0x4e, 0x78, 0x23, 0xe7, 0x8d, 0xc9, 0x46, 0xa3, 0x59, 0x52, 0x5c, 0xb1, 0x42, 0xd2, 0x58,
+	0xed, 0xd3, 0x3e, 0x15, 0x47, 0x33, 0x39, 0xa5, 0xe8, 0xfa, 0x8f, 0x2a, 0xba, 0xd9, 0x4e, 0xad,
+	0xbb, 0x9c, 0x70, 0xc0, 0xcf, 0x51, 0x7d, 0x44, 0x62, 0x12, 0x32, 0x45, 0xd2, 0x24, 0x7d, 0x71,
+	0x53, 0x31, 0x8a, 0xad, 0x8c, 0x37, 0x82, 0x6f, 0xd5, 0x4e, 0xcf, 0x9b, 0x15, 0x27, 0xab, 0xc6,
+	0x2f, 0xd0, 0xc2, 0x11, 0x80, 0x2b, 0x8a, 0x94, 0xaa, 0x36, 0xa7, 0x2f, 0x6e, 0x36, 0xca, 0xd2,
+	0x97, 0x00, 0xdd, 0xe4, 0x9c, 0x89, 0xe7, 0x8f, 0xb2, 0x77, 0x7c, 0x80, 0x30, 0xf1, 0xbc, 0x78,
+	0x0c, 0xbe, 0x7b, 0x65, 0xc3, 0x94, 0x39, 0xe1, 0x73, 0xaf, 0xec, 0xb3, 0x95, 0xd6, 0x16, 0xec,
+	0x64, 0xf2, 0x2f, 0xcc, 0xf0, 0x00, 0xdd, 0xf1, 0x68, 0xc4, 0x63, 0xe2, 0xf1, 0x99, 0xaf, 0x1b,
+	0x13, 0x1e, 0x50, 0xa6, 0xd4, 0x84, 0xfb, 0xc3, 0xb2, 0xfb, 0x76, 0x26, 0xc9, 0x7d, 0x9c, 0xa4,
+	0x3e, 0xeb, 0xb1, 0xe6, 0xfd, 0x8f, 0x64, 0xeb, 0xbf, 0xab, 0xa8, 0x9e, 0x06, 0x83, 0x75, 0x24,
+	0x43, 0x44, 0x7a, 0x43, 0x98, 0xb5, 0x14, 0x61, 0xce, 0x3b, 0xcb, 0x29, 0x9e, 0x4b, 0xf1, 0x3b,
+	0x24, 0xfb, 0x30, 0x81, 0x21, 0x1d, 0x41, 0x9c, 0xdf, 0xb9, 0xaa, 0x49, 0xfa, 0x42, 0xcb, 0x48,
+	0x9a, 0xfd, 0x3a, 0x6f, 0x3e, 0xe8, 0x07, 0x7c, 0x30, 0xee, 0x19, 0x1e, 0x0d, 0x4d, 0x8f, 0xb2,
+	0x90, 0xb2, 0xec, 0xf1, 0x98, 0xf9, 0x1f, 0x4d, 0xfe, 0x79, 0x04, 0xcc, 0xb0, 0xc0, 0x73, 0x6e,
+	0x5d, 0xf9, 0x64, 0x37, 0xbf, 0x8f, 0x96, 0xc9, 0x70, 0x48, 0x3f, 0x81, 0xef, 0xfa, 0x10, 0xd1,
+	0x30, 0x0d, 0x73, 0xc1, 0x59, 0xca, 0x50, 0x4b, 0x80, 0x78, 0x0f, 0xad, 0xf8, 0x01, 0xe3, 0x71,
+	0xd0, 0x1b, 0xf3, 0x80, 0x46, 0x6e, 0x48, 0x7d, 0x50, 0x6a, 0x9a, 0xa4, 0x2f, 0x6f, 0xae, 0x97,
+	0x83, 0xb1, 0xae, 0x95, 0xee, 0x50, 0x1f, 0x1c, 0xd9, 0x2f, 0x20, 0xd8, 0x40, 0xb7, 0x43, 0x32,
+	0x75, 0x61, 0x0a, 0x5e, 0xea, 0xe8, 0xc3, 0x88, 0x0f, 0x94, 0x1b, 0x9a, 0xa4, 0x2f, 0x39, 0x2b,
+	0x21, 0x99, 0xda, 0x39, 0x63, 0x25, 0x44, 0xf2, 0x9d, 0x59, 0x58, 0x62, 0x78, 0x64, 0xa8, 0xd4,
+	0x45, 0x54, 0x4b, 0x29, 0xba, 0x95, 0x82, 0x8f, 0xbe, 0x49, 0x48, 0x2e, 0x76, 0xc7, 0xcf, 0xd0,
+	0x9a, 0xd5, 0xe9, 0xee, 0x3b, 0x9d, 0xd6, 0xc1, 0x7e, 0x67, 0x6f, 0xd7, 0xdd, 0xd9, 0xb3, 0x6c,
+	0xd7, 0x7e, 0x6b, 0xef, 0xca, 0x95, 0x86, 0x72, 0x7c, 0xa2, 0xad, 0x16, 0x15, 0xf6, 0x04, 0x22,
+	0xbc, 0x8d, 0xd4, 0xb2, 0xaa, 0xbd, 0xd5, 0x75, 0x0f, 0xed, 0x4e, 0xfb, 0xd5, 0xbe, 0x6d, 0xc9,
+	0x52, 0xa3, 0x79, 0x7c, 0xa2, 0xdd, 0x2d, 0xaa, 0xdb, 0x84, 0x1d, 0x42, 0xd0, 0x1f, 0x70, 0xf0,
+	0x1b, 0xb5, 0x2f, 0xdf, 0xd5, 0x4a, 0xeb, 0xf5, 0xe9, 0x85, 0x2a, 0x9d, 0x5d, 0xa8, 0xd2, 0x9f,
+	0x0b, 0x55, 0xfa, 0x7a, 0xa9, 0x56, 0xce, 0x2e, 0xd5, 0xca, 0xcf, 0x4b, 0xb5, 0xf2, 0xfe, 0xc9,
+	0xb5, 0xb9, 0x6d, 0x8b, 0x81, 0xe5, 0x3f, 0x15, 0x33, 0xc5, 0xa6, 0x4e, 0x67, 0xbb, 0x2a, 0xa6,
+	0xd8, 0xab, 0x8b, 0x85, 0x7c, 0xfa, 0x77, 0x00, 0x88, 0x3b, 0xd3, 0xee, 0xfb, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -193,6 +294,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractFeeShareRatios) > 0 {
+		for iNdEx := len(m.ContractFeeShareRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractFeeShareRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AccruedFeeShares) > 0 {
+		for iNdEx := len(m.AccruedFeeShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccruedFeeShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.FeeShare) > 0 {
 		for iNdEx := len(m.FeeShare) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -240,6 +369,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EnableAccrual {
+		i--
+		if m.EnableAccrual {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxExecutionDepth != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxExecutionDepth))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DistributionMode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DistributionMode))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.AllowedDenoms) > 0 {
 		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedDenoms[iNdEx])
@@ -297,6 +446,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AccruedFeeShares) > 0 {
+		for _, e := range m.AccruedFeeShares {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractFeeShareRatios) > 0 {
+		for _, e := range m.ContractFeeShareRatios {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -317,6 +478,15 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.DistributionMode != 0 {
+		n += 1 + sovGenesis(uint64(m.DistributionMode))
+	}
+	if m.MaxExecutionDepth != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxExecutionDepth))
+	}
+	if m.EnableAccrual {
+		n += 2
+	}
 	return n
 }
 
@@ -422,6 +592,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedFeeShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccruedFeeShares = append(m.AccruedFeeShares, AccruedFeeShare{})
+			if err := m.AccruedFeeShares[len(m.AccruedFeeShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractFeeShareRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractFeeShareRatios = append(m.ContractFeeShareRatios, ContractFeeShareRatio{})
+			if err := m.ContractFeeShareRatios[len(m.ContractFeeShareRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -558,6 +796,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMode", wireType)
+			}
+			m.DistributionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionMode |= DistributionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionDepth", wireType)
+			}
+			m.MaxExecutionDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableAccrual", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableAccrual = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -29,7 +29,7 @@ func (suite *GenesisTestSuite) SetupTest() {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
-	newGen := NewGenesisState(DefaultParams(), []FeeShare{})
+	newGen := NewGenesisState(DefaultParams(), []FeeShare{}, []AccruedFeeShare{}, []ContractFeeShareRatio{})
 	testCases := []struct {
 		name     string
 		genState *GenesisState
@@ -125,6 +125,97 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with accrued fee shares",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				AccruedFeeShares: []AccruedFeeShare{
+					{
+						WithdrawerAddress: suite.address1,
+						Amount:            sdk.NewCoins(sdk.NewInt64Coin("ujuno", 100)),
+					},
+					{
+						WithdrawerAddress: suite.address2,
+						Amount:            sdk.NewCoins(sdk.NewInt64Coin("ujuno", 1), sdk.NewInt64Coin("uatom", 2)),
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated accrued fee share withdrawer",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				AccruedFeeShares: []AccruedFeeShare{
+					{
+						WithdrawerAddress: suite.address1,
+						Amount:            sdk.NewCoins(sdk.NewInt64Coin("ujuno", 100)),
+					},
+					{
+						WithdrawerAddress: suite.address1,
+						Amount:            sdk.NewCoins(sdk.NewInt64Coin("ujuno", 1)),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid accrued fee share withdrawer",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				AccruedFeeShares: []AccruedFeeShare{
+					{
+						WithdrawerAddress: "withdraw",
+						Amount:            sdk.NewCoins(sdk.NewInt64Coin("ujuno", 100)),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - empty accrued fee share",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				AccruedFeeShares: []AccruedFeeShare{
+					{
+						WithdrawerAddress: suite.address1,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with contract fee share ratios",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ContractFeeShareRatios: []ContractFeeShareRatio{
+					{ContractAddress: suite.contractA, DeveloperShares: sdk.NewDecWithPrec(90, 2)},
+					{ContractAddress: suite.contractB, DeveloperShares: sdk.ZeroDec()},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated contract fee share ratio",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ContractFeeShareRatios: []ContractFeeShareRatio{
+					{ContractAddress: suite.contractA, DeveloperShares: sdk.NewDecWithPrec(90, 2)},
+					{ContractAddress: suite.contractA, DeveloperShares: sdk.NewDecWithPrec(10, 2)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - contract fee share ratio above 1",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ContractFeeShareRatios: []ContractFeeShareRatio{
+					{ContractAddress: suite.contractA, DeveloperShares: sdk.NewDecWithPrec(101, 2)},
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
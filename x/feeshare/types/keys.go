@@ -9,6 +9,10 @@ const (
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
 
+	// TStoreKey to be used when creating the transient store tracking
+	// contract gas usage within a transaction
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey to be used for message routing
 	RouterKey = ModuleName
 )
@@ -19,16 +23,28 @@ const (
 	prefixDeployer
 	prefixWithdrawer
 	prefixParams
+	prefixAccrued
+	prefixContractRatio
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixFeeShare   = []byte{prefixFeeShare}
-	KeyPrefixDeployer   = []byte{prefixDeployer}
-	KeyPrefixWithdrawer = []byte{prefixWithdrawer}
-	ParamsKey           = []byte{prefixParams}
+	KeyPrefixFeeShare      = []byte{prefixFeeShare}
+	KeyPrefixDeployer      = []byte{prefixDeployer}
+	KeyPrefixWithdrawer    = []byte{prefixWithdrawer}
+	ParamsKey              = []byte{prefixParams}
+	KeyPrefixAccrued       = []byte{prefixAccrued}
+	KeyPrefixContractRatio = []byte{prefixContractRatio}
 )
 
+// prefix bytes for the fees transient store
+const (
+	prefixContractGas = iota + 1
+)
+
+// TransientStore key prefixes
+var KeyPrefixContractGas = []byte{prefixContractGas}
+
 // GetKeyPrefixDeployer returns the KVStore key prefix for storing
 // registered feeshare contract for a deployer
 func GetKeyPrefixDeployer(deployerAddress sdk.AccAddress) []byte {
@@ -10,12 +10,14 @@ var (
 	_ sdk.Msg = &MsgRegisterFeeShare{}
 	_ sdk.Msg = &MsgCancelFeeShare{}
 	_ sdk.Msg = &MsgUpdateFeeShare{}
+	_ sdk.Msg = &MsgClaimFeeShare{}
 )
 
 const (
 	TypeMsgRegisterFeeShare = "register_feeshare"
 	TypeMsgCancelFeeShare   = "cancel_feeshare"
 	TypeMsgUpdateFeeShare   = "update_feeshare"
+	TypeMsgClaimFeeShare    = "claim_feeshare"
 )
 
 // NewMsgRegisterFeeShare creates new instance of MsgRegisterFeeShare
@@ -52,6 +54,14 @@ func (msg MsgRegisterFeeShare) ValidateBasic() error {
 		return errorsmod.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
 	}
 
+	if len(msg.Withdrawers) != 0 {
+		if msg.WithdrawerAddress != "" {
+			return errorsmod.Wrap(ErrFeeShareInvalidWithdrawer, "withdrawer address must be empty when withdrawers are set")
+		}
+
+		return ValidateWithdrawers(msg.Withdrawers)
+	}
+
 	if msg.WithdrawerAddress != "" {
 		if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
 			return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
@@ -142,6 +152,14 @@ func (msg MsgUpdateFeeShare) ValidateBasic() error {
 		return errorsmod.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
 	}
 
+	if len(msg.Withdrawers) != 0 {
+		if msg.WithdrawerAddress != "" {
+			return errorsmod.Wrap(ErrFeeShareInvalidWithdrawer, "withdrawer address must be empty when withdrawers are set")
+		}
+
+		return ValidateWithdrawers(msg.Withdrawers)
+	}
+
 	if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
 		return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
 	}
@@ -160,6 +178,70 @@ func (msg MsgUpdateFeeShare) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+// NewMsgClaimFeeShare creates new instance of MsgClaimFeeShare
+func NewMsgClaimFeeShare(withdrawer sdk.AccAddress) *MsgClaimFeeShare {
+	return &MsgClaimFeeShare{
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgClaimFeeShare) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgClaimFeeShare) Type() string { return TypeMsgClaimFeeShare }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgClaimFeeShare) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgClaimFeeShare) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgClaimFeeShare) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
+	return []sdk.AccAddress{from}
+}
+
+var _ sdk.Msg = &MsgSetContractFeeShareRatio{}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetContractFeeShareRatio) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetContractFeeShareRatio message.
+func (m *MsgSetContractFeeShareRatio) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data. An unset developer
+// share removes the override of the contract.
+func (m *MsgSetContractFeeShareRatio) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", m.ContractAddress)
+	}
+
+	if m.DeveloperShares == nil {
+		return nil
+	}
+
+	return validateShares(*m.DeveloperShares)
+}
+
 var _ sdk.Msg = &MsgUpdateParams{}
 
 // GetSignBytes implements the LegacyMsg interface.
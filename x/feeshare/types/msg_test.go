@@ -221,3 +221,115 @@ func (suite *MsgsTestSuite) TestMsgUpdateFeeShareNew() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgClaimFeeShareGetters() {
+	msgInvalid := MsgClaimFeeShare{}
+	msg := NewMsgClaimFeeShare(
+		sdk.AccAddress(suite.deployer.Bytes()),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgClaimFeeShare, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgClaimFeeShareNew() {
+	testCases := []struct {
+		msg        string
+		withdraw   string
+		expectPass bool
+	}{
+		{
+			"msg claim fee share - pass",
+			suite.withdrawerStr,
+			true,
+		},
+		{
+			"invalid withdraw address",
+			"withdraw",
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgClaimFeeShare{
+			WithdrawerAddress: tc.withdraw,
+		}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+			suite.Require().Contains(err.Error(), tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgSetContractFeeShareRatioNew() {
+	authority := sdk.AccAddress([]byte("authority")).String()
+
+	testCases := []struct {
+		msg        string
+		authority  string
+		contract   string
+		shares     *sdk.Dec
+		expectPass bool
+	}{
+		{
+			"msg set contract fee share ratio - pass",
+			authority,
+			suite.contract.String(),
+			decPtr(sdk.NewDecWithPrec(75, 2)),
+			true,
+		},
+		{
+			"msg remove contract fee share ratio - pass",
+			authority,
+			suite.contract.String(),
+			nil,
+			true,
+		},
+		{
+			"invalid authority address",
+			"authority",
+			suite.contract.String(),
+			decPtr(sdk.NewDecWithPrec(75, 2)),
+			false,
+		},
+		{
+			"invalid contract address",
+			authority,
+			"contract",
+			decPtr(sdk.NewDecWithPrec(75, 2)),
+			false,
+		},
+		{
+			"value cannot be greater than 1",
+			authority,
+			suite.contract.String(),
+			decPtr(sdk.NewDecWithPrec(101, 2)),
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgSetContractFeeShareRatio{
+			Authority:       tc.authority,
+			ContractAddress: tc.contract,
+			DeveloperShares: tc.shares,
+		}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+			suite.Require().Contains(err.Error(), tc.msg)
+		}
+	}
+}
+
+func decPtr(d sdk.Dec) *sdk.Dec {
+	return &d
+}
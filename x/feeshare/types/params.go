@@ -11,19 +11,28 @@ func NewParams(
 	enableFeeShare bool,
 	developerShares sdk.Dec,
 	allowedDenoms []string,
+	distributionMode DistributionMode,
+	maxExecutionDepth uint32,
+	enableAccrual bool,
 ) Params {
 	return Params{
-		EnableFeeShare:  enableFeeShare,
-		DeveloperShares: developerShares,
-		AllowedDenoms:   allowedDenoms,
+		EnableFeeShare:    enableFeeShare,
+		DeveloperShares:   developerShares,
+		AllowedDenoms:     allowedDenoms,
+		DistributionMode:  distributionMode,
+		MaxExecutionDepth: maxExecutionDepth,
+		EnableAccrual:     enableAccrual,
 	}
 }
 
 func DefaultParams() Params {
 	return Params{
-		EnableFeeShare:  DefaultEnableFeeShare,
-		DeveloperShares: DefaultDeveloperShares,
-		AllowedDenoms:   DefaultAllowedDenoms,
+		EnableFeeShare:    DefaultEnableFeeShare,
+		DeveloperShares:   DefaultDeveloperShares,
+		AllowedDenoms:     DefaultAllowedDenoms,
+		DistributionMode:  DefaultDistributionMode,
+		MaxExecutionDepth: DefaultMaxExecutionDepth,
+		EnableAccrual:     DefaultEnableAccrual,
 	}
 }
 
@@ -73,6 +82,32 @@ func validateArray(i interface{}) error {
 	return nil
 }
 
+func validateDistributionMode(i interface{}) error {
+	v, ok := i.(DistributionMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := DistributionMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid distribution mode: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxExecutionDepth(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxExecutionDepthLimit {
+		return fmt.Errorf("max execution depth cannot be greater than %d: %d", MaxExecutionDepthLimit, v)
+	}
+
+	return nil
+}
+
 func (p Params) Validate() error {
 	if err := validateBool(p.EnableFeeShare); err != nil {
 		return err
@@ -80,6 +115,14 @@ func (p Params) Validate() error {
 	if err := validateShares(p.DeveloperShares); err != nil {
 		return err
 	}
-	err := validateArray(p.AllowedDenoms)
-	return err
+	if err := validateArray(p.AllowedDenoms); err != nil {
+		return err
+	}
+	if err := validateDistributionMode(p.DistributionMode); err != nil {
+		return err
+	}
+	if err := validateMaxExecutionDepth(p.MaxExecutionDepth); err != nil {
+		return err
+	}
+	return validateBool(p.EnableAccrual)
 }
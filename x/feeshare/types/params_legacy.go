@@ -3,6 +3,8 @@ package types
 // TODO: Remove this and params_legacy_test.go after v0.47.x (v16) upgrade
 
 import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -13,6 +15,16 @@ var (
 	DefaultDeveloperShares = sdk.NewDecWithPrec(50, 2) // 50%
 	DefaultAllowedDenoms   = []string(nil)             // all allowed
 
+	DefaultDistributionMode = DistributionModeEven
+
+	// DefaultMaxExecutionDepth pays contracts up to 5 sub-message levels deep
+	DefaultMaxExecutionDepth uint32 = 5
+	// DefaultEnableAccrual sends fee shares on every transaction
+	DefaultEnableAccrual = false
+
+	// MaxExecutionDepthLimit matches the default max call depth of x/wasm
+	MaxExecutionDepthLimit = wasmtypes.DefaultMaxCallDepth
+
 	ParamStoreKeyEnableFeeShare  = []byte("EnableFeeShare")
 	ParamStoreKeyDeveloperShares = []byte("DeveloperShares")
 	ParamStoreKeyAllowedDenoms   = []byte("AllowedDenoms")
@@ -31,17 +31,17 @@ func TestLegacyParamsValidate(t *testing.T) {
 		{"default", DefaultParams(), false},
 		{
 			"valid: enabled",
-			NewParams(true, devShares, acceptedDenoms),
+			NewParams(true, devShares, acceptedDenoms, DistributionModeEven, DefaultMaxExecutionDepth, false),
 			false,
 		},
 		{
 			"valid: disabled",
-			NewParams(false, devShares, acceptedDenoms),
+			NewParams(false, devShares, acceptedDenoms, DistributionModeEven, DefaultMaxExecutionDepth, false),
 			false,
 		},
 		{
 			"valid: 100% devs",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(1)), acceptedDenoms},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(1)), acceptedDenoms, DistributionModeEven, DefaultMaxExecutionDepth, false},
 			false,
 		},
 		{
@@ -51,17 +51,17 @@ func TestLegacyParamsValidate(t *testing.T) {
 		},
 		{
 			"invalid: share > 1",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(2)), acceptedDenoms},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(2)), acceptedDenoms, DistributionModeEven, DefaultMaxExecutionDepth, false},
 			true,
 		},
 		{
 			"invalid: share < 0",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), acceptedDenoms},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), acceptedDenoms, DistributionModeEven, DefaultMaxExecutionDepth, false},
 			true,
 		},
 		{
 			"valid: all denoms allowed",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), []string{}},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), []string{}, DistributionModeEven, DefaultMaxExecutionDepth, false},
 			true,
 		},
 	}
@@ -30,17 +30,17 @@ func TestParamsValidate(t *testing.T) {
 		{"default", DefaultParams(), false},
 		{
 			"valid: enabled",
-			NewParams(true, devShares, acceptedDenoms),
+			NewParams(true, devShares, acceptedDenoms, DistributionModeEven, DefaultMaxExecutionDepth, false),
 			false,
 		},
 		{
 			"valid: disabled",
-			NewParams(false, devShares, acceptedDenoms),
+			NewParams(false, devShares, acceptedDenoms, DistributionModeEven, DefaultMaxExecutionDepth, false),
 			false,
 		},
 		{
 			"valid: 100% devs",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(1)), acceptedDenoms},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(1)), acceptedDenoms, DistributionModeEven, DefaultMaxExecutionDepth, false},
 			false,
 		},
 		{
@@ -50,17 +50,42 @@ func TestParamsValidate(t *testing.T) {
 		},
 		{
 			"invalid: share > 1",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(2)), acceptedDenoms},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(2)), acceptedDenoms, DistributionModeEven, DefaultMaxExecutionDepth, false},
 			true,
 		},
 		{
 			"invalid: share < 0",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), acceptedDenoms},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), acceptedDenoms, DistributionModeEven, DefaultMaxExecutionDepth, false},
 			true,
 		},
+		{
+			"valid: gas weighted distribution",
+			NewParams(true, devShares, acceptedDenoms, DistributionModeGasWeighted, DefaultMaxExecutionDepth, false),
+			false,
+		},
+		{
+			"invalid: unknown distribution mode",
+			NewParams(true, devShares, acceptedDenoms, DistributionMode(2), DefaultMaxExecutionDepth, false),
+			true,
+		},
+		{
+			"valid: top level contracts only",
+			NewParams(true, devShares, acceptedDenoms, DistributionModeEven, 0, false),
+			false,
+		},
+		{
+			"invalid: execution depth above wasm call depth",
+			NewParams(true, devShares, acceptedDenoms, DistributionModeEven, MaxExecutionDepthLimit+1, false),
+			true,
+		},
+		{
+			"valid: accrual enabled",
+			NewParams(true, devShares, acceptedDenoms, DistributionModeEven, DefaultMaxExecutionDepth, true),
+			false,
+		},
 		{
 			"valid: all denoms allowed",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), []string{}},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), []string{}, DistributionModeEven, DefaultMaxExecutionDepth, false},
 			true,
 		},
 	}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeePayLogic takes the total fees and splits them based on the governance params
+// and the number of contracts we are executing on.
+// This returns the amount of fees each contract developer should get.
+// tested in x/feeshare/ante/ante_test.go
+func FeePayLogic(fees sdk.Coins, govPercent sdk.Dec, numPairs int) sdk.Coins {
+	var splitFees sdk.Coins
+	for _, c := range fees.Sort() {
+		rewardAmount := govPercent.MulInt(c.Amount).QuoInt64(int64(numPairs)).RoundInt()
+		if !rewardAmount.IsZero() {
+			splitFees = splitFees.Add(sdk.NewCoin(c.Denom, rewardAmount))
+		}
+	}
+	return splitFees
+}
+
+// GasWeightedFees takes the total fees and returns the developer share owed to a
+// contract that used gasUsed out of the totalGas used by all paid contracts.
+// Amounts are truncated so the sum over all contracts never exceeds the share.
+// tested in x/feeshare/ante/ante_test.go
+func GasWeightedFees(fees sdk.Coins, govPercent sdk.Dec, gasUsed, totalGas uint64) sdk.Coins {
+	var splitFees sdk.Coins
+	if totalGas == 0 {
+		return splitFees
+	}
+
+	for _, c := range fees.Sort() {
+		rewardAmount := govPercent.MulInt(c.Amount).
+			MulInt(sdk.NewIntFromUint64(gasUsed)).
+			QuoInt(sdk.NewIntFromUint64(totalGas)).
+			TruncateInt()
+		if !rewardAmount.IsZero() {
+			splitFees = splitFees.Add(sdk.NewCoin(c.Denom, rewardAmount))
+		}
+	}
+	return splitFees
+}
+
+// WeightedFees returns the portion of a contract's fees paid to a withdrawer with
+// the given weight. Amounts are truncated, leaving any remainder in the fee
+// collector.
+func WeightedFees(fees sdk.Coins, weight sdk.Dec) sdk.Coins {
+	if weight.Equal(sdk.OneDec()) {
+		return fees
+	}
+
+	weighted, _ := sdk.NewDecCoinsFromCoins(fees...).MulDecTruncate(weight).TruncateDecimal()
+	return weighted
+}
@@ -32,3 +32,12 @@ func (q QueryWithdrawerFeeSharesRequest) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic runs stateless checks on the query requests
+func (q QueryAccruedFeeShareRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(q.WithdrawerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdraw address %s", q.WithdrawerAddress)
+	}
+
+	return nil
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -533,6 +535,306 @@ func (m *QueryWithdrawerFeeSharesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAccruedFeeShareRequest is the request type for the
+// Query/AccruedFeeShare RPC method.
+type QueryAccruedFeeShareRequest struct {
+	// withdrawer_address in bech32 format
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+}
+
+func (m *QueryAccruedFeeShareRequest) Reset()         { *m = QueryAccruedFeeShareRequest{} }
+func (m *QueryAccruedFeeShareRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccruedFeeShareRequest) ProtoMessage()    {}
+func (*QueryAccruedFeeShareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_affabc6f0bd2ad33, []int{10}
+}
+func (m *QueryAccruedFeeShareRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccruedFeeShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccruedFeeShareRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccruedFeeShareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccruedFeeShareRequest.Merge(m, src)
+}
+func (m *QueryAccruedFeeShareRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccruedFeeShareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccruedFeeShareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccruedFeeShareRequest proto.InternalMessageInfo
+
+func (m *QueryAccruedFeeShareRequest) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+// QueryAccruedFeeShareResponse is the response type for the
+// Query/AccruedFeeShare RPC method.
+type QueryAccruedFeeShareResponse struct {
+	// amount is the unclaimed fee share of the withdrawer
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *QueryAccruedFeeShareResponse) Reset()         { *m = QueryAccruedFeeShareResponse{} }
+func (m *QueryAccruedFeeShareResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccruedFeeShareResponse) ProtoMessage()    {}
+func (*QueryAccruedFeeShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_affabc6f0bd2ad33, []int{11}
+}
+func (m *QueryAccruedFeeShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccruedFeeShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccruedFeeShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccruedFeeShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccruedFeeShareResponse.Merge(m, src)
+}
+func (m *QueryAccruedFeeShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccruedFeeShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccruedFeeShareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccruedFeeShareResponse proto.InternalMessageInfo
+
+func (m *QueryAccruedFeeShareResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// QueryAccruedFeeSharesRequest is the request type for the
+// Query/AccruedFeeShares RPC method.
+type QueryAccruedFeeSharesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccruedFeeSharesRequest) Reset()         { *m = QueryAccruedFeeSharesRequest{} }
+func (m *QueryAccruedFeeSharesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccruedFeeSharesRequest) ProtoMessage()    {}
+func (*QueryAccruedFeeSharesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_affabc6f0bd2ad33, []int{12}
+}
+func (m *QueryAccruedFeeSharesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccruedFeeSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccruedFeeSharesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccruedFeeSharesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccruedFeeSharesRequest.Merge(m, src)
+}
+func (m *QueryAccruedFeeSharesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccruedFeeSharesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccruedFeeSharesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccruedFeeSharesRequest proto.InternalMessageInfo
+
+func (m *QueryAccruedFeeSharesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccruedFeeSharesResponse is the response type for the
+// Query/AccruedFeeShares RPC method.
+type QueryAccruedFeeSharesResponse struct {
+	// accrued_fee_shares is the slice of unclaimed fee shares
+	AccruedFeeShares []AccruedFeeShare `protobuf:"bytes,1,rep,name=accrued_fee_shares,json=accruedFeeShares,proto3" json:"accrued_fee_shares"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccruedFeeSharesResponse) Reset()         { *m = QueryAccruedFeeSharesResponse{} }
+func (m *QueryAccruedFeeSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccruedFeeSharesResponse) ProtoMessage()    {}
+func (*QueryAccruedFeeSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_affabc6f0bd2ad33, []int{13}
+}
+func (m *QueryAccruedFeeSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccruedFeeSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccruedFeeSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccruedFeeSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccruedFeeSharesResponse.Merge(m, src)
+}
+func (m *QueryAccruedFeeSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccruedFeeSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccruedFeeSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccruedFeeSharesResponse proto.InternalMessageInfo
+
+func (m *QueryAccruedFeeSharesResponse) GetAccruedFeeShares() []AccruedFeeShare {
+	if m != nil {
+		return m.AccruedFeeShares
+	}
+	return nil
+}
+
+func (m *QueryAccruedFeeSharesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryContractFeeShareRatiosRequest is the request type for the
+// Query/ContractFeeShareRatios RPC method.
+type QueryContractFeeShareRatiosRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractFeeShareRatiosRequest) Reset()         { *m = QueryContractFeeShareRatiosRequest{} }
+func (m *QueryContractFeeShareRatiosRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractFeeShareRatiosRequest) ProtoMessage()    {}
+func (*QueryContractFeeShareRatiosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_affabc6f0bd2ad33, []int{14}
+}
+func (m *QueryContractFeeShareRatiosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractFeeShareRatiosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractFeeShareRatiosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractFeeShareRatiosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractFeeShareRatiosRequest.Merge(m, src)
+}
+func (m *QueryContractFeeShareRatiosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractFeeShareRatiosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractFeeShareRatiosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractFeeShareRatiosRequest proto.InternalMessageInfo
+
+func (m *QueryContractFeeShareRatiosRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryContractFeeShareRatiosResponse is the response type for the
+// Query/ContractFeeShareRatios RPC method.
+type QueryContractFeeShareRatiosResponse struct {
+	// ratios are the developer share overrides of individual contracts
+	Ratios []ContractFeeShareRatio `protobuf:"bytes,1,rep,name=ratios,proto3" json:"ratios"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractFeeShareRatiosResponse) Reset()         { *m = QueryContractFeeShareRatiosResponse{} }
+func (m *QueryContractFeeShareRatiosResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractFeeShareRatiosResponse) ProtoMessage()    {}
+func (*QueryContractFeeShareRatiosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_affabc6f0bd2ad33, []int{15}
+}
+func (m *QueryContractFeeShareRatiosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractFeeShareRatiosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractFeeShareRatiosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractFeeShareRatiosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractFeeShareRatiosResponse.Merge(m, src)
+}
+func (m *QueryContractFeeShareRatiosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractFeeShareRatiosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractFeeShareRatiosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractFeeShareRatiosResponse proto.InternalMessageInfo
+
+func (m *QueryContractFeeShareRatiosResponse) GetRatios() []ContractFeeShareRatio {
+	if m != nil {
+		return m.Ratios
+	}
+	return nil
+}
+
+func (m *QueryContractFeeShareRatiosResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryFeeSharesRequest)(nil), "juno.feeshare.v1.QueryFeeSharesRequest")
 	proto.RegisterType((*QueryFeeSharesResponse)(nil), "juno.feeshare.v1.QueryFeeSharesResponse")
@@ -544,55 +846,76 @@ func init() {
 	proto.RegisterType((*QueryDeployerFeeSharesResponse)(nil), "juno.feeshare.v1.QueryDeployerFeeSharesResponse")
 	proto.RegisterType((*QueryWithdrawerFeeSharesRequest)(nil), "juno.feeshare.v1.QueryWithdrawerFeeSharesRequest")
 	proto.RegisterType((*QueryWithdrawerFeeSharesResponse)(nil), "juno.feeshare.v1.QueryWithdrawerFeeSharesResponse")
+	proto.RegisterType((*QueryAccruedFeeShareRequest)(nil), "juno.feeshare.v1.QueryAccruedFeeShareRequest")
+	proto.RegisterType((*QueryAccruedFeeShareResponse)(nil), "juno.feeshare.v1.QueryAccruedFeeShareResponse")
+	proto.RegisterType((*QueryAccruedFeeSharesRequest)(nil), "juno.feeshare.v1.QueryAccruedFeeSharesRequest")
+	proto.RegisterType((*QueryAccruedFeeSharesResponse)(nil), "juno.feeshare.v1.QueryAccruedFeeSharesResponse")
+	proto.RegisterType((*QueryContractFeeShareRatiosRequest)(nil), "juno.feeshare.v1.QueryContractFeeShareRatiosRequest")
+	proto.RegisterType((*QueryContractFeeShareRatiosResponse)(nil), "juno.feeshare.v1.QueryContractFeeShareRatiosResponse")
 }
 
 func init() { proto.RegisterFile("juno/feeshare/v1/query.proto", fileDescriptor_affabc6f0bd2ad33) }
 
 var fileDescriptor_affabc6f0bd2ad33 = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0x33, 0xfd, 0xfd, 0x0c, 0xed, 0xd3, 0x83, 0xed, 0xb4, 0x4a, 0x58, 0xea, 0x36, 0x2c,
-	0xb5, 0x7f, 0x84, 0xee, 0x74, 0x53, 0x50, 0x04, 0x2f, 0x6d, 0xa5, 0x82, 0x20, 0xd4, 0x88, 0x08,
-	0x5e, 0xca, 0x24, 0x19, 0xb7, 0x2b, 0xed, 0xce, 0x76, 0x67, 0xd3, 0x1a, 0x24, 0x17, 0xf1, 0xe4,
-	0x49, 0xd4, 0x43, 0xf1, 0xe2, 0x4b, 0xf0, 0xe8, 0x5b, 0xe8, 0xb1, 0xe0, 0xc5, 0x93, 0x48, 0xe2,
-	0x0b, 0x91, 0xcc, 0xcc, 0x26, 0x66, 0x37, 0xdb, 0x18, 0x29, 0x78, 0x5b, 0xe6, 0xf9, 0xf3, 0xfd,
-	0x3c, 0xcf, 0x3c, 0xf3, 0x2c, 0xcc, 0x3d, 0xaf, 0xfb, 0x9c, 0x3c, 0x63, 0x4c, 0xec, 0xd1, 0x90,
-	0x91, 0x23, 0x87, 0x1c, 0xd6, 0x59, 0xd8, 0xb0, 0x83, 0x90, 0x47, 0x1c, 0x4f, 0x75, 0xac, 0x76,
-	0x6c, 0xb5, 0x8f, 0x1c, 0xe3, 0x46, 0x95, 0x8b, 0x03, 0x2e, 0x48, 0x85, 0x0a, 0xa6, 0x5c, 0xc9,
-	0x91, 0x53, 0x61, 0x11, 0x75, 0x48, 0x40, 0x5d, 0xcf, 0xa7, 0x91, 0xc7, 0x7d, 0x15, 0x6d, 0x98,
-	0xa9, 0xdc, 0x2e, 0xf3, 0x99, 0xf0, 0x84, 0xb6, 0xcf, 0xa7, 0xec, 0x5d, 0x25, 0xe5, 0x30, 0xeb,
-	0x72, 0x97, 0xcb, 0x4f, 0xd2, 0xf9, 0xd2, 0xa7, 0x73, 0x2e, 0xe7, 0xee, 0x3e, 0x23, 0x34, 0xf0,
-	0x08, 0xf5, 0x7d, 0x1e, 0x49, 0x4d, 0x9d, 0xd4, 0xda, 0x85, 0x2b, 0x0f, 0x3b, 0x58, 0xdb, 0x8c,
-	0x3d, 0xea, 0xa4, 0x12, 0x65, 0x76, 0x58, 0x67, 0x22, 0xc2, 0xdb, 0x00, 0x3d, 0xc2, 0x02, 0x2a,
-	0xa2, 0xe5, 0xc9, 0xd2, 0xa2, 0xad, 0xca, 0xb1, 0x3b, 0xe5, 0xd8, 0xaa, 0x72, 0x5d, 0x8e, 0xbd,
-	0x43, 0x5d, 0xa6, 0x63, 0xcb, 0xbf, 0x45, 0x5a, 0x9f, 0x10, 0x5c, 0x4d, 0x2a, 0x88, 0x80, 0xfb,
-	0x82, 0xe1, 0x3b, 0x30, 0x1e, 0x57, 0x50, 0x40, 0xc5, 0xff, 0x96, 0x27, 0x4b, 0x86, 0x9d, 0xec,
-	0xa0, 0x1d, 0x87, 0x6d, 0xfe, 0x7f, 0xfa, 0x7d, 0x3e, 0x57, 0xee, 0x46, 0xe0, 0x7b, 0x7d, 0x80,
-	0x63, 0x12, 0x70, 0x69, 0x28, 0xa0, 0x92, 0xee, 0x23, 0xdc, 0x80, 0xd9, 0x3e, 0xc0, 0xb8, 0x03,
-	0x2b, 0x30, 0x55, 0xe5, 0x7e, 0x14, 0xd2, 0x6a, 0xb4, 0x4b, 0x6b, 0xb5, 0x90, 0x09, 0x21, 0xfb,
-	0x30, 0x51, 0xbe, 0x1c, 0x9f, 0x6f, 0xa8, 0x63, 0xeb, 0x71, 0xa2, 0x8b, 0x19, 0x25, 0xa2, 0xd1,
-	0x4a, 0xb4, 0x66, 0x01, 0xcb, 0xb4, 0x3b, 0x34, 0xa4, 0x07, 0xf1, 0xcd, 0x58, 0x0f, 0x60, 0xa6,
-	0xef, 0x54, 0x4b, 0xdd, 0x84, 0x7c, 0x20, 0x4f, 0xb4, 0x50, 0x21, 0x2d, 0xa4, 0x22, 0xb4, 0x8c,
-	0xf6, 0xb6, 0xde, 0x21, 0xb8, 0x26, 0xf3, 0xdd, 0x65, 0xc1, 0x3e, 0x6f, 0xb0, 0x30, 0x35, 0x0a,
-	0x2b, 0x30, 0x55, 0xd3, 0xb6, 0x64, 0x23, 0xe2, 0x73, 0xdd, 0x88, 0xc4, 0xd4, 0x8c, 0xfd, 0xf5,
-	0xd4, 0x9c, 0x20, 0x30, 0xb3, 0xa0, 0x74, 0xbd, 0xab, 0x80, 0x93, 0xd7, 0xc3, 0x84, 0x9c, 0xa3,
-	0x89, 0xf2, 0x74, 0xe2, 0x82, 0x98, 0xb8, 0xb8, 0x71, 0x39, 0x41, 0x30, 0x2f, 0xd1, 0x9e, 0x78,
-	0xd1, 0x5e, 0x2d, 0xa4, 0xc7, 0x03, 0x3a, 0xb6, 0x0a, 0xf8, 0xb8, 0x6b, 0x4d, 0xf4, 0x6c, 0xba,
-	0x67, 0xb9, 0xe8, 0xae, 0x7d, 0x44, 0x50, 0xcc, 0x46, 0xfb, 0xb7, 0x7d, 0x2b, 0xbd, 0xc9, 0xc3,
-	0x25, 0x09, 0x87, 0x5f, 0x23, 0x98, 0xe8, 0x72, 0xe1, 0xa5, 0xf4, 0x9c, 0x0e, 0xdc, 0x48, 0xc6,
-	0xf2, 0x70, 0x47, 0x25, 0x6b, 0x2d, 0xbc, 0xfa, 0xfa, 0xf3, 0xfd, 0x98, 0x89, 0xe7, 0xc8, 0xa0,
-	0x95, 0xb9, 0x2b, 0x94, 0xf0, 0x07, 0x04, 0xe3, 0x71, 0x2c, 0x5e, 0x1c, 0x92, 0x3c, 0x86, 0x58,
-	0x1a, 0xea, 0xa7, 0x19, 0x6e, 0x49, 0x06, 0x07, 0x93, 0xf3, 0x18, 0xc8, 0xcb, 0xe4, 0x55, 0x34,
-	0xf1, 0x31, 0xe4, 0xd5, 0x3b, 0xc5, 0x0b, 0x19, 0x5a, 0x7d, 0xeb, 0xc0, 0xb8, 0x3e, 0xc4, 0x4b,
-	0xf3, 0x14, 0x25, 0x8f, 0x81, 0x0b, 0x69, 0x1e, 0xb5, 0x08, 0xf0, 0x67, 0x04, 0xd3, 0xa9, 0xe7,
-	0x86, 0x49, 0x46, 0xfa, 0xac, 0x6d, 0x61, 0xac, 0xfd, 0x79, 0xc0, 0x68, 0xad, 0x4a, 0xee, 0xa0,
-	0x26, 0xfe, 0x82, 0x60, 0x66, 0xc0, 0xa8, 0x63, 0x27, 0x03, 0x21, 0xfb, 0xc5, 0x1a, 0xa5, 0x51,
-	0x42, 0x34, 0xf7, 0x6d, 0xc9, 0xbd, 0x8e, 0x9d, 0xf3, 0xb9, 0xd3, 0x9b, 0xa0, 0xb9, 0x79, 0xff,
-	0xb4, 0x65, 0xa2, 0xb3, 0x96, 0x89, 0x7e, 0xb4, 0x4c, 0xf4, 0xb6, 0x6d, 0xe6, 0xce, 0xda, 0x66,
-	0xee, 0x5b, 0xdb, 0xcc, 0x3d, 0x5d, 0x73, 0xbd, 0x68, 0xaf, 0x5e, 0xb1, 0xab, 0xfc, 0x80, 0x6c,
-	0xc9, 0x57, 0xb6, 0xa5, 0x47, 0x44, 0x28, 0x99, 0x17, 0x3d, 0xa1, 0xa8, 0x11, 0x30, 0x51, 0xc9,
-	0xcb, 0x3f, 0xf9, 0xfa, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x13, 0xa7, 0x98, 0x11, 0x9c, 0x08,
-	0x00, 0x00,
+	// 923 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xc7, 0x33, 0x05, 0xac, 0xe6, 0xe9, 0xa1, 0xce, 0x34, 0x54, 0xe9, 0x36, 0x6c, 0x9c, 0x6d,
+	0x69, 0x1c, 0x24, 0xef, 0xd8, 0x2e, 0x14, 0x21, 0x71, 0x71, 0x02, 0x41, 0x42, 0x20, 0x15, 0xa3,
+	0x0a, 0x89, 0x8b, 0x35, 0x5e, 0x4f, 0x36, 0x0b, 0xf1, 0x8e, 0xbb, 0xb3, 0x4e, 0x88, 0x50, 0x0e,
+	0xbc, 0x7c, 0x00, 0x04, 0x08, 0x55, 0x5c, 0xb8, 0x22, 0x2e, 0x70, 0x41, 0x1c, 0xb9, 0xf6, 0x58,
+	0x89, 0x0b, 0x27, 0x40, 0x09, 0x1f, 0x04, 0x79, 0x5e, 0xec, 0x78, 0x5f, 0xfc, 0x82, 0x2c, 0xf5,
+	0x94, 0xd5, 0xcc, 0x3c, 0xcf, 0xff, 0x37, 0xff, 0x99, 0x79, 0x9e, 0x18, 0xd6, 0x3f, 0xea, 0x87,
+	0x9c, 0xec, 0x33, 0x26, 0x0e, 0x68, 0xc4, 0xc8, 0x51, 0x8d, 0x3c, 0xec, 0xb3, 0xe8, 0xc4, 0xed,
+	0x45, 0x3c, 0xe6, 0xb8, 0x38, 0x98, 0x75, 0xcd, 0xac, 0x7b, 0x54, 0xb3, 0x5e, 0xf2, 0xb8, 0xe8,
+	0x72, 0x41, 0xda, 0x54, 0x30, 0xb5, 0x94, 0x1c, 0xd5, 0xda, 0x2c, 0xa6, 0x35, 0xd2, 0xa3, 0x7e,
+	0x10, 0xd2, 0x38, 0xe0, 0xa1, 0x8a, 0xb6, 0xec, 0x54, 0x6e, 0x9f, 0x85, 0x4c, 0x04, 0x42, 0xcf,
+	0x6f, 0xa4, 0xe6, 0x87, 0x4a, 0x6a, 0xc1, 0xaa, 0xcf, 0x7d, 0x2e, 0x3f, 0xc9, 0xe0, 0xcb, 0xa4,
+	0xbd, 0x88, 0x60, 0xc4, 0x3d, 0x1e, 0x18, 0xd9, 0x75, 0x9f, 0x73, 0xff, 0x90, 0x11, 0xda, 0x0b,
+	0x08, 0x0d, 0x43, 0x1e, 0x4b, 0x26, 0x2d, 0xea, 0xb4, 0xe0, 0xf9, 0xf7, 0x06, 0xd8, 0x7b, 0x8c,
+	0xbd, 0x3f, 0x90, 0x12, 0x4d, 0xf6, 0xb0, 0xcf, 0x44, 0x8c, 0xf7, 0x00, 0x46, 0x3b, 0x58, 0x43,
+	0x25, 0x54, 0xbe, 0x52, 0xbf, 0xe3, 0x2a, 0x2d, 0x77, 0xa0, 0xe5, 0x2a, 0x67, 0xb4, 0xa2, 0x7b,
+	0x9f, 0xfa, 0x4c, 0xc7, 0x36, 0x2f, 0x44, 0x3a, 0x3f, 0x20, 0xb8, 0x9e, 0x54, 0x10, 0x3d, 0x1e,
+	0x0a, 0x86, 0x5f, 0x87, 0xcb, 0x66, 0x87, 0x6b, 0xa8, 0xf4, 0x4c, 0xf9, 0x4a, 0xdd, 0x72, 0x93,
+	0x0e, 0xbb, 0x26, 0x6c, 0xe7, 0xd9, 0xc7, 0x7f, 0x6d, 0x2c, 0x35, 0x87, 0x11, 0xf8, 0xad, 0x31,
+	0xc0, 0x4b, 0x12, 0x70, 0x6b, 0x2a, 0xa0, 0x92, 0x1e, 0x23, 0x6c, 0xc0, 0xea, 0x18, 0xa0, 0x71,
+	0x60, 0x1b, 0x8a, 0x1e, 0x0f, 0xe3, 0x88, 0x7a, 0x71, 0x8b, 0x76, 0x3a, 0x11, 0x13, 0x42, 0xfa,
+	0xb0, 0xdc, 0xbc, 0x6a, 0xc6, 0x1b, 0x6a, 0xd8, 0x79, 0x90, 0x70, 0x31, 0x67, 0x8b, 0x68, 0xbe,
+	0x2d, 0x3a, 0xab, 0x80, 0x65, 0xda, 0xfb, 0x34, 0xa2, 0x5d, 0x73, 0x32, 0xce, 0xbb, 0x70, 0x6d,
+	0x6c, 0x54, 0x4b, 0xdd, 0x83, 0x42, 0x4f, 0x8e, 0x68, 0xa1, 0xb5, 0xb4, 0x90, 0x8a, 0xd0, 0x32,
+	0x7a, 0xb5, 0xf3, 0x35, 0x82, 0x17, 0x64, 0xbe, 0x37, 0x58, 0xef, 0x90, 0x9f, 0xb0, 0x28, 0x75,
+	0x15, 0xb6, 0xa1, 0xd8, 0xd1, 0x73, 0x49, 0x23, 0xcc, 0xb8, 0x36, 0x02, 0xef, 0x65, 0x1c, 0xca,
+	0xff, 0xb9, 0x35, 0x8f, 0x10, 0xd8, 0x79, 0x50, 0x7a, 0xbf, 0x15, 0xc0, 0xc9, 0xe3, 0x61, 0x42,
+	0xde, 0xa3, 0xe5, 0xe6, 0x4a, 0xe2, 0x80, 0x98, 0x58, 0xdc, 0x75, 0x79, 0x84, 0x60, 0x43, 0xa2,
+	0x7d, 0x10, 0xc4, 0x07, 0x9d, 0x88, 0x1e, 0x67, 0x38, 0x56, 0x01, 0x7c, 0x3c, 0x9c, 0x4d, 0x78,
+	0xb6, 0x32, 0x9a, 0x59, 0xb4, 0x6b, 0xdf, 0x23, 0x28, 0xe5, 0xa3, 0x3d, 0x65, 0xdf, 0xde, 0x81,
+	0x9b, 0x92, 0xad, 0xe1, 0x79, 0x51, 0x9f, 0x75, 0x92, 0xaf, 0x6d, 0x3e, 0xcb, 0x9c, 0x2f, 0x10,
+	0xac, 0x67, 0xa7, 0xd3, 0xdb, 0xf4, 0xa0, 0x40, 0xbb, 0xbc, 0x1f, 0xc6, 0xba, 0xb4, 0xdc, 0x18,
+	0x63, 0x36, 0xb4, 0xbb, 0x3c, 0x08, 0x77, 0xaa, 0x83, 0xf7, 0xf0, 0xd3, 0xdf, 0x1b, 0x65, 0x3f,
+	0x88, 0x0f, 0xfa, 0x6d, 0xd7, 0xe3, 0x5d, 0xa2, 0x8b, 0xaa, 0xfa, 0x53, 0x11, 0x9d, 0x8f, 0x49,
+	0x7c, 0xd2, 0x63, 0x42, 0x06, 0x88, 0xa6, 0x4e, 0xed, 0xec, 0x67, 0x43, 0x2c, 0xbc, 0x88, 0xfe,
+	0x6e, 0xde, 0x68, 0x5a, 0x48, 0x6f, 0xf7, 0x01, 0x60, 0xaa, 0xe6, 0x5a, 0xfb, 0x8c, 0xb5, 0xe4,
+	0x93, 0x17, 0x7a, 0xeb, 0x9b, 0xe9, 0x4a, 0x90, 0xc8, 0xa3, 0x4b, 0x42, 0x91, 0x26, 0xd2, 0x2f,
+	0xee, 0xf4, 0x0f, 0xc1, 0x91, 0x1b, 0xd8, 0xd5, 0x17, 0x6c, 0x78, 0x5e, 0x83, 0xe9, 0x85, 0xfb,
+	0xf5, 0x2b, 0x82, 0x5b, 0x13, 0xe5, 0xb4, 0x6b, 0x6f, 0x42, 0x21, 0x92, 0x23, 0xda, 0xa9, 0xad,
+	0xb4, 0x53, 0x99, 0x19, 0x4c, 0x09, 0x55, 0xc1, 0x0b, 0x73, 0xa9, 0xfe, 0x19, 0xc0, 0x73, 0x92,
+	0x1b, 0x7f, 0x89, 0x60, 0x79, 0x74, 0x0c, 0x19, 0x5c, 0x99, 0x5d, 0xdb, 0x2a, 0x4f, 0x5f, 0xa8,
+	0x64, 0x9d, 0xdb, 0x9f, 0xff, 0xf1, 0xef, 0x37, 0x97, 0x6c, 0xbc, 0x4e, 0xb2, 0xfe, 0xed, 0xd0,
+	0x17, 0x08, 0x7f, 0x8b, 0xe0, 0xb2, 0x89, 0xc5, 0x77, 0xa6, 0x24, 0x37, 0x10, 0x5b, 0x53, 0xd7,
+	0x69, 0x86, 0x57, 0x25, 0x43, 0x0d, 0x93, 0x49, 0x0c, 0xe4, 0xd3, 0x64, 0xb9, 0x3a, 0xc5, 0xc7,
+	0x50, 0x50, 0xbd, 0x0c, 0xdf, 0xce, 0xd1, 0x1a, 0x6b, 0x99, 0xd6, 0x8b, 0x53, 0x56, 0x69, 0x9e,
+	0x92, 0xe4, 0xb1, 0xf0, 0x5a, 0x9a, 0x47, 0x35, 0x4b, 0xfc, 0x33, 0x82, 0x95, 0x54, 0x4b, 0xc2,
+	0x24, 0x27, 0x7d, 0x5e, 0x47, 0xb5, 0xaa, 0xb3, 0x07, 0xcc, 0x67, 0x55, 0xb2, 0x4f, 0x9f, 0xe2,
+	0xdf, 0x10, 0x5c, 0xcb, 0x68, 0x07, 0xb8, 0x96, 0x83, 0x90, 0xdf, 0xd5, 0xac, 0xfa, 0x3c, 0x21,
+	0x9a, 0xfb, 0x35, 0xc9, 0x7d, 0x17, 0xd7, 0x26, 0x73, 0xa7, 0x4b, 0xff, 0x29, 0xfe, 0x11, 0xc1,
+	0xd5, 0x44, 0x9d, 0xc2, 0x95, 0x1c, 0x84, 0xec, 0xa6, 0x62, 0xb9, 0xb3, 0x2e, 0xd7, 0xb4, 0xf7,
+	0x24, 0x6d, 0x15, 0xbb, 0x69, 0x5a, 0x5d, 0x1a, 0xb3, 0x51, 0xbf, 0x43, 0x50, 0x6c, 0x24, 0x6b,
+	0xe7, 0x8c, 0xe2, 0x43, 0x7b, 0xc9, 0xcc, 0xeb, 0x35, 0xed, 0xa6, 0xa4, 0xbd, 0x89, 0x6f, 0xe4,
+	0xd2, 0xe2, 0x5f, 0x10, 0x5c, 0xcf, 0xae, 0x81, 0xf8, 0xe5, 0x1c, 0xb9, 0x89, 0x15, 0xda, 0x7a,
+	0x65, 0xce, 0x28, 0x8d, 0xba, 0x2d, 0x51, 0x6f, 0xe1, 0xcd, 0x34, 0xea, 0xf0, 0x75, 0xab, 0x62,
+	0xba, 0xf3, 0xf6, 0xe3, 0x33, 0x1b, 0x3d, 0x39, 0xb3, 0xd1, 0x3f, 0x67, 0x36, 0xfa, 0xea, 0xdc,
+	0x5e, 0x7a, 0x72, 0x6e, 0x2f, 0xfd, 0x79, 0x6e, 0x2f, 0x7d, 0x58, 0xbd, 0xd0, 0x9f, 0x77, 0x65,
+	0x71, 0x35, 0xaa, 0x42, 0xa5, 0xfd, 0x64, 0x94, 0x58, 0x76, 0xeb, 0x76, 0x41, 0xfe, 0xc8, 0xb9,
+	0xfb, 0xdf, 0x00, 0x5c, 0xf1, 0x12, 0xef, 0xd7, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -619,6 +942,13 @@ type QueryClient interface {
 	// WithdrawerFeeShares retrieves all FeeShares with a given withdrawer
 	// address
 	WithdrawerFeeShares(ctx context.Context, in *QueryWithdrawerFeeSharesRequest, opts ...grpc.CallOption) (*QueryWithdrawerFeeSharesResponse, error)
+	// AccruedFeeShare retrieves the unclaimed fee share accrued to a withdrawer
+	AccruedFeeShare(ctx context.Context, in *QueryAccruedFeeShareRequest, opts ...grpc.CallOption) (*QueryAccruedFeeShareResponse, error)
+	// AccruedFeeShares retrieves the unclaimed fee shares of all withdrawers
+	AccruedFeeShares(ctx context.Context, in *QueryAccruedFeeSharesRequest, opts ...grpc.CallOption) (*QueryAccruedFeeSharesResponse, error)
+	// ContractFeeShareRatios retrieves the developer shares set by governance
+	// for individual contracts
+	ContractFeeShareRatios(ctx context.Context, in *QueryContractFeeShareRatiosRequest, opts ...grpc.CallOption) (*QueryContractFeeShareRatiosResponse, error)
 }
 
 type queryClient struct {
@@ -674,6 +1004,33 @@ func (c *queryClient) WithdrawerFeeShares(ctx context.Context, in *QueryWithdraw
 	return out, nil
 }
 
+func (c *queryClient) AccruedFeeShare(ctx context.Context, in *QueryAccruedFeeShareRequest, opts ...grpc.CallOption) (*QueryAccruedFeeShareResponse, error) {
+	out := new(QueryAccruedFeeShareResponse)
+	err := c.cc.Invoke(ctx, "/juno.feeshare.v1.Query/AccruedFeeShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccruedFeeShares(ctx context.Context, in *QueryAccruedFeeSharesRequest, opts ...grpc.CallOption) (*QueryAccruedFeeSharesResponse, error) {
+	out := new(QueryAccruedFeeSharesResponse)
+	err := c.cc.Invoke(ctx, "/juno.feeshare.v1.Query/AccruedFeeShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractFeeShareRatios(ctx context.Context, in *QueryContractFeeShareRatiosRequest, opts ...grpc.CallOption) (*QueryContractFeeShareRatiosResponse, error) {
+	out := new(QueryContractFeeShareRatiosResponse)
+	err := c.cc.Invoke(ctx, "/juno.feeshare.v1.Query/ContractFeeShareRatios", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// FeeShares retrieves all registered FeeShares
@@ -688,6 +1045,13 @@ type QueryServer interface {
 	// WithdrawerFeeShares retrieves all FeeShares with a given withdrawer
 	// address
 	WithdrawerFeeShares(context.Context, *QueryWithdrawerFeeSharesRequest) (*QueryWithdrawerFeeSharesResponse, error)
+	// AccruedFeeShare retrieves the unclaimed fee share accrued to a withdrawer
+	AccruedFeeShare(context.Context, *QueryAccruedFeeShareRequest) (*QueryAccruedFeeShareResponse, error)
+	// AccruedFeeShares retrieves the unclaimed fee shares of all withdrawers
+	AccruedFeeShares(context.Context, *QueryAccruedFeeSharesRequest) (*QueryAccruedFeeSharesResponse, error)
+	// ContractFeeShareRatios retrieves the developer shares set by governance
+	// for individual contracts
+	ContractFeeShareRatios(context.Context, *QueryContractFeeShareRatiosRequest) (*QueryContractFeeShareRatiosResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -709,6 +1073,15 @@ func (*UnimplementedQueryServer) DeployerFeeShares(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) WithdrawerFeeShares(ctx context.Context, req *QueryWithdrawerFeeSharesRequest) (*QueryWithdrawerFeeSharesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawerFeeShares not implemented")
 }
+func (*UnimplementedQueryServer) AccruedFeeShare(ctx context.Context, req *QueryAccruedFeeShareRequest) (*QueryAccruedFeeShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccruedFeeShare not implemented")
+}
+func (*UnimplementedQueryServer) AccruedFeeShares(ctx context.Context, req *QueryAccruedFeeSharesRequest) (*QueryAccruedFeeSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccruedFeeShares not implemented")
+}
+func (*UnimplementedQueryServer) ContractFeeShareRatios(ctx context.Context, req *QueryContractFeeShareRatiosRequest) (*QueryContractFeeShareRatiosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractFeeShareRatios not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -804,6 +1177,60 @@ func _Query_WithdrawerFeeShares_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccruedFeeShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccruedFeeShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccruedFeeShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feeshare.v1.Query/AccruedFeeShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccruedFeeShare(ctx, req.(*QueryAccruedFeeShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccruedFeeShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccruedFeeSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccruedFeeShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feeshare.v1.Query/AccruedFeeShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccruedFeeShares(ctx, req.(*QueryAccruedFeeSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractFeeShareRatios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractFeeShareRatiosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractFeeShareRatios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feeshare.v1.Query/ContractFeeShareRatios",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractFeeShareRatios(ctx, req.(*QueryContractFeeShareRatiosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "juno.feeshare.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -828,6 +1255,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawerFeeShares",
 			Handler:    _Query_WithdrawerFeeShares_Handler,
 		},
+		{
+			MethodName: "AccruedFeeShare",
+			Handler:    _Query_AccruedFeeShare_Handler,
+		},
+		{
+			MethodName: "AccruedFeeShares",
+			Handler:    _Query_AccruedFeeShares_Handler,
+		},
+		{
+			MethodName: "ContractFeeShareRatios",
+			Handler:    _Query_ContractFeeShareRatios_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "juno/feeshare/v1/query.proto",
@@ -1208,15 +1647,250 @@ func (m *QueryWithdrawerFeeSharesResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
+func (m *QueryAccruedFeeShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccruedFeeShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccruedFeeShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccruedFeeShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccruedFeeShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccruedFeeShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccruedFeeSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccruedFeeSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccruedFeeSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccruedFeeSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccruedFeeSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccruedFeeSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AccruedFeeShares) > 0 {
+		for iNdEx := len(m.AccruedFeeShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccruedFeeShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractFeeShareRatiosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractFeeShareRatiosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractFeeShareRatiosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractFeeShareRatiosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractFeeShareRatiosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractFeeShareRatiosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ratios) > 0 {
+		for iNdEx := len(m.Ratios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ratios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *QueryFeeSharesRequest) Size() (n int) {
@@ -1367,26 +2041,118 @@ func (m *QueryWithdrawerFeeSharesResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryAccruedFeeShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryAccruedFeeShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *QueryFeeSharesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
+
+func (m *QueryAccruedFeeSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccruedFeeSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccruedFeeShares) > 0 {
+		for _, e := range m.AccruedFeeShares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractFeeShareRatiosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractFeeShareRatiosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ratios) > 0 {
+		for _, e := range m.Ratios {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryFeeSharesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
@@ -1459,7 +2225,543 @@ func (m *QueryFeeSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeshare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeshare = append(m.Feeshare, FeeShare{})
+			if err := m.Feeshare[len(m.Feeshare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeShareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeShareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeshare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Feeshare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeployerFeeSharesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeployerFeeSharesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeployerFeeSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeployerFeeSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1482,17 +2784,17 @@ func (m *QueryFeeSharesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeployerFeeSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeployerFeeSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeshare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1502,25 +2804,23 @@ func (m *QueryFeeSharesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Feeshare = append(m.Feeshare, FeeShare{})
-			if err := m.Feeshare[len(m.Feeshare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1579,7 +2879,7 @@ func (m *QueryFeeSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeShareRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawerFeeSharesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1602,15 +2902,15 @@ func (m *QueryFeeShareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeShareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawerFeeSharesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawerFeeSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1638,7 +2938,43 @@ func (m *QueryFeeShareRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1661,7 +2997,7 @@ func (m *QueryFeeShareRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeShareResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawerFeeSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1684,15 +3020,47 @@ func (m *QueryFeeShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawerFeeSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawerFeeSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeshare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1719,7 +3087,10 @@ func (m *QueryFeeShareResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Feeshare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1744,7 +3115,7 @@ func (m *QueryFeeShareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccruedFeeShareRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1767,12 +3138,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccruedFeeShareRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccruedFeeShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1794,7 +3197,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccruedFeeShareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1817,15 +3220,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccruedFeeShareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccruedFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1852,7 +3255,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1877,7 +3281,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDeployerFeeSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccruedFeeSharesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1900,45 +3304,13 @@ func (m *QueryDeployerFeeSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDeployerFeeSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccruedFeeSharesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDeployerFeeSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccruedFeeSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1995,7 +3367,7 @@ func (m *QueryDeployerFeeSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDeployerFeeSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccruedFeeSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2018,17 +3390,17 @@ func (m *QueryDeployerFeeSharesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDeployerFeeSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccruedFeeSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDeployerFeeSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccruedFeeSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedFeeShares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2038,23 +3410,25 @@ func (m *QueryDeployerFeeSharesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			m.AccruedFeeShares = append(m.AccruedFeeShares, AccruedFeeShare{})
+			if err := m.AccruedFeeShares[len(m.AccruedFeeShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2113,7 +3487,7 @@ func (m *QueryDeployerFeeSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawerFeeSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractFeeShareRatiosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2136,45 +3510,13 @@ func (m *QueryWithdrawerFeeSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawerFeeSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractFeeShareRatiosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawerFeeSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractFeeShareRatiosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2231,7 +3573,7 @@ func (m *QueryWithdrawerFeeSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawerFeeSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractFeeShareRatiosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2254,17 +3596,17 @@ func (m *QueryWithdrawerFeeSharesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawerFeeSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractFeeShareRatiosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawerFeeSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractFeeShareRatiosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratios", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2274,23 +3616,25 @@ func (m *QueryWithdrawerFeeSharesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			m.Ratios = append(m.Ratios, ContractFeeShareRatio{})
+			if err := m.Ratios[len(m.Ratios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
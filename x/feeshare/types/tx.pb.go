@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -41,6 +43,9 @@ type MsgRegisterFeeShare struct {
 	// withdrawer_address is the bech32 address of account receiving the
 	// transaction fees
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// withdrawers split the transaction fees between multiple accounts by
+	// weight. When set, withdrawer_address must be empty.
+	Withdrawers []WeightedWithdrawer `protobuf:"bytes,4,rep,name=withdrawers,proto3" json:"withdrawers"`
 }
 
 func (m *MsgRegisterFeeShare) Reset()         { *m = MsgRegisterFeeShare{} }
@@ -97,6 +102,13 @@ func (m *MsgRegisterFeeShare) GetWithdrawerAddress() string {
 	return ""
 }
 
+func (m *MsgRegisterFeeShare) GetWithdrawers() []WeightedWithdrawer {
+	if m != nil {
+		return m.Withdrawers
+	}
+	return nil
+}
+
 // MsgRegisterFeeShareResponse defines the MsgRegisterFeeShare response type
 type MsgRegisterFeeShareResponse struct {
 }
@@ -145,6 +157,9 @@ type MsgUpdateFeeShare struct {
 	// withdrawer_address is the bech32 address of account receiving the
 	// transaction fees
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// withdrawers replace the weighted withdrawers of the FeeShare. When set,
+	// withdrawer_address must be empty.
+	Withdrawers []WeightedWithdrawer `protobuf:"bytes,4,rep,name=withdrawers,proto3" json:"withdrawers"`
 }
 
 func (m *MsgUpdateFeeShare) Reset()         { *m = MsgUpdateFeeShare{} }
@@ -201,6 +216,13 @@ func (m *MsgUpdateFeeShare) GetWithdrawerAddress() string {
 	return ""
 }
 
+func (m *MsgUpdateFeeShare) GetWithdrawers() []WeightedWithdrawer {
+	if m != nil {
+		return m.Withdrawers
+	}
+	return nil
+}
+
 // MsgUpdateFeeShareResponse defines the MsgUpdateFeeShare response type
 type MsgUpdateFeeShareResponse struct {
 }
@@ -331,6 +353,197 @@ func (m *MsgCancelFeeShareResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelFeeShareResponse proto.InternalMessageInfo
 
+// MsgClaimFeeShare defines a message that claims the fee share accrued to a
+// withdrawer
+type MsgClaimFeeShare struct {
+	// withdrawer_address is the bech32 address of the withdrawer claiming its
+	// accrued fee share
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+}
+
+func (m *MsgClaimFeeShare) Reset()         { *m = MsgClaimFeeShare{} }
+func (m *MsgClaimFeeShare) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimFeeShare) ProtoMessage()    {}
+func (*MsgClaimFeeShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db5ab2575863a062, []int{6}
+}
+func (m *MsgClaimFeeShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimFeeShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimFeeShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimFeeShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimFeeShare.Merge(m, src)
+}
+func (m *MsgClaimFeeShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimFeeShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimFeeShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimFeeShare proto.InternalMessageInfo
+
+func (m *MsgClaimFeeShare) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+// MsgClaimFeeShareResponse defines the MsgClaimFeeShare response type
+type MsgClaimFeeShareResponse struct {
+	// amount is the fee share sent to the withdrawer
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClaimFeeShareResponse) Reset()         { *m = MsgClaimFeeShareResponse{} }
+func (m *MsgClaimFeeShareResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimFeeShareResponse) ProtoMessage()    {}
+func (*MsgClaimFeeShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db5ab2575863a062, []int{7}
+}
+func (m *MsgClaimFeeShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimFeeShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimFeeShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimFeeShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimFeeShareResponse.Merge(m, src)
+}
+func (m *MsgClaimFeeShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimFeeShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimFeeShareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimFeeShareResponse proto.InternalMessageInfo
+
+func (m *MsgClaimFeeShareResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgSetContractFeeShareRatio is the Msg/SetContractFeeShareRatio request type.
+type MsgSetContractFeeShareRatio struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract_address is the bech32 address of the contract
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// developer_shares is the percentage of the transaction fees paid out for the
+	// contract. If unset, the override is removed and the developer_shares param
+	// applies again.
+	DeveloperShares *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=developer_shares,json=developerShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"developer_shares,omitempty"`
+}
+
+func (m *MsgSetContractFeeShareRatio) Reset()         { *m = MsgSetContractFeeShareRatio{} }
+func (m *MsgSetContractFeeShareRatio) String() string { return proto.CompactTextString(m) }
+func (*MsgSetContractFeeShareRatio) ProtoMessage()    {}
+func (*MsgSetContractFeeShareRatio) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db5ab2575863a062, []int{8}
+}
+func (m *MsgSetContractFeeShareRatio) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetContractFeeShareRatio) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetContractFeeShareRatio.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetContractFeeShareRatio) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetContractFeeShareRatio.Merge(m, src)
+}
+func (m *MsgSetContractFeeShareRatio) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetContractFeeShareRatio) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetContractFeeShareRatio.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetContractFeeShareRatio proto.InternalMessageInfo
+
+func (m *MsgSetContractFeeShareRatio) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetContractFeeShareRatio) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgSetContractFeeShareRatioResponse defines the response structure for
+// executing a MsgSetContractFeeShareRatio message.
+type MsgSetContractFeeShareRatioResponse struct {
+}
+
+func (m *MsgSetContractFeeShareRatioResponse) Reset()         { *m = MsgSetContractFeeShareRatioResponse{} }
+func (m *MsgSetContractFeeShareRatioResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetContractFeeShareRatioResponse) ProtoMessage()    {}
+func (*MsgSetContractFeeShareRatioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db5ab2575863a062, []int{9}
+}
+func (m *MsgSetContractFeeShareRatioResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetContractFeeShareRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetContractFeeShareRatioResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetContractFeeShareRatioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetContractFeeShareRatioResponse.Merge(m, src)
+}
+func (m *MsgSetContractFeeShareRatioResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetContractFeeShareRatioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetContractFeeShareRatioResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetContractFeeShareRatioResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 //
 // Since: cosmos-sdk 0.47
@@ -347,7 +560,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db5ab2575863a062, []int{6}
+	return fileDescriptor_db5ab2575863a062, []int{10}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,7 +614,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db5ab2575863a062, []int{7}
+	return fileDescriptor_db5ab2575863a062, []int{11}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,6 +650,10 @@ func init() {
 	proto.RegisterType((*MsgUpdateFeeShareResponse)(nil), "juno.feeshare.v1.MsgUpdateFeeShareResponse")
 	proto.RegisterType((*MsgCancelFeeShare)(nil), "juno.feeshare.v1.MsgCancelFeeShare")
 	proto.RegisterType((*MsgCancelFeeShareResponse)(nil), "juno.feeshare.v1.MsgCancelFeeShareResponse")
+	proto.RegisterType((*MsgClaimFeeShare)(nil), "juno.feeshare.v1.MsgClaimFeeShare")
+	proto.RegisterType((*MsgClaimFeeShareResponse)(nil), "juno.feeshare.v1.MsgClaimFeeShareResponse")
+	proto.RegisterType((*MsgSetContractFeeShareRatio)(nil), "juno.feeshare.v1.MsgSetContractFeeShareRatio")
+	proto.RegisterType((*MsgSetContractFeeShareRatioResponse)(nil), "juno.feeshare.v1.MsgSetContractFeeShareRatioResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "juno.feeshare.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "juno.feeshare.v1.MsgUpdateParamsResponse")
 }
@@ -444,42 +661,58 @@ func init() {
 func init() { proto.RegisterFile("juno/feeshare/v1/tx.proto", fileDescriptor_db5ab2575863a062) }
 
 var fileDescriptor_db5ab2575863a062 = []byte{
-	// 559 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xc1, 0x6a, 0x13, 0x41,
-	0x1c, 0xc6, 0x33, 0x6d, 0x29, 0x74, 0x94, 0x36, 0x5d, 0x0b, 0x4d, 0x52, 0xdd, 0xea, 0xaa, 0xa5,
-	0x55, 0xb3, 0x63, 0x2b, 0xf4, 0xd0, 0x9b, 0x09, 0x78, 0x10, 0x02, 0x92, 0xe2, 0x45, 0x84, 0x30,
-	0xdd, 0x8c, 0x93, 0x95, 0x64, 0x67, 0x99, 0x99, 0xb4, 0xcd, 0xb5, 0x4f, 0x50, 0xf1, 0xe2, 0x49,
-	0x7a, 0xf0, 0x01, 0x3c, 0xf8, 0x10, 0x3d, 0x16, 0xbd, 0x78, 0x12, 0x49, 0x04, 0x7d, 0x0c, 0xd9,
-	0x99, 0xd9, 0x5d, 0x37, 0xd9, 0xd6, 0x5e, 0x04, 0x6f, 0xc9, 0x7c, 0xbf, 0xf9, 0xcf, 0x6f, 0x97,
-	0x6f, 0x16, 0x96, 0x5f, 0xf7, 0x03, 0x86, 0x5e, 0x11, 0x22, 0x3a, 0x98, 0x13, 0xb4, 0xbf, 0x89,
-	0xe4, 0xa1, 0x1b, 0x72, 0x26, 0x99, 0x55, 0x8c, 0x22, 0x37, 0x8e, 0xdc, 0xfd, 0xcd, 0xca, 0x12,
-	0x65, 0x94, 0xa9, 0x10, 0x45, 0xbf, 0x34, 0x57, 0xb9, 0x4e, 0x19, 0xa3, 0x5d, 0x82, 0x70, 0xe8,
-	0x23, 0x1c, 0x04, 0x4c, 0x62, 0xe9, 0xb3, 0x40, 0x98, 0x74, 0xd9, 0x63, 0xa2, 0xc7, 0x04, 0xea,
-	0x09, 0x1a, 0x4d, 0xef, 0x09, 0x6a, 0x82, 0xb2, 0x0e, 0x5a, 0x7a, 0x9e, 0xfe, 0x63, 0x22, 0x7b,
-	0x42, 0x8a, 0x92, 0x80, 0x08, 0xdf, 0xe4, 0xce, 0x09, 0x80, 0xd7, 0x1a, 0x82, 0x36, 0x09, 0xf5,
-	0x85, 0x24, 0xfc, 0x09, 0x21, 0xbb, 0x11, 0x68, 0x6d, 0xc0, 0xa2, 0xc7, 0x02, 0xc9, 0xb1, 0x27,
-	0x5b, 0xb8, 0xdd, 0xe6, 0x44, 0x88, 0x12, 0xb8, 0x09, 0xd6, 0xe7, 0x9a, 0x0b, 0xf1, 0xfa, 0x63,
-	0xbd, 0x1c, 0xa1, 0x6d, 0x12, 0x76, 0xd9, 0x80, 0xf0, 0x04, 0x9d, 0xd2, 0x68, 0xbc, 0x1e, 0xa3,
-	0x55, 0x68, 0x1d, 0xf8, 0xb2, 0xd3, 0xe6, 0xf8, 0xe0, 0x0f, 0x78, 0x5a, 0xc1, 0x8b, 0x69, 0x62,
-	0xf0, 0x9d, 0x99, 0x5f, 0x27, 0xab, 0x05, 0xe7, 0x06, 0x5c, 0xc9, 0x31, 0x6c, 0x12, 0x11, 0xb2,
-	0x40, 0x10, 0xe7, 0x3d, 0x80, 0x8b, 0x0d, 0x41, 0x9f, 0x87, 0x6d, 0x2c, 0xc9, 0xff, 0xe8, 0xbf,
-	0x02, 0xcb, 0x13, 0x7e, 0x89, 0x3d, 0x53, 0xf2, 0x75, 0x1c, 0x78, 0xa4, 0xfb, 0x6f, 0xe5, 0x33,
-	0x36, 0xd9, 0x03, 0x13, 0x9b, 0x37, 0x00, 0x2e, 0x24, 0xae, 0xcf, 0x30, 0xc7, 0x3d, 0x61, 0x6d,
-	0xc3, 0x39, 0xdc, 0x97, 0x1d, 0xc6, 0x7d, 0x39, 0xd0, 0x16, 0xb5, 0xd2, 0xe7, 0x4f, 0xd5, 0x25,
-	0x53, 0x33, 0x33, 0x7d, 0x57, 0x72, 0x3f, 0xa0, 0xcd, 0x14, 0xb5, 0xb6, 0xe1, 0x6c, 0xa8, 0x26,
-	0x28, 0x9f, 0x2b, 0x5b, 0x25, 0x77, 0xfc, 0x12, 0xb8, 0xfa, 0x84, 0xda, 0xcc, 0xe9, 0xb7, 0xd5,
-	0x42, 0xd3, 0xd0, 0x3b, 0xf3, 0x47, 0x3f, 0x3f, 0xde, 0x4b, 0xe7, 0x38, 0x65, 0xb8, 0x3c, 0xa6,
-	0x14, 0xeb, 0x6e, 0x7d, 0x98, 0x81, 0xd3, 0x0d, 0x41, 0xad, 0x77, 0x00, 0x16, 0x27, 0x1a, 0x7c,
-	0x77, 0xf2, 0xbc, 0x9c, 0x1a, 0x55, 0xaa, 0x97, 0xc2, 0x92, 0x37, 0xe4, 0x1e, 0x7d, 0xf9, 0xf1,
-	0x76, 0x6a, 0xdd, 0x59, 0x43, 0x39, 0xb7, 0x1d, 0x71, 0xb3, 0xad, 0x95, 0x58, 0x1c, 0x03, 0x38,
-	0x3f, 0x56, 0xcd, 0xdb, 0xb9, 0x27, 0x66, 0xa1, 0xca, 0xfd, 0x4b, 0x40, 0x89, 0xd4, 0x03, 0x25,
-	0xb5, 0xe6, 0xdc, 0xc9, 0x95, 0xea, 0xab, 0x4d, 0x59, 0xa5, 0xb1, 0xc2, 0xe5, 0x2b, 0x65, 0xa1,
-	0x73, 0x94, 0xce, 0x69, 0xd2, 0xc5, 0x4a, 0x9e, 0xda, 0x94, 0x2a, 0xbd, 0x84, 0x57, 0x33, 0x9d,
-	0xbb, 0x75, 0xc1, 0xd3, 0x6b, 0xa4, 0xb2, 0xf1, 0x57, 0x24, 0x76, 0xa9, 0x3d, 0x3d, 0x1d, 0xda,
-	0xe0, 0x6c, 0x68, 0x83, 0xef, 0x43, 0x1b, 0x1c, 0x8f, 0xec, 0xc2, 0xd9, 0xc8, 0x2e, 0x7c, 0x1d,
-	0xd9, 0x85, 0x17, 0x0f, 0xa9, 0x2f, 0x3b, 0xfd, 0x3d, 0xd7, 0x63, 0x3d, 0x54, 0x57, 0x7d, 0xae,
-	0x9b, 0xfb, 0x25, 0xb4, 0xf7, 0x61, 0x6a, 0x2e, 0x07, 0x21, 0x11, 0x7b, 0xb3, 0xea, 0xb3, 0xf9,
-	0xe8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x85, 0x8e, 0x48, 0x79, 0xed, 0x05, 0x00, 0x00,
+	// 804 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0x00, 0x69, 0xc2, 0xf0, 0xfb, 0x41, 0xa9, 0x24, 0xb4, 0x8b, 0xb6, 0xb8, 0x7c, 0xa4,
+	0x80, 0xdd, 0x05, 0x8c, 0x1c, 0xb8, 0xd9, 0x1a, 0x4d, 0x8c, 0x24, 0xa6, 0xc4, 0x10, 0x8d, 0x49,
+	0x33, 0xdd, 0x8e, 0xdb, 0xd5, 0x76, 0x67, 0xb3, 0x33, 0xe5, 0xe3, 0xa4, 0xe1, 0xec, 0x01, 0xe3,
+	0xc5, 0xa3, 0x67, 0x4f, 0x1e, 0x8c, 0x7f, 0x03, 0x47, 0xa2, 0x17, 0xe3, 0x01, 0x0d, 0x98, 0x68,
+	0xa2, 0xf1, 0x6f, 0x30, 0x3b, 0x3b, 0x3b, 0xfd, 0xd8, 0x05, 0xaa, 0x89, 0x17, 0x4f, 0xd0, 0x79,
+	0x9e, 0xf7, 0x7d, 0x9f, 0xf7, 0x99, 0x79, 0xdf, 0x16, 0xa6, 0x1f, 0x36, 0x6d, 0xa2, 0x3f, 0xc0,
+	0x98, 0xd6, 0x90, 0x8b, 0xf5, 0xcd, 0x25, 0x9d, 0x6d, 0x6b, 0x8e, 0x4b, 0x18, 0x49, 0x26, 0x3c,
+	0x48, 0x0b, 0x20, 0x6d, 0x73, 0x49, 0x19, 0x33, 0x89, 0x49, 0x38, 0xa8, 0x7b, 0xff, 0xf9, 0x3c,
+	0xe5, 0xbc, 0x49, 0x88, 0x59, 0xc7, 0x3a, 0x72, 0x2c, 0x1d, 0xd9, 0x36, 0x61, 0x88, 0x59, 0xc4,
+	0xa6, 0x02, 0x1d, 0x37, 0x08, 0x6d, 0x10, 0xaa, 0x37, 0xa8, 0xe9, 0x65, 0x6f, 0x50, 0x53, 0x00,
+	0x69, 0x1f, 0x28, 0xfb, 0xf9, 0xfc, 0x0f, 0x02, 0xca, 0x88, 0x98, 0x0a, 0xa2, 0x9e, 0xa4, 0x0a,
+	0x66, 0x68, 0x49, 0x37, 0x88, 0x65, 0x07, 0x78, 0x48, 0xb4, 0x89, 0x6d, 0x4c, 0xad, 0x20, 0x3e,
+	0x1b, 0xc2, 0x65, 0x17, 0x9c, 0xa0, 0xfe, 0x04, 0xf0, 0xdc, 0x1a, 0x35, 0x4b, 0xd8, 0xb4, 0x28,
+	0xc3, 0xee, 0x75, 0x8c, 0xd7, 0x3d, 0x34, 0x39, 0x07, 0x13, 0x06, 0xb1, 0x99, 0x8b, 0x0c, 0x56,
+	0x46, 0xd5, 0xaa, 0x8b, 0x29, 0x4d, 0x81, 0x49, 0x90, 0x1b, 0x2c, 0x8d, 0x04, 0xe7, 0x57, 0xfd,
+	0x63, 0x8f, 0x5a, 0xc5, 0x4e, 0x9d, 0xec, 0x60, 0x57, 0x52, 0xfb, 0x7c, 0x6a, 0x70, 0x1e, 0x50,
+	0xf3, 0x30, 0xb9, 0x65, 0xb1, 0x5a, 0xd5, 0x45, 0x5b, 0x6d, 0xe4, 0x7e, 0x4e, 0x1e, 0x6d, 0x21,
+	0x01, 0xfd, 0x16, 0x1c, 0x6a, 0x1d, 0xd2, 0xd4, 0xc0, 0x64, 0x7f, 0x6e, 0x68, 0x79, 0x5a, 0xeb,
+	0xbe, 0x0d, 0x6d, 0x03, 0x5b, 0x66, 0x8d, 0xe1, 0xea, 0x86, 0x24, 0x17, 0x06, 0xf6, 0x0f, 0xb3,
+	0xb1, 0x52, 0x7b, 0xf8, 0xea, 0xc0, 0xb7, 0x97, 0xd9, 0x98, 0x7a, 0x01, 0x4e, 0x44, 0xf4, 0x5b,
+	0xc2, 0xd4, 0x21, 0x36, 0xc5, 0xea, 0x0f, 0x00, 0x47, 0xd7, 0xa8, 0x79, 0xc7, 0xa9, 0x22, 0x86,
+	0xff, 0x7d, 0x37, 0x26, 0x60, 0x3a, 0xd4, 0xad, 0xf4, 0x82, 0x70, 0x2b, 0x8a, 0xc8, 0x36, 0x70,
+	0xfd, 0xef, 0x5a, 0xd1, 0xa1, 0xa6, 0xb3, 0xa0, 0x54, 0x73, 0x03, 0x26, 0x3c, 0xb0, 0x8e, 0xac,
+	0x86, 0x14, 0x13, 0xed, 0x20, 0x38, 0xc1, 0x41, 0x51, 0xe5, 0x31, 0x4c, 0x75, 0x27, 0x0a, 0x8a,
+	0x24, 0x0d, 0x18, 0x47, 0x0d, 0xd2, 0xb4, 0x59, 0x0a, 0x70, 0x7b, 0xd3, 0x9a, 0x18, 0x47, 0x6f,
+	0x00, 0x35, 0x31, 0x80, 0x5a, 0x91, 0x58, 0x76, 0x61, 0xd1, 0xf3, 0xf4, 0xd5, 0xa7, 0x6c, 0xce,
+	0xb4, 0x58, 0xad, 0x59, 0xd1, 0x0c, 0xd2, 0x10, 0xb3, 0x2b, 0xfe, 0xe4, 0x69, 0xf5, 0x91, 0xce,
+	0x76, 0x1c, 0x4c, 0x79, 0x00, 0x2d, 0x89, 0xd4, 0xea, 0x77, 0xc0, 0xdf, 0xe0, 0x3a, 0x66, 0x45,
+	0xe1, 0x98, 0xd4, 0xe1, 0x2d, 0x8c, 0xe4, 0x0a, 0x1c, 0x44, 0x4d, 0x56, 0x23, 0xae, 0xc5, 0x76,
+	0xfc, 0x66, 0x0a, 0xa9, 0x77, 0x6f, 0xf2, 0x63, 0x42, 0x8a, 0xe8, 0x66, 0x9d, 0xb9, 0x96, 0x6d,
+	0x96, 0x5a, 0xd4, 0xc8, 0xab, 0xe9, 0x8b, 0xbe, 0x9a, 0xbb, 0xde, 0xd5, 0x6c, 0xe2, 0x3a, 0x71,
+	0xb0, 0x5b, 0xe6, 0x2f, 0x47, 0x3c, 0xbc, 0x82, 0xb6, 0x7f, 0x98, 0x05, 0x1f, 0x0f, 0xb3, 0xb3,
+	0x3d, 0xb4, 0x75, 0x0d, 0x1b, 0xa5, 0x11, 0x99, 0x87, 0x77, 0x40, 0x57, 0x87, 0x77, 0xbf, 0xbe,
+	0x9e, 0x6f, 0xa9, 0x52, 0x67, 0xe0, 0xd4, 0x29, 0xcd, 0xca, 0xeb, 0x7d, 0x06, 0xe0, 0x88, 0x7c,
+	0x8a, 0xb7, 0x91, 0x8b, 0x1a, 0xf4, 0x8f, 0x8d, 0x58, 0x81, 0x71, 0x87, 0x67, 0xe0, 0xed, 0x0f,
+	0x2d, 0xa7, 0xc2, 0x43, 0xe2, 0x57, 0x10, 0x83, 0x21, 0xd8, 0x21, 0xe9, 0x69, 0x38, 0xde, 0x25,
+	0x29, 0x90, 0xbb, 0xfc, 0x36, 0x0e, 0xfb, 0xd7, 0xa8, 0x99, 0x7c, 0x01, 0x60, 0x22, 0xb4, 0x3c,
+	0x67, 0xc2, 0xf5, 0x22, 0x76, 0x8e, 0x92, 0xef, 0x89, 0x26, 0x1d, 0xd2, 0x76, 0xdf, 0x7f, 0x79,
+	0xde, 0x97, 0x53, 0x67, 0xf5, 0x88, 0x6f, 0x2a, 0xdd, 0x15, 0x61, 0x65, 0xa9, 0x62, 0x0f, 0xc0,
+	0xe1, 0xae, 0x3d, 0x36, 0x15, 0x59, 0xb1, 0x93, 0xa4, 0x2c, 0xf4, 0x40, 0x92, 0xa2, 0x2e, 0x71,
+	0x51, 0xb3, 0xea, 0x74, 0xa4, 0xa8, 0x26, 0x0f, 0xea, 0x94, 0xd4, 0xb5, 0x4f, 0xa2, 0x25, 0x75,
+	0x92, 0x94, 0x85, 0x1e, 0x48, 0x3d, 0x4a, 0x32, 0x78, 0x50, 0x4b, 0xd2, 0x53, 0x00, 0xff, 0xef,
+	0x5c, 0x2a, 0x6a, 0x74, 0xb1, 0x76, 0x8e, 0x32, 0x7f, 0x36, 0x47, 0xea, 0x59, 0xe0, 0x7a, 0x66,
+	0xd4, 0xa9, 0x68, 0x3d, 0x5e, 0x4c, 0x4b, 0xce, 0x13, 0x00, 0x53, 0x27, 0x2e, 0x86, 0xe8, 0x07,
+	0x73, 0x12, 0x5d, 0xb9, 0xf2, 0x5b, 0x74, 0xb9, 0x03, 0xef, 0xc3, 0xff, 0x3a, 0xa6, 0xf0, 0xe2,
+	0x29, 0xef, 0xc1, 0xa7, 0x28, 0x73, 0x67, 0x52, 0x82, 0xec, 0x85, 0x9b, 0xfb, 0x47, 0x19, 0x70,
+	0x70, 0x94, 0x01, 0x9f, 0x8f, 0x32, 0x60, 0xef, 0x38, 0x13, 0x3b, 0x38, 0xce, 0xc4, 0x3e, 0x1c,
+	0x67, 0x62, 0xf7, 0x16, 0xdb, 0x36, 0x4e, 0x91, 0x4f, 0x78, 0xa0, 0x93, 0xfa, 0xce, 0x6d, 0xb7,
+	0xbc, 0xe3, 0xfb, 0xa7, 0x12, 0xe7, 0xbf, 0x61, 0x2e, 0xff, 0x1a, 0x00, 0x45, 0xe5, 0x71, 0xfa,
+	0xbb, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -496,11 +729,17 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// RegisterFeeShare registers a new contract for receiving transaction fees
 	RegisterFeeShare(ctx context.Context, in *MsgRegisterFeeShare, opts ...grpc.CallOption) (*MsgRegisterFeeShareResponse, error)
-	// UpdateFeeShare updates the withdrawer address of a FeeShare
+	// UpdateFeeShare updates the withdrawer address or weighted withdrawers of a
+	// FeeShare
 	UpdateFeeShare(ctx context.Context, in *MsgUpdateFeeShare, opts ...grpc.CallOption) (*MsgUpdateFeeShareResponse, error)
 	// CancelFeeShare cancels a contract's fee registration and further receival
 	// of transaction fees
 	CancelFeeShare(ctx context.Context, in *MsgCancelFeeShare, opts ...grpc.CallOption) (*MsgCancelFeeShareResponse, error)
+	// ClaimFeeShare sends the fee share accrued to a withdrawer to its account
+	ClaimFeeShare(ctx context.Context, in *MsgClaimFeeShare, opts ...grpc.CallOption) (*MsgClaimFeeShareResponse, error)
+	// SetContractFeeShareRatio defines a governance operation for overriding the
+	// developer share of a single contract.
+	SetContractFeeShareRatio(ctx context.Context, in *MsgSetContractFeeShareRatio, opts ...grpc.CallOption) (*MsgSetContractFeeShareRatioResponse, error)
 	// Update the params of the module through gov v1 type.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -540,6 +779,24 @@ func (c *msgClient) CancelFeeShare(ctx context.Context, in *MsgCancelFeeShare, o
 	return out, nil
 }
 
+func (c *msgClient) ClaimFeeShare(ctx context.Context, in *MsgClaimFeeShare, opts ...grpc.CallOption) (*MsgClaimFeeShareResponse, error) {
+	out := new(MsgClaimFeeShareResponse)
+	err := c.cc.Invoke(ctx, "/juno.feeshare.v1.Msg/ClaimFeeShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetContractFeeShareRatio(ctx context.Context, in *MsgSetContractFeeShareRatio, opts ...grpc.CallOption) (*MsgSetContractFeeShareRatioResponse, error) {
+	out := new(MsgSetContractFeeShareRatioResponse)
+	err := c.cc.Invoke(ctx, "/juno.feeshare.v1.Msg/SetContractFeeShareRatio", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/juno.feeshare.v1.Msg/UpdateParams", in, out, opts...)
@@ -553,11 +810,17 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	// RegisterFeeShare registers a new contract for receiving transaction fees
 	RegisterFeeShare(context.Context, *MsgRegisterFeeShare) (*MsgRegisterFeeShareResponse, error)
-	// UpdateFeeShare updates the withdrawer address of a FeeShare
+	// UpdateFeeShare updates the withdrawer address or weighted withdrawers of a
+	// FeeShare
 	UpdateFeeShare(context.Context, *MsgUpdateFeeShare) (*MsgUpdateFeeShareResponse, error)
 	// CancelFeeShare cancels a contract's fee registration and further receival
 	// of transaction fees
 	CancelFeeShare(context.Context, *MsgCancelFeeShare) (*MsgCancelFeeShareResponse, error)
+	// ClaimFeeShare sends the fee share accrued to a withdrawer to its account
+	ClaimFeeShare(context.Context, *MsgClaimFeeShare) (*MsgClaimFeeShareResponse, error)
+	// SetContractFeeShareRatio defines a governance operation for overriding the
+	// developer share of a single contract.
+	SetContractFeeShareRatio(context.Context, *MsgSetContractFeeShareRatio) (*MsgSetContractFeeShareRatioResponse, error)
 	// Update the params of the module through gov v1 type.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -575,6 +838,12 @@ func (*UnimplementedMsgServer) UpdateFeeShare(ctx context.Context, req *MsgUpdat
 func (*UnimplementedMsgServer) CancelFeeShare(ctx context.Context, req *MsgCancelFeeShare) (*MsgCancelFeeShareResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelFeeShare not implemented")
 }
+func (*UnimplementedMsgServer) ClaimFeeShare(ctx context.Context, req *MsgClaimFeeShare) (*MsgClaimFeeShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimFeeShare not implemented")
+}
+func (*UnimplementedMsgServer) SetContractFeeShareRatio(ctx context.Context, req *MsgSetContractFeeShareRatio) (*MsgSetContractFeeShareRatioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContractFeeShareRatio not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -637,6 +906,42 @@ func _Msg_CancelFeeShare_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimFeeShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimFeeShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimFeeShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feeshare.v1.Msg/ClaimFeeShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimFeeShare(ctx, req.(*MsgClaimFeeShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetContractFeeShareRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetContractFeeShareRatio)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetContractFeeShareRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feeshare.v1.Msg/SetContractFeeShareRatio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetContractFeeShareRatio(ctx, req.(*MsgSetContractFeeShareRatio))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -671,6 +976,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelFeeShare",
 			Handler:    _Msg_CancelFeeShare_Handler,
 		},
+		{
+			MethodName: "ClaimFeeShare",
+			Handler:    _Msg_ClaimFeeShare_Handler,
+		},
+		{
+			MethodName: "SetContractFeeShareRatio",
+			Handler:    _Msg_SetContractFeeShareRatio_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -700,6 +1013,20 @@ func (m *MsgRegisterFeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Withdrawers) > 0 {
+		for iNdEx := len(m.Withdrawers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.WithdrawerAddress) > 0 {
 		i -= len(m.WithdrawerAddress)
 		copy(dAtA[i:], m.WithdrawerAddress)
@@ -767,6 +1094,20 @@ func (m *MsgUpdateFeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Withdrawers) > 0 {
+		for iNdEx := len(m.Withdrawers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.WithdrawerAddress) > 0 {
 		i -= len(m.WithdrawerAddress)
 		copy(dAtA[i:], m.WithdrawerAddress)
@@ -874,7 +1215,7 @@ func (m *MsgCancelFeeShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimFeeShare) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -884,37 +1225,27 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimFeeShare) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimFeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawerAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimFeeShareResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -924,69 +1255,188 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimFeeShareResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimFeeShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgRegisterFeeShare) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DeployerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.WithdrawerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetContractFeeShareRatio) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterFeeShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetContractFeeShareRatio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateFeeShare) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetContractFeeShareRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DeveloperShares != nil {
+		{
+			size := m.DeveloperShares.Size()
+			i -= size
+			if _, err := m.DeveloperShares.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetContractFeeShareRatioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractFeeShareRatioResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractFeeShareRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterFeeShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.DeployerAddress)
 	if l > 0 {
@@ -996,6 +1446,48 @@ func (m *MsgUpdateFeeShare) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Withdrawers) > 0 {
+		for _, e := range m.Withdrawers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterFeeShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateFeeShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DeployerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Withdrawers) > 0 {
+		for _, e := range m.Withdrawers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1034,6 +1526,64 @@ func (m *MsgCancelFeeShareResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClaimFeeShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimFeeShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetContractFeeShareRatio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DeveloperShares != nil {
+		l = m.DeveloperShares.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetContractFeeShareRatioResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1044,27 +1594,257 @@ func (m *MsgUpdateParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterFeeShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterFeeShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawers = append(m.Withdrawers, WeightedWithdrawer{})
+			if err := m.Withdrawers[len(m.Withdrawers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterFeeShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterFeeShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRegisterFeeShare) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFeeShare) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1087,10 +1867,10 @@ func (m *MsgRegisterFeeShare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterFeeShare: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFeeShare: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1189,6 +1969,40 @@ func (m *MsgRegisterFeeShare) Unmarshal(dAtA []byte) error {
 			}
 			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawers = append(m.Withdrawers, WeightedWithdrawer{})
+			if err := m.Withdrawers[len(m.Withdrawers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1210,7 +2024,7 @@ func (m *MsgRegisterFeeShare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterFeeShareResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFeeShareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1233,10 +2047,10 @@ func (m *MsgRegisterFeeShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterFeeShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFeeShareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1260,7 +2074,7 @@ func (m *MsgRegisterFeeShareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateFeeShare) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelFeeShare) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1283,10 +2097,10 @@ func (m *MsgUpdateFeeShare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateFeeShare: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelFeeShare: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1353,7 +2167,107 @@ func (m *MsgUpdateFeeShare) Unmarshal(dAtA []byte) error {
 			}
 			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelFeeShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelFeeShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimFeeShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimFeeShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
 			}
@@ -1406,7 +2320,7 @@ func (m *MsgUpdateFeeShare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateFeeShareResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimFeeShareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1429,12 +2343,46 @@ func (m *MsgUpdateFeeShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateFeeShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimFeeShareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1456,7 +2404,7 @@ func (m *MsgUpdateFeeShareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelFeeShare) Unmarshal(dAtA []byte) error {
+func (m *MsgSetContractFeeShareRatio) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1479,13 +2427,45 @@ func (m *MsgCancelFeeShare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelFeeShare: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetContractFeeShareRatio: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetContractFeeShareRatio: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
@@ -1517,9 +2497,9 @@ func (m *MsgCancelFeeShare) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1547,7 +2527,11 @@ func (m *MsgCancelFeeShare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.DeveloperShares = &v
+			if err := m.DeveloperShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1570,7 +2554,7 @@ func (m *MsgCancelFeeShare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelFeeShareResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetContractFeeShareRatioResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1593,10 +2577,10 @@ func (m *MsgCancelFeeShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelFeeShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetContractFeeShareRatioResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetContractFeeShareRatioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9,39 +9,38 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
 	globalfeekeeper "github.com/CosmosContracts/juno/v26/x/globalfee/keeper"
+	"github.com/CosmosContracts/juno/v26/x/globalfee/types"
 )
 
 // FeeWithBypassDecorator checks if the transaction's fee is at least as large
 // as the local validator's minimum gasFee (defined in validator config) and global fee, and the fee denom should be in the global fees' denoms.
 //
 // If fee is too low, decorator returns error and tx is rejected from mempool.
-// Note this only applies when ctx.CheckTx = true. If fee is high enough or not
-// CheckTx, then call next AnteHandler.
+// Note this only applies when ctx.CheckTx = true, unless the EnforceInDeliverTx
+// param is set, in which case the global fee is also enforced in DeliverTx. If
+// fee is high enough or not enforced, then call next AnteHandler.
 //
 // CONTRACT: Tx must implement FeeTx to use FeeDecorator
-// If the tx msg type is one of the bypass msg types, the tx is valid even if the min fee is lower than normally required.
+// If the tx msg type is one of the bypass msg types set in the x/globalfee params, the tx is valid even if the min fee is lower than normally required.
 // If the bypass tx still carries fees, the fee denom should be the same as global fee required.
 
 var _ sdk.AnteDecorator = FeeDecorator{}
 
 type FeeDecorator struct {
-	BypassMinFeeMsgTypes            []string
-	GlobalFeeKeeper                 globalfeekeeper.Keeper
-	StakingKeeper                   stakingkeeper.Keeper
-	MaxTotalBypassMinFeeMsgGasUsage uint64
-	IsFeePayTx                      *bool
+	GlobalFeeKeeper globalfeekeeper.Keeper
+	StakingKeeper   stakingkeeper.Keeper
+	IsFeePayTx      *bool
 }
 
-func NewFeeDecorator(bypassMsgTypes []string, gfk globalfeekeeper.Keeper, sk stakingkeeper.Keeper, maxTotalBypassMinFeeMsgGasUsage uint64, isFeePayTx *bool) FeeDecorator {
+func NewFeeDecorator(gfk globalfeekeeper.Keeper, sk stakingkeeper.Keeper, isFeePayTx *bool) FeeDecorator {
 	return FeeDecorator{
-		BypassMinFeeMsgTypes:            bypassMsgTypes,
-		GlobalFeeKeeper:                 gfk,
-		StakingKeeper:                   sk,
-		MaxTotalBypassMinFeeMsgGasUsage: maxTotalBypassMinFeeMsgGasUsage,
-		IsFeePayTx:                      isFeePayTx,
+		GlobalFeeKeeper: gfk,
+		StakingKeeper:   sk,
+		IsFeePayTx:      isFeePayTx,
 	}
 }
 
@@ -52,8 +51,16 @@ func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must implement the sdk.FeeTx interface")
 	}
 
-	// Call next handler if the execution mode is CheckTx, simulation, or if the tx is a fee pay tx
-	if !ctx.IsCheckTx() || simulate || *mfd.IsFeePayTx {
+	// Call next handler if simulating or if the tx is a fee pay tx
+	if simulate || *mfd.IsFeePayTx {
+		return next(ctx, tx, simulate)
+	}
+
+	params := mfd.GlobalFeeKeeper.GetParams(ctx)
+
+	// Outside of CheckTx the fees are only checked if governance enforces the
+	// global fee at the consensus level
+	if !ctx.IsCheckTx() && !params.EnforceInDeliverTx {
 		return next(ctx, tx, simulate)
 	}
 
@@ -68,8 +75,12 @@ func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
-	// Get local minimum-gas-prices
-	localFees := GetMinGasPrice(ctx, int64(feeTx.GetGas()))
+	// Get local minimum-gas-prices. These are node specific, so they are never
+	// part of the consensus level check
+	localFees := sdk.Coins{}
+	if ctx.IsCheckTx() {
+		localFees = GetMinGasPrice(ctx, int64(feeTx.GetGas()))
+	}
 
 	// CombinedFeeRequirement should never be empty since
 	// global fee is set to its default value, i.e. 0uatom, if empty
@@ -96,13 +107,13 @@ func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	// Accept zero fee transactions only if both of the following statements are true:
 	//
 	// 	- the tx contains only message types that can bypass the minimum fee,
-	//	see BypassMinFeeMsgTypes;
-	//	- the total gas limit per message does not exceed MaxTotalBypassMinFeeMsgGasUsage,
+	//	see the BypassMinFeeMsgTypes param;
+	//	- the total gas limit per message does not exceed the MaxTotalBypassMinFeeMsgGasUsage param,
 	//	i.e., totalGas <=  MaxTotalBypassMinFeeMsgGasUsage
 	//
 	// Otherwise, minimum fees and global fees are checked to prevent spam.
-	doesNotExceedMaxGasUsage := gas <= mfd.MaxTotalBypassMinFeeMsgGasUsage
-	allowedToBypassMinFee := mfd.ContainsOnlyBypassMinFeeMsgs(msgs) && doesNotExceedMaxGasUsage
+	doesNotExceedMaxGasUsage := gas <= params.MaxTotalBypassMinFeeMsgGasUsage
+	allowedToBypassMinFee := ContainsOnlyBypassMinFeeMsgs(msgs, params.BypassMinFeeMsgTypes) && doesNotExceedMaxGasUsage
 
 	// Either the transaction contains at least one message of a type
 	// that cannot bypass the minimum fee or the total gas limit exceeds
@@ -148,7 +159,8 @@ func (mfd FeeDecorator) GetGlobalFee(ctx sdk.Context, feeTx sdk.FeeTx) (sdk.Coin
 		err                error
 	)
 
-	globalMinGasPrices = mfd.GlobalFeeKeeper.GetParams(ctx).MinimumGasPrices
+	// base gas prices of the fee market if enabled, minimum gas prices otherwise
+	globalMinGasPrices = mfd.GlobalFeeKeeper.GetGlobalMinGasPrices(ctx)
 
 	// global fee is empty set, set global fee to 0uatom
 	if len(globalMinGasPrices) == 0 {
@@ -157,16 +169,30 @@ func (mfd FeeDecorator) GetGlobalFee(ctx sdk.Context, feeTx sdk.FeeTx) (sdk.Coin
 			return sdk.Coins{}, err
 		}
 	}
-	requiredGlobalFees := make(sdk.Coins, len(globalMinGasPrices))
+
+	// The most expensive msg of the tx sets the gas price multiplier
+	multiplier, err := GetMsgsGasPriceMultiplier(feeTx.GetMsgs(), mfd.GlobalFeeKeeper.GetParams(ctx).MsgGasPriceMultipliers)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	return GetRequiredFees(globalMinGasPrices, multiplier, feeTx.GetGas()), nil
+}
+
+// GetRequiredFees returns the fees required for the gas limit at the given gas
+// prices scaled by multiplier, sorted in ascending order.
+func GetRequiredFees(gasPrices sdk.DecCoins, multiplier sdk.Dec, gasLimit uint64) sdk.Coins {
+	requiredFees := make(sdk.Coins, len(gasPrices))
 	// Determine the required fees by multiplying each required minimum gas
-	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-	glDec := sdk.NewDec(int64(feeTx.GetGas()))
-	for i, gp := range globalMinGasPrices {
-		fee := gp.Amount.Mul(glDec)
-		requiredGlobalFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	// price by the multiplier and the gas limit, where
+	// fee = ceil(minGasPrice * multiplier * gasLimit).
+	glDec := sdk.NewDec(int64(gasLimit))
+	for i, gp := range gasPrices {
+		fee := gp.Amount.Mul(multiplier).Mul(glDec)
+		requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
 	}
 
-	return requiredGlobalFees.Sort(), nil
+	return requiredFees.Sort()
 }
 
 func (mfd FeeDecorator) DefaultZeroGlobalFee(ctx sdk.Context) ([]sdk.DecCoin, error) {
@@ -183,10 +209,10 @@ func (mfd FeeDecorator) getBondDenom(ctx sdk.Context) string {
 }
 
 // ContainsOnlyBypassMinFeeMsgs returns true if all the given msgs type are listed
-// in the BypassMinFeeMsgTypes of the FeeDecorator.
-func (mfd FeeDecorator) ContainsOnlyBypassMinFeeMsgs(msgs []sdk.Msg) bool {
+// in bypassMsgTypes.
+func ContainsOnlyBypassMinFeeMsgs(msgs []sdk.Msg, bypassMsgTypes []string) bool {
 	for _, msg := range msgs {
-		if tmstrings.StringInSlice(sdk.MsgTypeURL(msg), mfd.BypassMinFeeMsgTypes) {
+		if tmstrings.StringInSlice(sdk.MsgTypeURL(msg), bypassMsgTypes) {
 			continue
 		}
 		return false
@@ -195,6 +221,58 @@ func (mfd FeeDecorator) ContainsOnlyBypassMinFeeMsgs(msgs []sdk.Msg) bool {
 	return true
 }
 
+// GetMsgsGasPriceMultiplier returns the highest gas price multiplier of the
+// given msgs, recursing into the msgs of authz MsgExec. Msgs without a
+// multiplier in multipliers count as one.
+func GetMsgsGasPriceMultiplier(msgs []sdk.Msg, multipliers []types.MsgGasPriceMultiplier) (sdk.Dec, error) {
+	if len(msgs) == 0 {
+		return sdk.OneDec(), nil
+	}
+
+	var highest sdk.Dec
+	for _, msg := range msgs {
+		multiplier, found := getMsgGasPriceMultiplier(msg, multipliers)
+
+		// Check if an authz message, and recursively call this function with
+		// the inner messages. An unset multiplier of the MsgExec itself does not
+		// count, so it is priced by the messages it executes.
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			innerMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return sdk.Dec{}, err
+			}
+
+			innerMultiplier, err := GetMsgsGasPriceMultiplier(innerMsgs, multipliers)
+			if err != nil {
+				return sdk.Dec{}, err
+			}
+
+			if !found || innerMultiplier.GT(multiplier) {
+				multiplier = innerMultiplier
+			}
+		}
+
+		if highest.IsNil() || multiplier.GT(highest) {
+			highest = multiplier
+		}
+	}
+
+	return highest, nil
+}
+
+// getMsgGasPriceMultiplier returns the gas price multiplier set for the msg
+// type, or one if none is set.
+func getMsgGasPriceMultiplier(msg sdk.Msg, multipliers []types.MsgGasPriceMultiplier) (sdk.Dec, bool) {
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	for _, m := range multipliers {
+		if m.MsgTypeUrl == msgTypeURL {
+			return m.Multiplier, true
+		}
+	}
+
+	return sdk.OneDec(), false
+}
+
 // GetMinGasPrice returns the validator's minimum gas prices
 // fees given a gas limit
 func GetMinGasPrice(ctx sdk.Context, gasLimit int64) sdk.Coins {
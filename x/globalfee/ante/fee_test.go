@@ -0,0 +1,268 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/CosmosContracts/juno/v26/app"
+	"github.com/CosmosContracts/juno/v26/x/globalfee/ante"
+	"github.com/CosmosContracts/juno/v26/x/globalfee/types"
+)
+
+var emptyAnte = func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+type mockFeeTx struct {
+	msgs []sdk.Msg
+	fee  sdk.Coins
+}
+
+func (tx mockFeeTx) GetGas() uint64                        { return 200_000 }
+func (tx mockFeeTx) GetFee() sdk.Coins                     { return tx.fee }
+func (tx mockFeeTx) FeePayer() sdk.AccAddress              { return nil }
+func (tx mockFeeTx) FeeGranter() sdk.AccAddress            { return nil }
+func (tx mockFeeTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx mockFeeTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx mockFeeTx) ValidateBasic() error                  { return nil }
+
+func TestFeeDecoratorDeliverTx(t *testing.T) {
+	junoApp := app.Setup(t)
+	baseCtx := junoApp.BaseApp.NewContext(false, tmproto.Header{ChainID: "testing"})
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	sendMsg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins())
+	bypassMsg := banktypes.NewMsgMultiSend(nil, nil)
+
+	// 0.1ujuno * 200_000 gas
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("ujuno", sdk.NewDecWithPrec(1, 1)))
+	enoughFee := sdk.NewCoins(sdk.NewInt64Coin("ujuno", 20_000))
+	lowFee := sdk.NewCoins(sdk.NewInt64Coin("ujuno", 19_999))
+
+	testCases := []struct {
+		name         string
+		checkTx      bool
+		simulate     bool
+		feePayTx     bool
+		enforce      bool
+		localMin     sdk.DecCoins
+		maxBypassGas uint64
+		multipliers  []types.MsgGasPriceMultiplier
+		tx           mockFeeTx
+		expectErr    bool
+	}{
+		{
+			name:      "CheckTx: insufficient fee is rejected",
+			checkTx:   true,
+			tx:        mockFeeTx{msgs: []sdk.Msg{sendMsg}, fee: lowFee},
+			expectErr: true,
+		},
+		{
+			name:    "CheckTx: sufficient fee is accepted",
+			checkTx: true,
+			tx:      mockFeeTx{msgs: []sdk.Msg{sendMsg}, fee: enoughFee},
+		},
+		{
+			name:      "CheckTx: local min gas prices apply",
+			checkTx:   true,
+			localMin:  sdk.NewDecCoins(sdk.NewDecCoinFromDec("ujuno", sdk.NewDecWithPrec(2, 1))),
+			tx:        mockFeeTx{msgs: []sdk.Msg{sendMsg}, fee: enoughFee},
+			expectErr: true,
+		},
+		{
+			name: "DeliverTx: insufficient fee is accepted when not enforced",
+			tx:   mockFeeTx{msgs: []sdk.Msg{sendMsg}, fee: lowFee},
+		},
+		{
+			name:      "DeliverTx: insufficient fee is rejected when enforced",
+			enforce:   true,
+			tx:        mockFeeTx{msgs: []sdk.Msg{sendMsg}, fee: lowFee},
+			expectErr: true,
+		},
+		{
+			name:    "DeliverTx: sufficient fee is accepted when enforced",
+			enforce: true,
+			tx:      mockFeeTx{msgs: []sdk.Msg{sendMsg}, fee: enoughFee},
+		},
+		{
+			name:     "DeliverTx: local min gas prices are ignored when enforced",
+			enforce:  true,
+			localMin: sdk.NewDecCoins(sdk.NewDecCoinFromDec("ujuno", sdk.NewDecWithPrec(2, 1))),
+			tx:       mockFeeTx{msgs: []sdk.Msg{sendMsg}, fee: enoughFee},
+		},
+		{
+			name:    "DeliverTx: bypass msgs are accepted without fee when enforced",
+			enforce: true,
+			tx:      mockFeeTx{msgs: []sdk.Msg{bypassMsg}},
+		},
+		{
+			name:      "DeliverTx: bypass msgs mixed with other msgs are rejected when enforced",
+			enforce:   true,
+			tx:        mockFeeTx{msgs: []sdk.Msg{bypassMsg, sendMsg}},
+			expectErr: true,
+		},
+		{
+			name:    "CheckTx: bypass msgs are accepted without fee",
+			checkTx: true,
+			tx:      mockFeeTx{msgs: []sdk.Msg{bypassMsg}},
+		},
+		{
+			name:         "CheckTx: bypass msgs above the max gas usage are rejected",
+			checkTx:      true,
+			maxBypassGas: 100_000,
+			tx:           mockFeeTx{msgs: []sdk.Msg{bypassMsg}},
+			expectErr:    true,
+		},
+		{
+			name:        "CheckTx: msg gas price multiplier raises the required fee",
+			checkTx:     true,
+			multipliers: []types.MsgGasPriceMultiplier{{MsgTypeUrl: sdk.MsgTypeURL(sendMsg), Multiplier: sdk.NewDec(2)}},
+			tx:          mockFeeTx{msgs: []sdk.Msg{sendMsg}, fee: enoughFee},
+			expectErr:   true,
+		},
+		{
+			name:        "CheckTx: msg gas price multiplier lowers the required fee",
+			checkTx:     true,
+			multipliers: []types.MsgGasPriceMultiplier{{MsgTypeUrl: sdk.MsgTypeURL(sendMsg), Multiplier: sdk.NewDecWithPrec(5, 1)}},
+			tx:          mockFeeTx{msgs: []sdk.Msg{sendMsg}, fee: sdk.NewCoins(sdk.NewInt64Coin("ujuno", 10_000))},
+		},
+		{
+			name:        "DeliverTx: msg gas price multiplier applies when enforced",
+			enforce:     true,
+			multipliers: []types.MsgGasPriceMultiplier{{MsgTypeUrl: sdk.MsgTypeURL(sendMsg), Multiplier: sdk.NewDec(2)}},
+			tx:          mockFeeTx{msgs: []sdk.Msg{sendMsg}, fee: sdk.NewCoins(sdk.NewInt64Coin("ujuno", 40_000))},
+		},
+		{
+			name:     "DeliverTx: simulation is not checked",
+			simulate: true,
+			enforce:  true,
+			tx:       mockFeeTx{msgs: []sdk.Msg{sendMsg}, fee: lowFee},
+		},
+		{
+			name:     "DeliverTx: fee pay txs are not checked",
+			feePayTx: true,
+			enforce:  true,
+			tx:       mockFeeTx{msgs: []sdk.Msg{sendMsg}, fee: lowFee},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := baseCtx.CacheContext()
+			ctx = ctx.WithIsCheckTx(tc.checkTx).WithMinGasPrices(tc.localMin)
+
+			maxBypassGas := tc.maxBypassGas
+			if maxBypassGas == 0 {
+				maxBypassGas = 1_000_000
+			}
+			err := junoApp.AppKeepers.GlobalFeeKeeper.SetParams(ctx, types.Params{
+				MinimumGasPrices:                minGasPrices,
+				EnforceInDeliverTx:              tc.enforce,
+				BypassMinFeeMsgTypes:            []string{sdk.MsgTypeURL(bypassMsg)},
+				MaxTotalBypassMinFeeMsgGasUsage: maxBypassGas,
+				MsgGasPriceMultipliers:          tc.multipliers,
+			})
+			require.NoError(t, err)
+
+			isFeePayTx := tc.feePayTx
+			decorator := ante.NewFeeDecorator(
+				junoApp.AppKeepers.GlobalFeeKeeper,
+				*junoApp.AppKeepers.StakingKeeper,
+				&isFeePayTx,
+			)
+
+			_, err = decorator.AnteHandle(ctx, tc.tx, tc.simulate, emptyAnte)
+			if tc.expectErr {
+				require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetMsgsGasPriceMultiplier(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	sendMsg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins())
+	multiSendMsg := banktypes.NewMsgMultiSend(nil, nil)
+	execSendMsg := authz.NewMsgExec(addr, []sdk.Msg{sendMsg})
+	execExecSendMsg := authz.NewMsgExec(addr, []sdk.Msg{&execSendMsg})
+
+	multipliers := []types.MsgGasPriceMultiplier{
+		{MsgTypeUrl: sdk.MsgTypeURL(sendMsg), Multiplier: sdk.NewDec(3)},
+		{MsgTypeUrl: sdk.MsgTypeURL(multiSendMsg), Multiplier: sdk.NewDecWithPrec(5, 1)},
+	}
+
+	testCases := []struct {
+		name        string
+		msgs        []sdk.Msg
+		multipliers []types.MsgGasPriceMultiplier
+		expected    sdk.Dec
+	}{
+		{
+			name:     "no msgs",
+			expected: sdk.OneDec(),
+		},
+		{
+			name:     "no multipliers set",
+			msgs:     []sdk.Msg{sendMsg},
+			expected: sdk.OneDec(),
+		},
+		{
+			name:        "single msg",
+			msgs:        []sdk.Msg{multiSendMsg},
+			multipliers: multipliers,
+			expected:    sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			name:        "most expensive msg is used",
+			msgs:        []sdk.Msg{multiSendMsg, sendMsg},
+			multipliers: multipliers,
+			expected:    sdk.NewDec(3),
+		},
+		{
+			name:        "msgs without multiplier count as one",
+			msgs:        []sdk.Msg{multiSendMsg, banktypes.NewMsgSetSendEnabled("authority", nil, nil)},
+			multipliers: multipliers,
+			expected:    sdk.OneDec(),
+		},
+		{
+			name:        "authz exec msgs are priced by their inner msgs",
+			msgs:        []sdk.Msg{&execSendMsg},
+			multipliers: multipliers,
+			expected:    sdk.NewDec(3),
+		},
+		{
+			name:        "nested authz exec msgs are priced by their inner msgs",
+			msgs:        []sdk.Msg{&execExecSendMsg},
+			multipliers: multipliers,
+			expected:    sdk.NewDec(3),
+		},
+		{
+			name: "authz exec multiplier applies when higher than its inner msgs",
+			msgs: []sdk.Msg{&execSendMsg},
+			multipliers: append([]types.MsgGasPriceMultiplier{
+				{MsgTypeUrl: sdk.MsgTypeURL(&execSendMsg), Multiplier: sdk.NewDec(4)},
+			}, multipliers...),
+			expected: sdk.NewDec(4),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			multiplier, err := ante.GetMsgsGasPriceMultiplier(tc.msgs, tc.multipliers)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.String(), multiplier.String())
+		})
+	}
+}
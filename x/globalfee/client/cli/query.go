@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 
 	"github.com/CosmosContracts/juno/v26/x/globalfee/types"
 )
@@ -19,6 +20,10 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		GetCmdShowMinimumGasPrices(),
+		GetCmdShowParams(),
+		GetCmdShowBaseGasPrices(),
+		GetCmdShowPricedDenomRates(),
+		GetCmdEstimateFee(),
 	)
 	return queryCmd
 }
@@ -47,3 +52,109 @@ func GetCmdShowMinimumGasPrices() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetCmdShowParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Show the global fee module params",
+		Long:  "Show the global fee module params, including the msg types that bypass the minimum fee",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdShowBaseGasPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-gas-prices",
+		Short: "Show the gas prices currently required by the global fee",
+		Long:  "Show the fee market base gas prices, or the minimum gas prices when the fee market is disabled",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BaseGasPrices(cmd.Context(), &types.QueryBaseGasPricesRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdShowPricedDenomRates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "priced-denom-rates",
+		Short: "Show the conversion rates of the priced fee denoms",
+		Long:  "Show the last conversion rates to the bond denom of the fee denoms priced by the price source contract",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PricedDenomRates(cmd.Context(), &types.QueryPricedDenomRatesRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdEstimateFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-fee [tx-json-file]",
+		Short: "Estimate the fee of a tx",
+		Long:  "Estimate the required fees of a tx, whether a fee pay contract would sponsor it, and the fee share payouts of the contracts it executes",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EstimateFee(cmd.Context(), &types.QueryEstimateFeeRequest{TxBytes: txBytes})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
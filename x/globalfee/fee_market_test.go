@@ -0,0 +1,211 @@
+package globalfee
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	globalfeekeeper "github.com/CosmosContracts/juno/v26/x/globalfee/keeper"
+	"github.com/CosmosContracts/juno/v26/x/globalfee/types"
+)
+
+func TestFeeMarketEndBlock(t *testing.T) {
+	minGasPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("ALX", sdk.OneDec()),
+		sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 3)),
+	)
+	feeMarket := &types.FeeMarketParams{
+		Enabled:                true,
+		TargetBlockUtilization: sdk.NewDecWithPrec(5, 1),
+		MaxChangeRate:          sdk.NewDecWithPrec(125, 3),
+		MaxGasPrices:           sdk.NewDecCoins(sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(12, 1))),
+	}
+
+	specs := map[string]struct {
+		feeMarket     *types.FeeMarketParams
+		basePrices    sdk.DecCoins
+		blockGasMeter sdk.GasMeter
+		gasUsed       uint64
+		expPrices     sdk.DecCoins
+	}{
+		"fee market disabled": {
+			basePrices:    sdk.NewDecCoins(sdk.NewDecCoinFromDec("ALX", sdk.NewDec(2))),
+			blockGasMeter: sdk.NewGasMeter(1_000_000),
+			gasUsed:       1_000_000,
+			expPrices:     minGasPrices,
+		},
+		"full block raises the prices by the max change rate": {
+			feeMarket:     feeMarket,
+			blockGasMeter: sdk.NewGasMeter(1_000_000),
+			gasUsed:       1_000_000,
+			expPrices: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(1125, 3)),
+				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1125, 6)),
+			),
+		},
+		"block above target raises the prices proportionally": {
+			feeMarket:     feeMarket,
+			blockGasMeter: sdk.NewGasMeter(1_000_000),
+			gasUsed:       750_000,
+			expPrices: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(10625, 4)),
+				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(10625, 7)),
+			),
+		},
+		"block at target keeps the prices": {
+			feeMarket:     feeMarket,
+			basePrices:    sdk.NewDecCoins(sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(11, 1))),
+			blockGasMeter: sdk.NewGasMeter(1_000_000),
+			gasUsed:       500_000,
+			expPrices: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(11, 1)),
+				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 3)),
+			),
+		},
+		"empty block lowers the prices by the max change rate": {
+			feeMarket:     feeMarket,
+			basePrices:    sdk.NewDecCoins(sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(12, 1))),
+			blockGasMeter: sdk.NewGasMeter(1_000_000),
+			expPrices: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(105, 2)),
+				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 3)),
+			),
+		},
+		"prices are capped by the max gas prices": {
+			feeMarket:     feeMarket,
+			basePrices:    sdk.NewDecCoins(sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(115, 2))),
+			blockGasMeter: sdk.NewGasMeter(1_000_000),
+			gasUsed:       1_000_000,
+			expPrices: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(12, 1)),
+				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1125, 6)),
+			),
+		},
+		"prices of removed denoms are dropped": {
+			feeMarket:     feeMarket,
+			basePrices:    sdk.NewDecCoins(sdk.NewDecCoinFromDec("CLX", sdk.NewDec(5))),
+			blockGasMeter: sdk.NewGasMeter(1_000_000),
+			gasUsed:       500_000,
+			expPrices:     minGasPrices,
+		},
+		"no block gas limit targets the target block gas": {
+			feeMarket: &types.FeeMarketParams{
+				Enabled:                true,
+				TargetBlockUtilization: sdk.NewDecWithPrec(5, 1),
+				MaxChangeRate:          sdk.NewDecWithPrec(125, 3),
+				TargetBlockGas:         500_000,
+			},
+			blockGasMeter: sdk.NewInfiniteGasMeter(),
+			gasUsed:       1_000_000,
+			expPrices: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(1125, 3)),
+				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1125, 6)),
+			),
+		},
+		"no block gas limit nor target block gas keeps the prices": {
+			feeMarket:     feeMarket,
+			basePrices:    sdk.NewDecCoins(sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(11, 1))),
+			blockGasMeter: sdk.NewInfiniteGasMeter(),
+			gasUsed:       1_000_000,
+			expPrices: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(11, 1)),
+				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 3)),
+			),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, encCfg, keeper := setupTestStore(t)
+			require.NoError(t, keeper.SetParams(ctx, types.Params{
+				MinimumGasPrices: minGasPrices,
+				FeeMarket:        spec.feeMarket,
+			}))
+			for _, basePrice := range spec.basePrices {
+				keeper.SetBaseGasPrice(ctx, basePrice.Denom, basePrice.Amount)
+			}
+
+			spec.blockGasMeter.ConsumeGas(spec.gasUsed, "block")
+			ctx = ctx.WithBlockGasMeter(spec.blockGasMeter)
+
+			m := NewAppModule(encCfg.Marshaler, keeper, "stake")
+			m.EndBlock(ctx, abci.RequestEndBlock{})
+
+			assert.Equal(t, spec.expPrices, keeper.GetGlobalMinGasPrices(ctx))
+			if spec.feeMarket == nil {
+				assert.Empty(t, keeper.GetBaseGasPrices(ctx))
+			}
+		})
+	}
+}
+
+func TestFeeMarketRequiresTargetGas(t *testing.T) {
+	ctx, _, keeper := setupTestStore(t)
+	msgServer := globalfeekeeper.NewMsgServerImpl(keeper)
+	authority := keeper.GetAuthority()
+
+	params := types.Params{
+		MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("ALX", sdk.OneDec())),
+		FeeMarket: &types.FeeMarketParams{
+			Enabled:                true,
+			TargetBlockUtilization: sdk.NewDecWithPrec(5, 1),
+			MaxChangeRate:          sdk.NewDecWithPrec(125, 3),
+		},
+	}
+
+	// the fee market can be enabled with a block gas limit
+	ctx = ctx.WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 100_000_000}})
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+
+	// without one, it requires a target block gas
+	for _, maxGas := range []int64{-1, 0} {
+		ctx = ctx.WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: maxGas}})
+		_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		require.Error(t, err, "max gas %d", maxGas)
+	}
+
+	params.FeeMarket.TargetBlockGas = 50_000_000
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+}
+
+func TestFeeMarketGlobalMinGasPrices(t *testing.T) {
+	ctx, encCfg, keeper := setupTestStore(t)
+	minGasPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("ALX", sdk.OneDec()),
+		sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 3)),
+	)
+	params := types.Params{
+		MinimumGasPrices: minGasPrices,
+		FeeMarket: &types.FeeMarketParams{
+			Enabled:                true,
+			TargetBlockUtilization: sdk.NewDecWithPrec(5, 1),
+			MaxChangeRate:          sdk.NewDecWithPrec(125, 3),
+		},
+	}
+	require.NoError(t, keeper.SetParams(ctx, params))
+	keeper.SetBaseGasPrice(ctx, "ALX", sdk.NewDec(2))
+
+	// denoms without a base gas price fall back to the minimum gas price
+	expPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("ALX", sdk.NewDec(2)),
+		sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 3)),
+	)
+	assert.Equal(t, expPrices, keeper.GetGlobalMinGasPrices(ctx))
+
+	q := NewGrpcQuerier(encCfg.Marshaler, keeper)
+	gotResp, err := q.BaseGasPrices(sdk.WrapSDKContext(ctx), nil)
+	require.NoError(t, err)
+	assert.Equal(t, expPrices, gotResp.BaseGasPrices)
+
+	// the minimum gas prices apply once the fee market is disabled
+	params.FeeMarket.Enabled = false
+	require.NoError(t, keeper.SetParams(ctx, params))
+	assert.Equal(t, minGasPrices, keeper.GetGlobalMinGasPrices(ctx))
+}
@@ -23,7 +23,7 @@ import (
 func TestDefaultGenesis(t *testing.T) {
 	encCfg := appparams.MakeEncodingConfig()
 	gotJSON := AppModuleBasic{}.DefaultGenesis(encCfg.Marshaler)
-	assert.JSONEq(t, `{"params":{"minimum_gas_prices":[]}}`, string(gotJSON), string(gotJSON))
+	assert.JSONEq(t, `{"params":{"minimum_gas_prices":[],"enforce_in_deliver_tx":false,"bypass_min_fee_msg_types":["/ibc.core.channel.v1.MsgRecvPacket","/ibc.core.channel.v1.MsgAcknowledgement","/ibc.core.client.v1.MsgCreateClient","/ibc.core.client.v1.MsgUpdateClient","/ibc.core.client.v1.MsgSubmitMisbehaviour","/ibc.core.client.v1.MsgUpgradeClient","/ibc.applications.transfer.v1.MsgTransfer","/ibc.core.channel.v1.MsgTimeout","/ibc.core.channel.v1.MsgTimeoutOnClose","/ibc.core.channel.v1.MsgChannelOpenTry","/ibc.core.channel.v1.MsgChannelOpenConfirm","/ibc.core.channel.v1.MsgChannelOpenAck"],"max_total_bypass_min_fee_msg_gas_usage":"2000000","fee_market":null,"msg_gas_price_multipliers":[],"priced_denoms":null},"base_gas_prices":[],"priced_denom_rates":[]}`, string(gotJSON), string(gotJSON))
 }
 
 func TestValidateGenesis(t *testing.T) {
@@ -41,6 +41,53 @@ func TestValidateGenesis(t *testing.T) {
 		"minimum not set": {
 			src: `{"params":{}}`,
 		},
+		"enforced in deliver tx": {
+			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"enforce_in_deliver_tx":true}}`,
+		},
+		"bypass msg types": {
+			src: `{"params":{"minimum_gas_prices":[],"bypass_min_fee_msg_types":["/ibc.core.client.v1.MsgUpdateClient"],"max_total_bypass_min_fee_msg_gas_usage":"1000000"}}`,
+		},
+		"bypass msg type without leading slash not allowed": {
+			src:    `{"params":{"minimum_gas_prices":[],"bypass_min_fee_msg_types":["ibc.core.client.v1.MsgUpdateClient"]}}`,
+			expErr: true,
+		},
+		"duplicate bypass msg types not allowed": {
+			src:    `{"params":{"minimum_gas_prices":[],"bypass_min_fee_msg_types":["/ibc.core.client.v1.MsgUpdateClient","/ibc.core.client.v1.MsgUpdateClient"]}}`,
+			expErr: true,
+		},
+		"fee market": {
+			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"fee_market":{"enabled":true,"target_block_utilization":"0.5","max_change_rate":"0.125","max_gas_prices":[{"denom":"ALX", "amount":"10"}]}},"base_gas_prices":[{"denom":"ALX", "amount":"2"}]}`,
+		},
+		"fee market max gas price below minimum not allowed": {
+			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"fee_market":{"enabled":true,"target_block_utilization":"0.5","max_change_rate":"0.125","max_gas_prices":[{"denom":"ALX", "amount":"0.5"}]}}}`,
+			expErr: true,
+		},
+		"fee market without change rate not allowed": {
+			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"fee_market":{"enabled":true,"target_block_utilization":"0.5"}}}`,
+			expErr: true,
+		},
+		"msg gas price multipliers": {
+			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"msg_gas_price_multipliers":[{"msg_type_url":"/cosmos.gov.v1.MsgVote","multiplier":"0.5"}]}}`,
+		},
+		"negative msg gas price multiplier not allowed": {
+			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"msg_gas_price_multipliers":[{"msg_type_url":"/cosmos.gov.v1.MsgVote","multiplier":"-1"}]}}`,
+			expErr: true,
+		},
+		"priced denom rates": {
+			src: `{"params":{},"priced_denom_rates":[{"denom":"ALX","rate":"2","updated_at":"1000"}]}`,
+		},
+		"zero priced denom rate not allowed": {
+			src:    `{"params":{},"priced_denom_rates":[{"denom":"ALX","rate":"0","updated_at":"1000"}]}`,
+			expErr: true,
+		},
+		"duplicate priced denom rates not allowed": {
+			src:    `{"params":{},"priced_denom_rates":[{"denom":"ALX","rate":"2","updated_at":"1000"},{"denom":"ALX","rate":"3","updated_at":"1000"}]}`,
+			expErr: true,
+		},
+		"unsorted base gas prices not allowed": {
+			src:    `{"params":{},"base_gas_prices":[{"denom":"ZLX", "amount":"1"},{"denom":"ALX", "amount":"2"}]}`,
+			expErr: true,
+		},
 		"zero amount allowed": {
 			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"0"}]}}`,
 			expErr: false,
@@ -81,16 +128,61 @@ func TestInitExportGenesis(t *testing.T) {
 	}{
 		"single fee": {
 			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}]}}`,
-			exp: types.GenesisState{Params: types.Params{MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)))}},
+			exp: types.GenesisState{Params: types.Params{
+				MinimumGasPrices:       sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1))),
+				BypassMinFeeMsgTypes:   []string{},
+				MsgGasPriceMultipliers: []types.MsgGasPriceMultiplier{},
+			}, BaseGasPrices: sdk.DecCoins{}, PricedDenomRates: []types.PricedDenomRate{}},
 		},
 		"multiple fee options": {
 			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}, {"denom":"BLX", "amount":"0.001"}]}}`,
-			exp: types.GenesisState{Params: types.Params{MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)),
-				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 3)))}},
+			exp: types.GenesisState{Params: types.Params{
+				MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)),
+					sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 3))),
+				BypassMinFeeMsgTypes:   []string{},
+				MsgGasPriceMultipliers: []types.MsgGasPriceMultiplier{},
+			}, BaseGasPrices: sdk.DecCoins{}, PricedDenomRates: []types.PricedDenomRate{}},
+		},
+		"bypass msgs set": {
+			src: `{"params":{"minimum_gas_prices":[],"bypass_min_fee_msg_types":["/ibc.core.client.v1.MsgUpdateClient"],"max_total_bypass_min_fee_msg_gas_usage":"1000000"}}`,
+			exp: types.GenesisState{Params: types.Params{
+				MinimumGasPrices:                sdk.DecCoins{},
+				BypassMinFeeMsgTypes:            []string{"/ibc.core.client.v1.MsgUpdateClient"},
+				MaxTotalBypassMinFeeMsgGasUsage: 1_000_000,
+				MsgGasPriceMultipliers:          []types.MsgGasPriceMultiplier{},
+			}, BaseGasPrices: sdk.DecCoins{}, PricedDenomRates: []types.PricedDenomRate{}},
+		},
+		"fee market set": {
+			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"fee_market":{"enabled":true,"target_block_utilization":"0.5","max_change_rate":"0.125","max_gas_prices":[{"denom":"ALX", "amount":"10"}]}},"base_gas_prices":[{"denom":"ALX", "amount":"2"}]}`,
+			exp: types.GenesisState{Params: types.Params{
+				MinimumGasPrices:     sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1))),
+				BypassMinFeeMsgTypes: []string{},
+				FeeMarket: &types.FeeMarketParams{
+					Enabled:                true,
+					TargetBlockUtilization: sdk.NewDecWithPrec(5, 1),
+					MaxChangeRate:          sdk.NewDecWithPrec(125, 3),
+					MaxGasPrices:           sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(10))),
+				},
+				MsgGasPriceMultipliers: []types.MsgGasPriceMultiplier{},
+			}, BaseGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2))), PricedDenomRates: []types.PricedDenomRate{}},
+		},
+		"msg gas price multipliers set": {
+			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"msg_gas_price_multipliers":[{"msg_type_url":"/cosmos.gov.v1.MsgVote","multiplier":"0.5"}]}}`,
+			exp: types.GenesisState{Params: types.Params{
+				MinimumGasPrices:     sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1))),
+				BypassMinFeeMsgTypes: []string{},
+				MsgGasPriceMultipliers: []types.MsgGasPriceMultiplier{
+					{MsgTypeUrl: "/cosmos.gov.v1.MsgVote", Multiplier: sdk.NewDecWithPrec(5, 1)},
+				},
+			}, BaseGasPrices: sdk.DecCoins{}, PricedDenomRates: []types.PricedDenomRate{}},
 		},
 		"no fee set": {
 			src: `{"params":{}}`,
-			exp: types.GenesisState{Params: types.Params{MinimumGasPrices: sdk.DecCoins{}}},
+			exp: types.GenesisState{Params: types.Params{
+				MinimumGasPrices:       sdk.DecCoins{},
+				BypassMinFeeMsgTypes:   []string{},
+				MsgGasPriceMultipliers: []types.MsgGasPriceMultiplier{},
+			}, BaseGasPrices: sdk.DecCoins{}, PricedDenomRates: []types.PricedDenomRate{}},
 		},
 	}
 	for name, spec := range specs {
@@ -108,6 +200,11 @@ func TestInitExportGenesis(t *testing.T) {
 }
 
 func setupTestStore(t *testing.T) (sdk.Context, appparams.EncodingConfig, globalfeekeeper.Keeper) {
+	t.Helper()
+	return setupTestStoreWithWasm(t, nil)
+}
+
+func setupTestStoreWithWasm(t *testing.T, wk types.WasmViewKeeper) (sdk.Context, appparams.EncodingConfig, globalfeekeeper.Keeper) {
 	t.Helper()
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -119,7 +216,7 @@ func setupTestStore(t *testing.T) (sdk.Context, appparams.EncodingConfig, global
 	// ms.MountStoreWithDB(tkeyParams, storetypes.StoreTypeTransient, db)
 	require.NoError(t, ms.LoadLatestVersion())
 
-	globalfeeKeeper := globalfeekeeper.NewKeeper(encCfg.Marshaler, keyParams, "juno1jv65s3grqf6v6jl3dp4t6c9t9rk99cd83d88wr")
+	globalfeeKeeper := globalfeekeeper.NewKeeper(encCfg.Marshaler, keyParams, wk, nil, nil, "stake", "juno1jv65s3grqf6v6jl3dp4t6c9t9rk99cd83d88wr")
 
 	ctx := sdk.NewContext(ms, tmproto.Header{
 		Height:  1234567,
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/globalfee/types"
+)
+
+// GetSponsoringFeePayContract returns the fee pay contract that would cover the
+// fee of the tx, using the same eligibility check as the fee pay route of the
+// ante handler.
+func (k Keeper) GetSponsoringFeePayContract(ctx sdk.Context, feeTx sdk.FeeTx) (string, bool) {
+	if k.feePayKeeper == nil {
+		return "", false
+	}
+
+	feepayContract, _, err := k.feePayKeeper.GetSponsoringContract(ctx, feeTx, k.GetGlobalMinGasPrices(ctx))
+	if err != nil {
+		return "", false
+	}
+
+	return feepayContract.ContractAddress, true
+}
+
+// EstimateFeeSharePayouts returns the fee share payout of each contract executed
+// by the msgs, out of the given fees. Contracts executed indirectly, e.g. through
+// sub-messages, are not known before the tx runs and are not included.
+func (k Keeper) EstimateFeeSharePayouts(ctx sdk.Context, fees sdk.Coins, msgs []sdk.Msg) ([]types.FeeSharePayout, error) {
+	if k.feeShareKeeper == nil {
+		return nil, nil
+	}
+
+	toPay, contractFees, err := k.feeShareKeeper.FeeSharePayouts(ctx, fees, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// A contract executed by several msgs is paid for each of them
+	payouts := []types.FeeSharePayout{}
+	index := make(map[string]int, len(toPay))
+	for i, share := range toPay {
+		if contractFees[i].IsZero() {
+			continue
+		}
+
+		if j, found := index[share.ContractAddress]; found {
+			payouts[j].Fees = payouts[j].Fees.Add(contractFees[i]...)
+			continue
+		}
+
+		index[share.ContractAddress] = len(payouts)
+		payouts = append(payouts, types.FeeSharePayout{
+			ContractAddress: share.ContractAddress,
+			Fees:            contractFees[i],
+		})
+	}
+
+	return payouts, nil
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/globalfee/types"
+)
+
+// GetBaseGasPrice returns the fee market base gas price of a denom, if set.
+func (k Keeper) GetBaseGasPrice(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BaseGasPricesKeyPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.Dec{}, false
+	}
+
+	var price sdk.Dec
+	if err := price.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return price, true
+}
+
+// SetBaseGasPrice stores the fee market base gas price of a denom.
+func (k Keeper) SetBaseGasPrice(ctx sdk.Context, denom string, price sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BaseGasPricesKeyPrefix)
+	bz, err := price.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set([]byte(denom), bz)
+}
+
+// GetBaseGasPrices returns all the stored fee market base gas prices, sorted
+// by denom.
+func (k Keeper) GetBaseGasPrices(ctx sdk.Context) sdk.DecCoins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BaseGasPricesKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	prices := sdk.DecCoins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var price sdk.Dec
+		if err := price.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		prices = append(prices, sdk.NewDecCoinFromDec(string(iterator.Key()), price))
+	}
+
+	return prices
+}
+
+// ClearBaseGasPrices removes all the stored fee market base gas prices.
+func (k Keeper) ClearBaseGasPrices(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BaseGasPricesKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getFeeMarketGasPrices returns the base gas prices, falling back to the
+// minimum gas price of denoms without a base gas price yet.
+func (k Keeper) getFeeMarketGasPrices(ctx sdk.Context, minGasPrices sdk.DecCoins) sdk.DecCoins {
+	prices := make(sdk.DecCoins, len(minGasPrices))
+	for i, minPrice := range minGasPrices {
+		prices[i] = minPrice
+		if basePrice, found := k.GetBaseGasPrice(ctx, minPrice.Denom); found {
+			prices[i] = sdk.NewDecCoinFromDec(minPrice.Denom, basePrice)
+		}
+	}
+
+	return prices
+}
+
+// UpdateBaseGasPrices moves the fee market base gas prices toward the target
+// block gas utilization, based on the gas consumed by the current block. The
+// base gas prices change by at most the max change rate, and stay between the
+// minimum and the max gas prices.
+func (k Keeper) UpdateBaseGasPrices(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.FeeMarketEnabled() {
+		k.ClearBaseGasPrices(ctx)
+		return
+	}
+
+	blockGasMeter := ctx.BlockGasMeter()
+	if blockGasMeter == nil {
+		return
+	}
+
+	target, found := targetBlockGas(blockGasMeter, params.FeeMarket)
+	if !found {
+		k.Logger(ctx).Error("fee market enabled without a block gas limit or a target block gas, the base gas prices are not adjusted")
+		return
+	}
+
+	// delta is the relative distance to the target, capped to [-1, 1]
+	used := sdk.NewDecFromInt(sdk.NewIntFromUint64(blockGasMeter.GasConsumedToLimit()))
+	delta := used.Sub(target).Quo(target)
+	if delta.GT(sdk.OneDec()) {
+		delta = sdk.OneDec()
+	}
+	multiplier := sdk.OneDec().Add(delta.Mul(params.FeeMarket.MaxChangeRate))
+
+	basePrices := make(map[string]sdk.Dec)
+	for _, basePrice := range k.GetBaseGasPrices(ctx) {
+		basePrices[basePrice.Denom] = basePrice.Amount
+	}
+
+	// Base gas prices of denoms no longer in the minimum gas prices are dropped
+	k.ClearBaseGasPrices(ctx)
+	for _, minPrice := range params.MinimumGasPrices {
+		price, found := basePrices[minPrice.Denom]
+		if !found {
+			price = minPrice.Amount
+		}
+
+		price = price.Mul(multiplier)
+		if price.LT(minPrice.Amount) {
+			price = minPrice.Amount
+		}
+		if maxPrice := params.FeeMarket.MaxGasPrices.AmountOf(minPrice.Denom); maxPrice.IsPositive() && price.GT(maxPrice) {
+			price = maxPrice
+		}
+
+		k.SetBaseGasPrice(ctx, minPrice.Denom, price)
+	}
+}
+
+// targetBlockGas returns the gas a block should use: the target block
+// utilization of the block gas limit, or the target block gas when the block
+// gas limit is unlimited.
+func targetBlockGas(blockGasMeter sdk.GasMeter, feeMarket *types.FeeMarketParams) (sdk.Dec, bool) {
+	var target sdk.Dec
+	if limit := blockGasMeter.Limit(); limit != 0 && limit != math.MaxUint64 {
+		target = sdk.NewDecFromInt(sdk.NewIntFromUint64(limit)).Mul(feeMarket.TargetBlockUtilization)
+	} else {
+		target = sdk.NewDecFromInt(sdk.NewIntFromUint64(feeMarket.TargetBlockGas))
+	}
+
+	return target, target.IsPositive()
+}
+
+// hasBlockGasLimit returns true if the max gas of the block consensus params
+// limits the block gas. Zero and -1 mean unlimited.
+func hasBlockGasLimit(maxGas int64) bool {
+	return maxGas > 0
+}
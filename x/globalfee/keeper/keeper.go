@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"github.com/cometbft/cometbft/libs/log"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,6 +15,11 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 
+	wasmKeeper     types.WasmViewKeeper
+	feePayKeeper   types.FeePayKeeper
+	feeShareKeeper types.FeeShareKeeper
+	bondDenom      string
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -21,15 +28,33 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
+	wk types.WasmViewKeeper,
+	fpk types.FeePayKeeper,
+	fsk types.FeeShareKeeper,
+	bondDenom string,
 	authority string,
 ) Keeper {
 	return Keeper{
-		cdc:       cdc,
-		storeKey:  key,
-		authority: authority,
+		cdc:            cdc,
+		storeKey:       key,
+		wasmKeeper:     wk,
+		feePayKeeper:   fpk,
+		feeShareKeeper: fsk,
+		bondDenom:      bondDenom,
+		authority:      authority,
 	}
 }
 
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetBondDenom returns the bond denom the priced denoms are derived from.
+func (k Keeper) GetBondDenom() string {
+	return k.bondDenom
+}
+
 // GetAuthority returns the x/globalfee module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -59,3 +84,57 @@ func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
 	k.cdc.MustUnmarshal(bz, &p)
 	return p
 }
+
+// GetGlobalMinGasPrices returns the gas prices required by the global fee. When
+// the fee market is enabled these are the base gas prices instead of the
+// minimum gas prices. Priced denoms with a fresh conversion rate are added, or
+// replace their minimum gas price.
+func (k Keeper) GetGlobalMinGasPrices(ctx sdk.Context) sdk.DecCoins {
+	params := k.GetParams(ctx)
+
+	prices := params.MinimumGasPrices
+	if params.FeeMarketEnabled() {
+		prices = k.getFeeMarketGasPrices(ctx, prices)
+	}
+
+	if params.PricedDenoms == nil {
+		return prices
+	}
+
+	// Priced denoms are derived from the bond denom gas price
+	bondGasPrice, found := sdk.Dec{}, false
+	for _, price := range prices {
+		if price.Denom == k.bondDenom {
+			bondGasPrice, found = price.Amount, true
+		}
+	}
+	if !found {
+		return prices
+	}
+
+	pricedGasPrices := k.getPricedDenomGasPrices(ctx, params.PricedDenoms, bondGasPrice)
+	if len(pricedGasPrices) == 0 {
+		return prices
+	}
+
+	merged := make(sdk.DecCoins, 0, len(prices)+len(pricedGasPrices))
+	merged = append(merged, pricedGasPrices...)
+	for _, price := range prices {
+		if containsDenom(pricedGasPrices, price.Denom) {
+			continue
+		}
+		merged = append(merged, price)
+	}
+
+	return merged.Sort()
+}
+
+func containsDenom(coins sdk.DecCoins, denom string) bool {
+	for _, coin := range coins {
+		if coin.Denom == denom {
+			return true
+		}
+	}
+
+	return false
+}
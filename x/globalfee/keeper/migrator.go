@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v2 "github.com/CosmosContracts/juno/v26/x/globalfee/migrations/v2"
+	v3 "github.com/CosmosContracts/juno/v26/x/globalfee/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place state migrations.
@@ -26,3 +27,10 @@ func NewMigrator(k Keeper, bondDenom string) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc, m.bondDenom)
 }
+
+// Migrate2to3 migrates the x/globalfee module state from the consensus version 2
+// to version 3. Specifically, it stores the bypass message settings in the
+// module params.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
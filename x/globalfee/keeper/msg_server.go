@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -30,6 +31,14 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// The base gas prices can only follow the block gas usage against a target
+	if req.Params.FeeMarketEnabled() && req.Params.FeeMarket.TargetBlockGas == 0 {
+		if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil && !hasBlockGasLimit(cp.Block.MaxGas) {
+			return nil, fmt.Errorf("fee market requires a target block gas while the block gas limit is unlimited")
+		}
+	}
+
 	if err := ms.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
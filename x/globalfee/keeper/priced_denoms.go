@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	helpers "github.com/CosmosContracts/juno/v26/app/helpers"
+	"github.com/CosmosContracts/juno/v26/x/globalfee/types"
+)
+
+// GetPricedDenomRate returns the last conversion rate of a priced denom, if set.
+func (k Keeper) GetPricedDenomRate(ctx sdk.Context, denom string) (types.PricedDenomRate, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PricedDenomRatesKeyPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.PricedDenomRate{}, false
+	}
+
+	var rate types.PricedDenomRate
+	k.cdc.MustUnmarshal(bz, &rate)
+	return rate, true
+}
+
+// SetPricedDenomRate stores the conversion rate of a priced denom.
+func (k Keeper) SetPricedDenomRate(ctx sdk.Context, rate types.PricedDenomRate) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PricedDenomRatesKeyPrefix)
+	store.Set([]byte(rate.Denom), k.cdc.MustMarshal(&rate))
+}
+
+// GetPricedDenomRates returns the conversion rates of all the priced denoms,
+// sorted by denom.
+func (k Keeper) GetPricedDenomRates(ctx sdk.Context) []types.PricedDenomRate {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PricedDenomRatesKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	rates := []types.PricedDenomRate{}
+	for ; iterator.Valid(); iterator.Next() {
+		var rate types.PricedDenomRate
+		k.cdc.MustUnmarshal(iterator.Value(), &rate)
+		rates = append(rates, rate)
+	}
+
+	return rates
+}
+
+// DeletePricedDenomRate removes the conversion rate of a priced denom.
+func (k Keeper) DeletePricedDenomRate(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PricedDenomRatesKeyPrefix)
+	store.Delete([]byte(denom))
+}
+
+// UpdatePricedDenomRates queries the conversion rate of every priced denom
+// from the price source contract, every UpdateInterval blocks. A failed query
+// keeps the previous rate, which eventually turns stale. Rates of denoms that
+// are no longer priced are removed.
+func (k Keeper) UpdatePricedDenomRates(ctx sdk.Context) {
+	params := k.GetParams(ctx).PricedDenoms
+
+	priced := make(map[string]bool)
+	if params != nil {
+		for _, denom := range params.Denoms {
+			priced[denom] = true
+		}
+	}
+	for _, rate := range k.GetPricedDenomRates(ctx) {
+		if !priced[rate.Denom] {
+			k.DeletePricedDenomRate(ctx, rate.Denom)
+		}
+	}
+
+	if params == nil || !params.IsUpdateHeight(ctx.BlockHeight()) {
+		return
+	}
+
+	contractAddr := sdk.MustAccAddressFromBech32(params.PriceSourceContract)
+	for _, denom := range params.Denoms {
+		rate, err := k.queryConversionRate(ctx, contractAddr, denom, params.QueryGasLimit)
+		if err != nil {
+			k.Logger(ctx).Error("failed to query conversion rate", "denom", denom, "contract", params.PriceSourceContract, "error", err)
+			continue
+		}
+
+		k.SetPricedDenomRate(ctx, rate)
+	}
+}
+
+// queryConversionRate queries the conversion rate of a denom with a gas capped
+// smart query, recovering from out of gas panics.
+func (k Keeper) queryConversionRate(ctx sdk.Context, contractAddr sdk.AccAddress, denom string, gasLimit uint64) (rate types.PricedDenomRate, err error) {
+	defer func() {
+		if recoveryError := recover(); recoveryError != nil {
+			if isOutOfGas, msg := helpers.IsOutOfGasError(recoveryError); isOutOfGas {
+				err = helpers.ErrOutOfGas.Wrapf("%s", msg)
+			} else {
+				err = helpers.ErrContractExecutionPanic.Wrapf("%s", recoveryError)
+			}
+		}
+	}()
+
+	req, err := types.NewConversionRateQuery(denom)
+	if err != nil {
+		return rate, err
+	}
+
+	childCtx, _ := ctx.WithGasMeter(sdk.NewGasMeter(gasLimit)).CacheContext()
+	bz, err := k.wasmKeeper.QuerySmart(childCtx, contractAddr, req)
+	if err != nil {
+		return rate, err
+	}
+
+	var res types.ConversionRateResponse
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return rate, err
+	}
+
+	rate = types.NewPricedDenomRate(denom, res.Rate, res.UpdatedAt)
+	return rate, rate.Validate()
+}
+
+// getPricedDenomGasPrices returns the minimum gas prices of the priced denoms
+// with a fresh conversion rate, derived from the given bond denom gas price.
+func (k Keeper) getPricedDenomGasPrices(ctx sdk.Context, params *types.PricedDenomsParams, bondGasPrice sdk.Dec) sdk.DecCoins {
+	prices := sdk.DecCoins{}
+	for _, denom := range params.Denoms {
+		rate, found := k.GetPricedDenomRate(ctx, denom)
+		if !found || rate.IsStale(ctx.BlockTime().Unix(), params.MaxRateAge) {
+			continue
+		}
+
+		prices = append(prices, sdk.NewDecCoinFromDec(denom, bondGasPrice.Quo(rate.Rate)))
+	}
+
+	return prices
+}
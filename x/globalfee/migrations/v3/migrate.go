@@ -0,0 +1,41 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/globalfee/types"
+)
+
+const (
+	ModuleName = "globalfee"
+)
+
+var ParamsKey = []byte{0x00}
+
+// Migrate migrates the x/globalfee module state from the consensus version 2 to
+// version 3. Specifically, it moves the bypass message settings, which were
+// previously hardcoded in the app, into the x/globalfee module params.
+func Migrate(
+	_ sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	var currParams types.Params
+	if bz := store.Get(ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &currParams); err != nil {
+			return err
+		}
+	}
+
+	currParams.BypassMinFeeMsgTypes = types.DefaultBypassMinFeeMsgTypes()
+	currParams.MaxTotalBypassMinFeeMsgGasUsage = types.DefaultMaxTotalBypassMinFeeMsgGasUsage
+
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+	bz := cdc.MustMarshal(&currParams)
+	store.Set(ParamsKey, bz)
+
+	return nil
+}
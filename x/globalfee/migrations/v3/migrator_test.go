@@ -0,0 +1,45 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/CosmosContracts/juno/v26/x/globalfee"
+	v3 "github.com/CosmosContracts/juno/v26/x/globalfee/migrations/v3"
+	"github.com/CosmosContracts/juno/v26/x/globalfee/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(globalfee.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(v3.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	minGasPrices := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("ujuno", sdk.NewDecWithPrec(75, 3)),
+	}
+	store.Set(v3.ParamsKey, cdc.MustMarshal(&types.Params{
+		MinimumGasPrices:   minGasPrices,
+		EnforceInDeliverTx: true,
+	}))
+
+	require.NoError(t, v3.Migrate(ctx, store, cdc))
+
+	var res types.Params
+	bz := store.Get(v3.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, types.Params{
+		MinimumGasPrices:                minGasPrices,
+		EnforceInDeliverTx:              true,
+		BypassMinFeeMsgTypes:            types.DefaultBypassMinFeeMsgTypes(),
+		MaxTotalBypassMinFeeMsgGasUsage: types.DefaultMaxTotalBypassMinFeeMsgGasUsage,
+	}, res)
+}
@@ -31,7 +31,7 @@ var (
 )
 
 // ConsensusVersion defines the current x/globalfee module consensus version.
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 // AppModuleBasic defines the basic application module used by the wasm module.
 type AppModuleBasic struct {
@@ -57,6 +57,12 @@ func (a AppModuleBasic) ValidateGenesis(marshaler codec.JSONCodec, _ client.TxEn
 	if err := data.Params.Validate(); err != nil {
 		return errorsmod.Wrap(err, "params")
 	}
+	if err := types.DecCoins(data.BaseGasPrices).Validate(); err != nil {
+		return errorsmod.Wrap(err, "base gas prices")
+	}
+	if err := types.ValidatePricedDenomRates(data.PricedDenomRates); err != nil {
+		return errorsmod.Wrap(err, "priced denom rates")
+	}
 	return nil
 }
 
@@ -114,12 +120,18 @@ func (a AppModule) InitGenesis(ctx sdk.Context, marshaler codec.JSONCodec, messa
 	marshaler.MustUnmarshalJSON(message, &genesisState)
 	// a.paramSpace.SetParamSet(ctx, &genesisState.Params)
 	_ = a.keeper.SetParams(ctx, genesisState.Params) // note: we may want to have this function return an error in the future.
+	for _, basePrice := range genesisState.BaseGasPrices {
+		a.keeper.SetBaseGasPrice(ctx, basePrice.Denom, basePrice.Amount)
+	}
+	for _, rate := range genesisState.PricedDenomRates {
+		a.keeper.SetPricedDenomRate(ctx, rate)
+	}
 	return nil
 }
 
 func (a AppModule) ExportGenesis(ctx sdk.Context, marshaler codec.JSONCodec) json.RawMessage {
 	params := a.keeper.GetParams(ctx)
-	genState := types.NewGenesisState(params)
+	genState := types.NewGenesisState(params, a.keeper.GetBaseGasPrices(ctx), a.keeper.GetPricedDenomRates(ctx))
 	return marshaler.MustMarshalJSON(genState)
 }
 
@@ -132,18 +144,23 @@ func (a AppModule) QuerierRoute() string {
 
 func (a AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(a.keeper))
-	types.RegisterQueryServer(cfg.QueryServer(), NewGrpcQuerier(a.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), NewGrpcQuerier(a.cdc, a.keeper))
 
 	m := keeper.NewMigrator(a.keeper, a.bondDenom)
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
 }
 
-func (a AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {
+func (a AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	a.keeper.UpdatePricedDenomRates(ctx)
 }
 
-func (a AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (a AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	a.keeper.UpdateBaseGasPrices(ctx)
 	return nil
 }
 
@@ -0,0 +1,184 @@
+package globalfee
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/globalfee/types"
+)
+
+// mockPriceSource answers conversion rate queries with the configured rates
+type mockPriceSource struct {
+	rates    map[string]types.ConversionRateResponse
+	queryGas uint64
+}
+
+func (m *mockPriceSource) QuerySmart(ctx sdk.Context, _ sdk.AccAddress, req []byte) ([]byte, error) {
+	ctx.GasMeter().ConsumeGas(m.queryGas, "price source query")
+
+	var query types.ConversionRateQuery
+	if err := json.Unmarshal(req, &query); err != nil {
+		return nil, err
+	}
+
+	rate, found := m.rates[query.ConversionRate.Denom]
+	if !found {
+		return nil, errors.New("unknown denom")
+	}
+
+	return json.Marshal(rate)
+}
+
+func TestPricedDenoms(t *testing.T) {
+	contract := sdk.AccAddress("price_source_contract_address___").String()
+	minGasPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("ALX", sdk.OneDec()),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1)),
+	)
+	pricedDenoms := &types.PricedDenomsParams{
+		PriceSourceContract: contract,
+		Denoms:              []string{"ALX", "BLX"},
+		QueryGasLimit:       100_000,
+		MaxRateAge:          600,
+		UpdateInterval:      10,
+	}
+
+	specs := map[string]struct {
+		minGasPrices sdk.DecCoins
+		pricedDenoms *types.PricedDenomsParams
+		height       int64
+		prevRates    []types.PricedDenomRate
+		rates        map[string]types.ConversionRateResponse
+		queryGas     uint64
+		expRates     []types.PricedDenomRate
+		expPrices    sdk.DecCoins
+	}{
+		"fresh rates derive the gas prices": {
+			minGasPrices: minGasPrices,
+			pricedDenoms: pricedDenoms,
+			rates: map[string]types.ConversionRateResponse{
+				"ALX": {Rate: sdk.NewDec(2), UpdatedAt: 1000},
+				"BLX": {Rate: sdk.NewDecWithPrec(5, 1), UpdatedAt: 1000},
+			},
+			expRates: []types.PricedDenomRate{
+				types.NewPricedDenomRate("ALX", sdk.NewDec(2), 1000),
+				types.NewPricedDenomRate("BLX", sdk.NewDecWithPrec(5, 1), 1000),
+			},
+			expPrices: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(5, 2)),
+				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(2, 1)),
+				sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1)),
+			),
+		},
+		"stale rates fall back to the minimum gas prices": {
+			minGasPrices: minGasPrices,
+			pricedDenoms: pricedDenoms,
+			rates: map[string]types.ConversionRateResponse{
+				"ALX": {Rate: sdk.NewDec(2), UpdatedAt: 399},
+				"BLX": {Rate: sdk.NewDecWithPrec(5, 1), UpdatedAt: 399},
+			},
+			expRates: []types.PricedDenomRate{
+				types.NewPricedDenomRate("ALX", sdk.NewDec(2), 399),
+				types.NewPricedDenomRate("BLX", sdk.NewDecWithPrec(5, 1), 399),
+			},
+			expPrices: minGasPrices,
+		},
+		"failed queries keep the previous rates": {
+			minGasPrices: minGasPrices,
+			pricedDenoms: pricedDenoms,
+			prevRates: []types.PricedDenomRate{
+				types.NewPricedDenomRate("BLX", sdk.NewDecWithPrec(5, 1), 900),
+			},
+			rates: map[string]types.ConversionRateResponse{
+				"ALX": {Rate: sdk.ZeroDec(), UpdatedAt: 1000},
+			},
+			expRates: []types.PricedDenomRate{
+				types.NewPricedDenomRate("BLX", sdk.NewDecWithPrec(5, 1), 900),
+			},
+			expPrices: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("ALX", sdk.OneDec()),
+				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(2, 1)),
+				sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1)),
+			),
+		},
+		"rates are only queried every update interval": {
+			minGasPrices: minGasPrices,
+			pricedDenoms: pricedDenoms,
+			height:       15,
+			prevRates: []types.PricedDenomRate{
+				types.NewPricedDenomRate("BLX", sdk.NewDecWithPrec(5, 1), 900),
+			},
+			rates: map[string]types.ConversionRateResponse{
+				"ALX": {Rate: sdk.NewDec(2), UpdatedAt: 1000},
+				"BLX": {Rate: sdk.NewDec(4), UpdatedAt: 1000},
+			},
+			expRates: []types.PricedDenomRate{
+				types.NewPricedDenomRate("BLX", sdk.NewDecWithPrec(5, 1), 900),
+			},
+			expPrices: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("ALX", sdk.OneDec()),
+				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(2, 1)),
+				sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1)),
+			),
+		},
+		"queries above the gas limit fail": {
+			minGasPrices: minGasPrices,
+			pricedDenoms: pricedDenoms,
+			rates: map[string]types.ConversionRateResponse{
+				"ALX": {Rate: sdk.NewDec(2), UpdatedAt: 1000},
+			},
+			queryGas:  100_001,
+			expRates:  []types.PricedDenomRate{},
+			expPrices: minGasPrices,
+		},
+		"rates of denoms no longer priced are removed": {
+			minGasPrices: minGasPrices,
+			prevRates: []types.PricedDenomRate{
+				types.NewPricedDenomRate("BLX", sdk.NewDecWithPrec(5, 1), 1000),
+			},
+			expRates:  []types.PricedDenomRate{},
+			expPrices: minGasPrices,
+		},
+		"no bond denom gas price": {
+			minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("ALX", sdk.OneDec())),
+			pricedDenoms: pricedDenoms,
+			rates: map[string]types.ConversionRateResponse{
+				"BLX": {Rate: sdk.NewDecWithPrec(5, 1), UpdatedAt: 1000},
+			},
+			expRates: []types.PricedDenomRate{
+				types.NewPricedDenomRate("BLX", sdk.NewDecWithPrec(5, 1), 1000),
+			},
+			expPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("ALX", sdk.OneDec())),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, encCfg, keeper := setupTestStoreWithWasm(t, &mockPriceSource{rates: spec.rates, queryGas: spec.queryGas})
+			ctx = ctx.WithBlockTime(time.Unix(1000, 0)).WithBlockHeight(spec.height)
+
+			require.NoError(t, keeper.SetParams(ctx, types.Params{
+				MinimumGasPrices: spec.minGasPrices,
+				PricedDenoms:     spec.pricedDenoms,
+			}))
+			for _, rate := range spec.prevRates {
+				keeper.SetPricedDenomRate(ctx, rate)
+			}
+
+			m := NewAppModule(encCfg.Marshaler, keeper, "stake")
+			m.BeginBlock(ctx, abci.RequestBeginBlock{})
+
+			assert.Equal(t, fmt.Sprint(spec.expRates), fmt.Sprint(keeper.GetPricedDenomRates(ctx)))
+			assert.Equal(t, spec.expPrices.String(), keeper.GetGlobalMinGasPrices(ctx).String())
+		})
+	}
+}
@@ -3,8 +3,17 @@ package globalfee
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 
+	"github.com/CosmosContracts/juno/v26/x/globalfee/ante"
 	"github.com/CosmosContracts/juno/v26/x/globalfee/keeper"
 	"github.com/CosmosContracts/juno/v26/x/globalfee/types"
 )
@@ -12,11 +21,13 @@ import (
 var _ types.QueryServer = &GrpcQuerier{}
 
 type GrpcQuerier struct {
+	cdc    codec.Codec
 	keeper keeper.Keeper
 }
 
-func NewGrpcQuerier(k keeper.Keeper) GrpcQuerier {
+func NewGrpcQuerier(cdc codec.Codec, k keeper.Keeper) GrpcQuerier {
 	return GrpcQuerier{
+		cdc:    cdc,
 		keeper: k,
 	}
 }
@@ -33,3 +44,143 @@ func (g GrpcQuerier) MinimumGasPrices(stdCtx context.Context, _ *types.QueryMini
 		MinimumGasPrices: minGasPrices,
 	}, nil
 }
+
+// Params return the globalfee module params
+func (g GrpcQuerier) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryParamsResponse{
+		Params: g.keeper.GetParams(ctx),
+	}, nil
+}
+
+// BaseGasPrices returns the gas prices currently required by the global fee
+func (g GrpcQuerier) BaseGasPrices(stdCtx context.Context, _ *types.QueryBaseGasPricesRequest) (*types.QueryBaseGasPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryBaseGasPricesResponse{
+		BaseGasPrices: g.keeper.GetGlobalMinGasPrices(ctx),
+	}, nil
+}
+
+// PricedDenomRates returns the last conversion rates of the priced denoms
+func (g GrpcQuerier) PricedDenomRates(stdCtx context.Context, _ *types.QueryPricedDenomRatesRequest) (*types.QueryPricedDenomRatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryPricedDenomRatesResponse{
+		Rates: g.keeper.GetPricedDenomRates(ctx),
+	}, nil
+}
+
+// EstimateFee returns the fee a tx requires, whether a fee pay contract would
+// sponsor it, and the fee share payouts of the contracts it executes
+func (g GrpcQuerier) EstimateFee(stdCtx context.Context, req *types.QueryEstimateFeeRequest) (*types.QueryEstimateFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	feeTx, err := g.estimateFeeTx(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params := g.keeper.GetParams(ctx)
+	msgs := feeTx.GetMsgs()
+	gas := feeTx.GetGas()
+
+	// global fee is empty set, set global fee to 0 bond denom
+	globalMinGasPrices := g.keeper.GetGlobalMinGasPrices(ctx)
+	if len(globalMinGasPrices) == 0 {
+		globalMinGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec(g.keeper.GetBondDenom(), sdk.ZeroDec())}
+	}
+
+	multiplier, err := ante.GetMsgsGasPriceMultiplier(msgs, params.MsgGasPriceMultipliers)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	requiredFees := ante.CombinedFeeRequirement(
+		ante.GetRequiredFees(globalMinGasPrices, multiplier, gas),
+		ante.GetMinGasPrice(ctx, int64(gas)),
+	)
+
+	bypassMinFee := ante.ContainsOnlyBypassMinFeeMsgs(msgs, params.BypassMinFeeMsgTypes) &&
+		gas <= params.MaxTotalBypassMinFeeMsgGasUsage
+
+	feePayContract, sponsored := g.keeper.GetSponsoringFeePayContract(ctx, feeTx)
+
+	// Without a fee, the fee shares are estimated with the fee a wallet would
+	// pay in the bond denom, unless a fee pay contract sponsors the tx
+	fee := feeTx.GetFee()
+	if fee.IsZero() && !sponsored {
+		if found, bondFee := ante.Find(requiredFees, g.keeper.GetBondDenom()); found {
+			fee = sdk.NewCoins(bondFee)
+		}
+	}
+
+	payouts, err := g.keeper.EstimateFeeSharePayouts(ctx, fee, msgs)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEstimateFeeResponse{
+		RequiredFees:    requiredFees,
+		BypassMinFee:    bypassMinFee,
+		FeePayContract:  feePayContract,
+		FeeSharePayouts: payouts,
+	}, nil
+}
+
+// estimateFeeTx returns the tx to estimate, decoded from the tx bytes or built
+// from the msgs of the request.
+func (g GrpcQuerier) estimateFeeTx(req *types.QueryEstimateFeeRequest) (sdk.FeeTx, error) {
+	if len(req.TxBytes) != 0 {
+		var tx txtypes.Tx
+		if err := g.cdc.Unmarshal(req.TxBytes, &tx); err != nil {
+			return nil, err
+		}
+		if tx.Body == nil || tx.AuthInfo == nil || tx.AuthInfo.Fee == nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "tx body, auth info and fee must be set")
+		}
+
+		return &tx, nil
+	}
+
+	if len(req.Msgs) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "either tx bytes or msgs must be set")
+	}
+
+	msgs := make([]sdk.Msg, len(req.Msgs))
+	for i, msgAny := range req.Msgs {
+		if err := g.cdc.UnpackAny(msgAny, &msgs[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return estimateTx{msgs: msgs, gas: req.Gas, fee: req.Fee}, nil
+}
+
+// estimateTx is a fee tx built from the msgs of an EstimateFee request. Its
+// fee payer is the first signer of the first msg.
+type estimateTx struct {
+	msgs []sdk.Msg
+	gas  uint64
+	fee  sdk.Coins
+}
+
+var _ sdk.FeeTx = estimateTx{}
+
+func (tx estimateTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx estimateTx) ValidateBasic() error       { return nil }
+func (tx estimateTx) GetGas() uint64             { return tx.gas }
+func (tx estimateTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx estimateTx) FeeGranter() sdk.AccAddress { return nil }
+
+func (tx estimateTx) FeePayer() sdk.AccAddress {
+	if signers := tx.msgs[0].GetSigners(); len(signers) != 0 {
+		return signers[0]
+	}
+	return nil
+}
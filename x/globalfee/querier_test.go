@@ -1,12 +1,17 @@
 package globalfee
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	globalfeekeeper "github.com/CosmosContracts/juno/v26/x/globalfee/keeper"
 	"github.com/CosmosContracts/juno/v26/x/globalfee/types"
@@ -48,9 +53,9 @@ func TestQueryMinimumGasPrices(t *testing.T) {
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			ctx, _, keeper := setupTestStore(t)
+			ctx, encCfg, keeper := setupTestStore(t)
 			spec.setupStore(ctx, keeper)
-			q := NewGrpcQuerier(keeper)
+			q := NewGrpcQuerier(encCfg.Marshaler, keeper)
 			gotResp, gotErr := q.MinimumGasPrices(sdk.WrapSDKContext(ctx), nil)
 			require.NoError(t, gotErr)
 			require.NotNil(t, gotResp)
@@ -58,3 +63,105 @@ func TestQueryMinimumGasPrices(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryParams(t *testing.T) {
+	ctx, encCfg, keeper := setupTestStore(t)
+	params := types.Params{
+		MinimumGasPrices:                sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+		EnforceInDeliverTx:              true,
+		BypassMinFeeMsgTypes:            []string{"/ibc.core.client.v1.MsgUpdateClient"},
+		MaxTotalBypassMinFeeMsgGasUsage: 1_000_000,
+	}
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	q := NewGrpcQuerier(encCfg.Marshaler, keeper)
+	gotResp, gotErr := q.Params(sdk.WrapSDKContext(ctx), nil)
+	require.NoError(t, gotErr)
+	require.NotNil(t, gotResp)
+	assert.Equal(t, params, gotResp.Params)
+}
+
+func TestQueryEstimateFee(t *testing.T) {
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String(),
+		ToAddress:   sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+	}
+	sendMsgAny, err := codectypes.NewAnyWithValue(sendMsg)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		params       types.Params
+		req          *types.QueryEstimateFeeRequest
+		expFees      sdk.Coins
+		expBypass    bool
+		expErrorCode codes.Code
+	}{
+		"required fees": {
+			params: types.Params{
+				MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1))),
+			},
+			req:     &types.QueryEstimateFeeRequest{Msgs: []*codectypes.Any{sendMsgAny}, Gas: 200_000},
+			expFees: sdk.NewCoins(sdk.NewInt64Coin("stake", 20_000)),
+		},
+		"required fees with msg multiplier": {
+			params: types.Params{
+				MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1))),
+				MsgGasPriceMultipliers: []types.MsgGasPriceMultiplier{
+					{MsgTypeUrl: sdk.MsgTypeURL(sendMsg), Multiplier: sdk.NewDec(2)},
+				},
+			},
+			req:     &types.QueryEstimateFeeRequest{Msgs: []*codectypes.Any{sendMsgAny}, Gas: 200_000},
+			expFees: sdk.NewCoins(sdk.NewInt64Coin("stake", 40_000)),
+		},
+		"bypass msgs within gas usage": {
+			params: types.Params{
+				MinimumGasPrices:                sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1))),
+				BypassMinFeeMsgTypes:            []string{sdk.MsgTypeURL(sendMsg)},
+				MaxTotalBypassMinFeeMsgGasUsage: 200_000,
+			},
+			req:       &types.QueryEstimateFeeRequest{Msgs: []*codectypes.Any{sendMsgAny}, Gas: 200_000},
+			expFees:   sdk.NewCoins(sdk.NewInt64Coin("stake", 20_000)),
+			expBypass: true,
+		},
+		"bypass msgs above gas usage": {
+			params: types.Params{
+				MinimumGasPrices:                sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1))),
+				BypassMinFeeMsgTypes:            []string{sdk.MsgTypeURL(sendMsg)},
+				MaxTotalBypassMinFeeMsgGasUsage: 100_000,
+			},
+			req:     &types.QueryEstimateFeeRequest{Msgs: []*codectypes.Any{sendMsgAny}, Gas: 200_000},
+			expFees: sdk.NewCoins(sdk.NewInt64Coin("stake", 20_000)),
+		},
+		"no tx bytes or msgs": {
+			req:          &types.QueryEstimateFeeRequest{Gas: 200_000},
+			expErrorCode: codes.InvalidArgument,
+		},
+		"invalid tx bytes": {
+			req:          &types.QueryEstimateFeeRequest{TxBytes: []byte("invalid")},
+			expErrorCode: codes.InvalidArgument,
+		},
+		"nil request": {
+			expErrorCode: codes.InvalidArgument,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, encCfg, keeper := setupTestStore(t)
+			banktypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+			require.NoError(t, keeper.SetParams(ctx, spec.params))
+
+			q := NewGrpcQuerier(encCfg.Marshaler, keeper)
+			gotResp, gotErr := q.EstimateFee(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErrorCode != codes.OK {
+				require.Equal(t, spec.expErrorCode, status.Code(gotErr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expFees, gotResp.RequiredFees)
+			assert.Equal(t, spec.expBypass, gotResp.BypassMinFee)
+			assert.Empty(t, gotResp.FeePayContract)
+			assert.Empty(t, gotResp.FeeSharePayouts)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feepaytypes "github.com/CosmosContracts/juno/v26/x/feepay/types"
+	feesharetypes "github.com/CosmosContracts/juno/v26/x/feeshare/types"
+)
+
+// WasmViewKeeper defines the expected interface needed to query the price
+// source contract.
+type WasmViewKeeper interface {
+	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+}
+
+// FeePayKeeper defines the expected interface needed to estimate if a tx would
+// be sponsored by a fee pay contract.
+type FeePayKeeper interface {
+	GetSponsoringContract(ctx sdk.Context, feeTx sdk.FeeTx, gasPrices sdk.DecCoins) (*feepaytypes.FeePayContract, sdk.Coin, error)
+}
+
+// FeeShareKeeper defines the expected interface needed to estimate the fee
+// share payouts of a tx.
+type FeeShareKeeper interface {
+	FeeSharePayouts(ctx sdk.Context, totalFees sdk.Coins, msgs []sdk.Msg) ([]feesharetypes.FeeShare, []sdk.Coins, error)
+}
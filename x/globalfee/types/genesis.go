@@ -2,22 +2,26 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(params Params) *GenesisState {
+func NewGenesisState(params Params, baseGasPrices sdk.DecCoins, pricedDenomRates []PricedDenomRate) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:           params,
+		BaseGasPrices:    baseGasPrices,
+		PricedDenomRates: pricedDenomRates,
 	}
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams())
+	return NewGenesisState(DefaultParams(), sdk.DecCoins(nil), nil)
 }
 
 // GetGenesisStateFromAppState returns x/auth GenesisState given raw application
@@ -37,5 +41,25 @@ func ValidateGenesis(data GenesisState) error {
 		return errorsmod.Wrap(err, "globalfee params")
 	}
 
+	if err := DecCoins(data.BaseGasPrices).Validate(); err != nil {
+		return errorsmod.Wrap(err, "globalfee base gas prices")
+	}
+
+	return ValidatePricedDenomRates(data.PricedDenomRates)
+}
+
+// ValidatePricedDenomRates validates the rates and requires unique denoms
+func ValidatePricedDenomRates(rates []PricedDenomRate) error {
+	seen := make(map[string]bool, len(rates))
+	for _, rate := range rates {
+		if err := rate.Validate(); err != nil {
+			return errorsmod.Wrap(err, "globalfee priced denom rates")
+		}
+		if seen[rate.Denom] {
+			return fmt.Errorf("duplicate priced denom rate %s", rate.Denom)
+		}
+		seen[rate.Denom] = true
+	}
+
 	return nil
 }
@@ -29,6 +29,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// Params of this module
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// base_gas_prices are the current fee market base gas prices
+	BaseGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=base_gas_prices,json=baseGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"base_gas_prices,omitempty" yaml:"base_gas_prices"`
+	// priced_denom_rates are the last conversion rates of the priced denoms
+	PricedDenomRates []PricedDenomRate `protobuf:"bytes,3,rep,name=priced_denom_rates,json=pricedDenomRates,proto3" json:"priced_denom_rates,omitempty" yaml:"priced_denom_rates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,6 +75,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetBaseGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.BaseGasPrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPricedDenomRates() []PricedDenomRate {
+	if m != nil {
+		return m.PricedDenomRates
+	}
+	return nil
+}
+
 // Params defines the set of module parameters.
 type Params struct {
 	// Minimum stores the minimum gas price(s) for all TX on the chain.
@@ -79,6 +97,27 @@ type Params struct {
 	// values allowed. For more information see
 	// https://docs.cosmos.network/main/modules/auth#concepts
 	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices,omitempty" yaml:"minimum_gas_prices"`
+	// enforce_in_deliver_tx enforces the minimum gas prices at the consensus
+	// level, rejecting under-priced txs in DeliverTx as well as in CheckTx. Local
+	// minimum gas prices of the validator only ever apply in CheckTx.
+	EnforceInDeliverTx bool `protobuf:"varint,2,opt,name=enforce_in_deliver_tx,json=enforceInDeliverTx,proto3" json:"enforce_in_deliver_tx,omitempty" yaml:"enforce_in_deliver_tx"`
+	// bypass_min_fee_msg_types defines the msg type URLs that are accepted
+	// without the minimum fee, as long as every msg of the tx is one of them.
+	BypassMinFeeMsgTypes []string `protobuf:"bytes,3,rep,name=bypass_min_fee_msg_types,json=bypassMinFeeMsgTypes,proto3" json:"bypass_min_fee_msg_types,omitempty" yaml:"bypass_min_fee_msg_types"`
+	// max_total_bypass_min_fee_msg_gas_usage defines the gas limit above which a
+	// tx of bypass msgs must pay the minimum fee.
+	MaxTotalBypassMinFeeMsgGasUsage uint64 `protobuf:"varint,4,opt,name=max_total_bypass_min_fee_msg_gas_usage,json=maxTotalBypassMinFeeMsgGasUsage,proto3" json:"max_total_bypass_min_fee_msg_gas_usage,omitempty" yaml:"max_total_bypass_min_fee_msg_gas_usage"`
+	// fee_market configures the optional dynamic base gas prices. The fee
+	// market is disabled when unset.
+	FeeMarket *FeeMarketParams `protobuf:"bytes,5,opt,name=fee_market,json=feeMarket,proto3" json:"fee_market,omitempty" yaml:"fee_market"`
+	// msg_gas_price_multipliers scale the global minimum gas prices per msg
+	// type. The required fee of a tx is computed with the highest multiplier of
+	// its msgs, including the msgs wrapped in an authz MsgExec. Msgs without a
+	// multiplier use a multiplier of one.
+	MsgGasPriceMultipliers []MsgGasPriceMultiplier `protobuf:"bytes,6,rep,name=msg_gas_price_multipliers,json=msgGasPriceMultipliers,proto3" json:"msg_gas_price_multipliers,omitempty" yaml:"msg_gas_price_multipliers"`
+	// priced_denoms configures the optional fee denoms priced by a price source
+	// contract. Priced denoms are disabled when unset.
+	PricedDenoms *PricedDenomsParams `protobuf:"bytes,7,opt,name=priced_denoms,json=pricedDenoms,proto3" json:"priced_denoms,omitempty" yaml:"priced_denoms"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -121,9 +160,337 @@ func (m *Params) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoi
 	return nil
 }
 
+func (m *Params) GetEnforceInDeliverTx() bool {
+	if m != nil {
+		return m.EnforceInDeliverTx
+	}
+	return false
+}
+
+func (m *Params) GetBypassMinFeeMsgTypes() []string {
+	if m != nil {
+		return m.BypassMinFeeMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTotalBypassMinFeeMsgGasUsage() uint64 {
+	if m != nil {
+		return m.MaxTotalBypassMinFeeMsgGasUsage
+	}
+	return 0
+}
+
+func (m *Params) GetFeeMarket() *FeeMarketParams {
+	if m != nil {
+		return m.FeeMarket
+	}
+	return nil
+}
+
+func (m *Params) GetMsgGasPriceMultipliers() []MsgGasPriceMultiplier {
+	if m != nil {
+		return m.MsgGasPriceMultipliers
+	}
+	return nil
+}
+
+func (m *Params) GetPricedDenoms() *PricedDenomsParams {
+	if m != nil {
+		return m.PricedDenoms
+	}
+	return nil
+}
+
+// PricedDenomsParams defines fee denoms whose minimum gas price is derived from
+// a conversion rate to the bond denom, instead of being set by governance. The
+// rates are queried from the price source contract at the beginning of every
+// update_interval blocks with:
+//
+//	{"conversion_rate":{"denom":"<denom>"}}
+//
+// The contract must answer with the amount of bond denom one unit of the denom
+// is worth, and the unix time in seconds of the last price update:
+//
+//	{"rate":"<decimal>","updated_at":<seconds>}
+//
+// The minimum gas price of a priced denom is the bond denom minimum gas price
+// divided by its rate. When the rate is missing or stale, the minimum gas price
+// of the denom in minimum_gas_prices applies, if any.
+type PricedDenomsParams struct {
+	// price_source_contract is the bech32 address of the price source contract
+	PriceSourceContract string `protobuf:"bytes,1,opt,name=price_source_contract,json=priceSourceContract,proto3" json:"price_source_contract,omitempty" yaml:"price_source_contract"`
+	// denoms are the priced fee denoms
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty" yaml:"denoms"`
+	// query_gas_limit is the gas limit of each conversion rate query
+	QueryGasLimit uint64 `protobuf:"varint,3,opt,name=query_gas_limit,json=queryGasLimit,proto3" json:"query_gas_limit,omitempty" yaml:"query_gas_limit"`
+	// max_rate_age is the number of seconds after its last update that a rate
+	// is considered stale
+	MaxRateAge uint64 `protobuf:"varint,4,opt,name=max_rate_age,json=maxRateAge,proto3" json:"max_rate_age,omitempty" yaml:"max_rate_age"`
+	// update_interval is the number of blocks between two conversion rate
+	// updates
+	UpdateInterval uint64 `protobuf:"varint,5,opt,name=update_interval,json=updateInterval,proto3" json:"update_interval,omitempty" yaml:"update_interval"`
+}
+
+func (m *PricedDenomsParams) Reset()         { *m = PricedDenomsParams{} }
+func (m *PricedDenomsParams) String() string { return proto.CompactTextString(m) }
+func (*PricedDenomsParams) ProtoMessage()    {}
+func (*PricedDenomsParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_015b3e8b7a7c65c5, []int{2}
+}
+func (m *PricedDenomsParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PricedDenomsParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PricedDenomsParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PricedDenomsParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricedDenomsParams.Merge(m, src)
+}
+func (m *PricedDenomsParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PricedDenomsParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricedDenomsParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricedDenomsParams proto.InternalMessageInfo
+
+func (m *PricedDenomsParams) GetPriceSourceContract() string {
+	if m != nil {
+		return m.PriceSourceContract
+	}
+	return ""
+}
+
+func (m *PricedDenomsParams) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *PricedDenomsParams) GetQueryGasLimit() uint64 {
+	if m != nil {
+		return m.QueryGasLimit
+	}
+	return 0
+}
+
+func (m *PricedDenomsParams) GetMaxRateAge() uint64 {
+	if m != nil {
+		return m.MaxRateAge
+	}
+	return 0
+}
+
+func (m *PricedDenomsParams) GetUpdateInterval() uint64 {
+	if m != nil {
+		return m.UpdateInterval
+	}
+	return 0
+}
+
+// PricedDenomRate is the last conversion rate of a priced denom to the bond
+// denom.
+type PricedDenomRate struct {
+	// denom is the priced fee denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the amount of bond denom one unit of the denom is worth
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// updated_at is the unix time in seconds the price source last updated the
+	// rate
+	UpdatedAt int64 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" yaml:"updated_at"`
+}
+
+func (m *PricedDenomRate) Reset()         { *m = PricedDenomRate{} }
+func (m *PricedDenomRate) String() string { return proto.CompactTextString(m) }
+func (*PricedDenomRate) ProtoMessage()    {}
+func (*PricedDenomRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_015b3e8b7a7c65c5, []int{3}
+}
+func (m *PricedDenomRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PricedDenomRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PricedDenomRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PricedDenomRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricedDenomRate.Merge(m, src)
+}
+func (m *PricedDenomRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PricedDenomRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricedDenomRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricedDenomRate proto.InternalMessageInfo
+
+func (m *PricedDenomRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PricedDenomRate) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+// MsgGasPriceMultiplier defines the gas price multiplier of a msg type.
+type MsgGasPriceMultiplier struct {
+	// msg_type_url is the type URL of the msg, e.g. /cosmos.gov.v1.MsgVote
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// multiplier is applied to the global minimum gas prices, must be positive
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier" yaml:"multiplier"`
+}
+
+func (m *MsgGasPriceMultiplier) Reset()         { *m = MsgGasPriceMultiplier{} }
+func (m *MsgGasPriceMultiplier) String() string { return proto.CompactTextString(m) }
+func (*MsgGasPriceMultiplier) ProtoMessage()    {}
+func (*MsgGasPriceMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_015b3e8b7a7c65c5, []int{4}
+}
+func (m *MsgGasPriceMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGasPriceMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGasPriceMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGasPriceMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGasPriceMultiplier.Merge(m, src)
+}
+func (m *MsgGasPriceMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGasPriceMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGasPriceMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGasPriceMultiplier proto.InternalMessageInfo
+
+func (m *MsgGasPriceMultiplier) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// FeeMarketParams defines an EIP-1559 style fee market. When enabled, a base
+// gas price is kept per denom of the minimum gas prices and adjusted at the end
+// of every block toward the target block gas utilization. The base gas prices
+// then replace the minimum gas prices as the global fee. Denoms with a zero
+// minimum gas price are not adjusted.
+type FeeMarketParams struct {
+	// enabled turns the fee market on
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// target_block_utilization is the targeted fraction of the block gas limit
+	// used by a block, in (0, 1]
+	TargetBlockUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=target_block_utilization,json=targetBlockUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_block_utilization" yaml:"target_block_utilization"`
+	// max_change_rate is the maximum fraction by which the base gas prices
+	// change per block, in (0, 1]
+	MaxChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_rate" yaml:"max_change_rate"`
+	// max_gas_prices bound the base gas prices from above. Denoms without a
+	// maximum are unbounded. The minimum gas prices are the lower bound.
+	MaxGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=max_gas_prices,json=maxGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"max_gas_prices,omitempty" yaml:"max_gas_prices"`
+	// target_block_gas is the targeted gas used by a block when the block gas
+	// limit is unlimited. The base gas prices are not adjusted without a block
+	// gas limit when it is zero.
+	TargetBlockGas uint64 `protobuf:"varint,5,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty" yaml:"target_block_gas"`
+}
+
+func (m *FeeMarketParams) Reset()         { *m = FeeMarketParams{} }
+func (m *FeeMarketParams) String() string { return proto.CompactTextString(m) }
+func (*FeeMarketParams) ProtoMessage()    {}
+func (*FeeMarketParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_015b3e8b7a7c65c5, []int{5}
+}
+func (m *FeeMarketParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarketParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarketParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMarketParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarketParams.Merge(m, src)
+}
+func (m *FeeMarketParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarketParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarketParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarketParams proto.InternalMessageInfo
+
+func (m *FeeMarketParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *FeeMarketParams) GetMaxGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MaxGasPrices
+	}
+	return nil
+}
+
+func (m *FeeMarketParams) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "gaia.globalfee.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "gaia.globalfee.v1beta1.Params")
+	proto.RegisterType((*PricedDenomsParams)(nil), "gaia.globalfee.v1beta1.PricedDenomsParams")
+	proto.RegisterType((*PricedDenomRate)(nil), "gaia.globalfee.v1beta1.PricedDenomRate")
+	proto.RegisterType((*MsgGasPriceMultiplier)(nil), "gaia.globalfee.v1beta1.MsgGasPriceMultiplier")
+	proto.RegisterType((*FeeMarketParams)(nil), "gaia.globalfee.v1beta1.FeeMarketParams")
 }
 
 func init() {
@@ -131,28 +498,77 @@ func init() {
 }
 
 var fileDescriptor_015b3e8b7a7c65c5 = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4b, 0x03, 0x31,
-	0x14, 0xc7, 0x2f, 0x08, 0x1d, 0xae, 0x0e, 0xe5, 0x10, 0xa9, 0xa5, 0xe4, 0xe4, 0x70, 0x28, 0xa8,
-	0x09, 0xad, 0x9b, 0xe3, 0x29, 0x74, 0x2d, 0x75, 0x73, 0xa9, 0xb9, 0x33, 0xc6, 0x60, 0x73, 0x09,
-	0x4d, 0x2a, 0xf6, 0x5b, 0xf8, 0x39, 0xfc, 0x0c, 0xee, 0x76, 0xec, 0xe8, 0x54, 0xe5, 0x6e, 0x73,
-	0xf4, 0x13, 0xc8, 0x25, 0x67, 0x2b, 0x9c, 0x53, 0x02, 0xef, 0xf7, 0x7e, 0xff, 0xc7, 0x7b, 0xfe,
-	0x11, 0x23, 0x9c, 0x60, 0x36, 0x95, 0x09, 0x99, 0xde, 0x51, 0x8a, 0x1f, 0xfb, 0x09, 0x35, 0xa4,
-	0x8f, 0x19, 0xcd, 0xa8, 0xe6, 0x1a, 0xa9, 0x99, 0x34, 0x32, 0xd8, 0x2f, 0x29, 0xb4, 0xa1, 0x50,
-	0x45, 0x75, 0xf6, 0x98, 0x64, 0xd2, 0x22, 0xb8, 0xfc, 0x39, 0xba, 0x03, 0x53, 0xa9, 0x85, 0xd4,
-	0x38, 0x21, 0x7a, 0x2b, 0x4c, 0x25, 0xcf, 0x5c, 0x3d, 0xba, 0xf1, 0x77, 0x87, 0x4e, 0x7f, 0x65,
-	0x88, 0xa1, 0xc1, 0xc8, 0x6f, 0x28, 0x32, 0x23, 0x42, 0xb7, 0xc1, 0x21, 0xe8, 0x35, 0x07, 0x10,
-	0xfd, 0x1f, 0x87, 0x46, 0x96, 0x8a, 0xdb, 0xcb, 0x75, 0xe8, 0x7d, 0xad, 0xc3, 0x96, 0xeb, 0x3a,
-	0x91, 0x82, 0x1b, 0x2a, 0x94, 0x59, 0x8c, 0x2b, 0x4f, 0xf4, 0x06, 0xfc, 0x86, 0x83, 0x83, 0x57,
-	0xe0, 0x07, 0x82, 0x67, 0x5c, 0xcc, 0xc5, 0x84, 0x11, 0x3d, 0x51, 0x33, 0x9e, 0xd2, 0x32, 0x69,
-	0xa7, 0xd7, 0x1c, 0x74, 0x91, 0x1b, 0x15, 0x95, 0xa3, 0x6e, 0x62, 0x2e, 0x69, 0x7a, 0x21, 0x79,
-	0x16, 0xab, 0x2a, 0xa7, 0x5b, 0xef, 0xdf, 0x66, 0x7e, 0xaf, 0xc3, 0x83, 0x05, 0x11, 0xd3, 0xf3,
-	0xa8, 0x4e, 0x45, 0x2f, 0x1f, 0xe1, 0x31, 0xe3, 0xe6, 0x7e, 0x9e, 0xa0, 0x54, 0x0a, 0x5c, 0xed,
-	0xc5, 0x3d, 0xa7, 0xfa, 0xf6, 0x01, 0x9b, 0x85, 0xa2, 0xfa, 0x37, 0x50, 0x8f, 0x5b, 0x95, 0x63,
-	0x48, 0xf4, 0xc8, 0x1a, 0xe2, 0x78, 0x99, 0x43, 0xb0, 0xca, 0x21, 0xf8, 0xcc, 0x21, 0x78, 0x2e,
-	0xa0, 0xb7, 0x2a, 0xa0, 0xf7, 0x5e, 0x40, 0xef, 0xba, 0x57, 0x17, 0xdb, 0x5b, 0x3e, 0xfd, 0xb9,
-	0xa6, 0xd5, 0x27, 0x0d, 0xbb, 0xf6, 0xb3, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x6d, 0x01,
-	0xcd, 0xec, 0x01, 0x00, 0x00,
+	// 1119 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4b, 0x6f, 0x23, 0x45,
+	0x10, 0xce, 0x24, 0xd9, 0xec, 0xba, 0x37, 0x89, 0xb3, 0xbd, 0x79, 0x4c, 0x42, 0xe4, 0xb1, 0x1a,
+	0xb4, 0x84, 0x47, 0x6c, 0x76, 0x97, 0x0b, 0xdc, 0x32, 0x0e, 0x84, 0x48, 0x44, 0x84, 0xd9, 0xe4,
+	0xc2, 0x65, 0xd4, 0x1e, 0x77, 0x26, 0x4d, 0xe6, 0xc5, 0x74, 0x3b, 0x72, 0xb8, 0x21, 0x71, 0xe3,
+	0xc2, 0x91, 0xeb, 0x5e, 0x11, 0x42, 0x9c, 0x16, 0x21, 0xf1, 0x03, 0xf6, 0xb8, 0x47, 0xe0, 0x30,
+	0xa0, 0xe4, 0xe6, 0x63, 0x7e, 0x01, 0xea, 0x87, 0x33, 0x1e, 0x3f, 0x90, 0x23, 0x4e, 0x76, 0x57,
+	0x7d, 0x55, 0xf5, 0xf5, 0x37, 0x55, 0xa5, 0x06, 0x6f, 0xf8, 0x98, 0xe2, 0xba, 0x1f, 0xc4, 0x4d,
+	0x1c, 0x9c, 0x10, 0x52, 0x3f, 0x7f, 0xdc, 0x24, 0x1c, 0x3f, 0xae, 0xfb, 0x24, 0x22, 0x8c, 0xb2,
+	0x5a, 0x92, 0xc6, 0x3c, 0x86, 0xab, 0x02, 0x55, 0xbb, 0x41, 0xd5, 0x34, 0x6a, 0x63, 0xd9, 0x8f,
+	0xfd, 0x58, 0x42, 0xea, 0xe2, 0x9f, 0x42, 0x6f, 0x54, 0xbc, 0x98, 0x85, 0x31, 0xab, 0x37, 0x31,
+	0xcb, 0x13, 0x7a, 0x31, 0x8d, 0x94, 0x1f, 0xfd, 0x3c, 0x03, 0xe6, 0xf7, 0x54, 0xfe, 0x67, 0x1c,
+	0x73, 0x02, 0x0f, 0xc1, 0x5c, 0x82, 0x53, 0x1c, 0x32, 0xd3, 0xa8, 0x1a, 0x5b, 0xf7, 0x9f, 0x54,
+	0x6a, 0xa3, 0xeb, 0xd5, 0x0e, 0x25, 0xca, 0x36, 0x5f, 0x66, 0xd6, 0x54, 0x37, 0xb3, 0x96, 0x54,
+	0xd4, 0xbb, 0x71, 0x48, 0x39, 0x09, 0x13, 0x7e, 0xe1, 0xe8, 0x3c, 0xf0, 0x17, 0x03, 0x94, 0x45,
+	0x79, 0xd7, 0xc7, 0xcc, 0x4d, 0x52, 0xea, 0x11, 0x66, 0x4e, 0x57, 0x67, 0xb6, 0xee, 0x3f, 0xd9,
+	0xac, 0x29, 0x76, 0x35, 0xe1, 0xbe, 0x49, 0xbc, 0x4b, 0xbc, 0x46, 0x4c, 0x23, 0xfb, 0x4b, 0x9d,
+	0x79, 0x7d, 0x20, 0x38, 0x2f, 0x71, 0x9d, 0x59, 0xab, 0x17, 0x38, 0x0c, 0x3e, 0x44, 0x03, 0x10,
+	0xf4, 0xe3, 0xdf, 0xd6, 0x3b, 0x3e, 0xe5, 0xa7, 0xed, 0x66, 0xcd, 0x8b, 0xc3, 0xba, 0x16, 0x41,
+	0xfd, 0x6c, 0xb3, 0xd6, 0x59, 0x9d, 0x5f, 0x24, 0x84, 0xf5, 0x4a, 0x31, 0x67, 0x41, 0x24, 0xd8,
+	0xc3, 0xec, 0x50, 0x86, 0xc3, 0x1f, 0x0c, 0x00, 0x65, 0xa6, 0x96, 0xdb, 0x22, 0x51, 0x1c, 0xba,
+	0x29, 0xe6, 0x84, 0x99, 0x33, 0x92, 0xf5, 0x9b, 0x63, 0x15, 0x91, 0x11, 0xbb, 0x22, 0xc0, 0xc1,
+	0x9c, 0xd8, 0x3b, 0xfa, 0x02, 0x9b, 0xc3, 0xa9, 0x0a, 0x77, 0x58, 0x57, 0x77, 0x18, 0x46, 0x21,
+	0x67, 0x29, 0x29, 0xe6, 0x64, 0xe8, 0xc5, 0x3d, 0x30, 0xa7, 0xa4, 0x87, 0xbf, 0x1b, 0x00, 0x86,
+	0x34, 0xa2, 0x61, 0x3b, 0xec, 0xd7, 0xd6, 0x98, 0x40, 0xdb, 0xa4, 0x47, 0x6d, 0x38, 0x7e, 0x14,
+	0xb5, 0x61, 0xd4, 0xad, 0x15, 0x5e, 0xd2, 0x39, 0x72, 0x91, 0xcf, 0xc1, 0x0a, 0x89, 0x4e, 0xe2,
+	0xd4, 0x23, 0x2e, 0x8d, 0xdc, 0x16, 0x09, 0xe8, 0x39, 0x49, 0x5d, 0xde, 0x31, 0xa7, 0xab, 0xc6,
+	0xd6, 0x3d, 0xbb, 0xd1, 0xcd, 0x2c, 0x6b, 0x24, 0xa0, 0xc0, 0x70, 0x53, 0x31, 0x1c, 0x09, 0x44,
+	0x0e, 0xd4, 0xf6, 0xfd, 0x68, 0x57, 0x59, 0x8f, 0x3a, 0xf0, 0x1b, 0x03, 0x98, 0xcd, 0x8b, 0x04,
+	0x33, 0xe6, 0x86, 0x34, 0x72, 0x4f, 0x08, 0x71, 0x43, 0xe6, 0xbb, 0x92, 0xaf, 0xfc, 0xc4, 0x25,
+	0x7b, 0xbf, 0x9b, 0x59, 0x68, 0x1c, 0xa6, 0x50, 0xde, 0xd2, 0xfd, 0x37, 0x06, 0x8b, 0x9c, 0x65,
+	0xe5, 0x3a, 0xa0, 0xd1, 0xc7, 0x84, 0x1c, 0x30, 0xff, 0x48, 0x98, 0xe1, 0x0b, 0x03, 0x3c, 0x0a,
+	0x71, 0xc7, 0xe5, 0x31, 0xc7, 0x81, 0x3b, 0x22, 0x5a, 0x28, 0xdd, 0x66, 0xd8, 0x27, 0xe6, 0x6c,
+	0xd5, 0xd8, 0x9a, 0xb5, 0x49, 0x37, 0xb3, 0xde, 0x9b, 0x2c, 0xa2, 0xc0, 0x6f, 0x5b, 0x7f, 0xc0,
+	0x89, 0x22, 0x91, 0x63, 0x85, 0xb8, 0x73, 0x24, 0x70, 0x76, 0x91, 0xf5, 0x1e, 0x66, 0xc7, 0x02,
+	0x01, 0xdb, 0x00, 0xc8, 0x30, 0x9c, 0x9e, 0x11, 0x6e, 0xde, 0xa9, 0x1a, 0xff, 0x35, 0x10, 0x22,
+	0x56, 0x02, 0xf5, 0xae, 0xd8, 0xee, 0x66, 0xd6, 0x72, 0x1e, 0x5e, 0x20, 0xfa, 0x40, 0x11, 0xcd,
+	0xbd, 0xc8, 0x29, 0x9d, 0xf4, 0xe2, 0xe1, 0x6f, 0x06, 0x58, 0xef, 0x51, 0x95, 0x0d, 0xe8, 0x86,
+	0xed, 0x80, 0xd3, 0x24, 0xa0, 0x24, 0x65, 0xe6, 0x9c, 0xec, 0xf8, 0xed, 0x71, 0x34, 0x14, 0x7f,
+	0xd9, 0x75, 0x07, 0x37, 0x51, 0xf6, 0x67, 0x7a, 0x04, 0x5e, 0x1f, 0x9b, 0xb7, 0xc0, 0xaf, 0xaa,
+	0x85, 0x1c, 0x07, 0x46, 0xce, 0x6a, 0x38, 0xaa, 0x0e, 0x83, 0xdf, 0x1a, 0x60, 0xa1, 0x7f, 0xb6,
+	0x99, 0x79, 0x57, 0xca, 0xf6, 0xf6, 0x04, 0x7b, 0x84, 0x69, 0xe5, 0x9e, 0x76, 0x33, 0x6b, 0xad,
+	0x90, 0xa4, 0x40, 0x6e, 0x79, 0x78, 0x83, 0x30, 0xe4, 0xcc, 0xf7, 0x2d, 0x0f, 0x86, 0xfe, 0x9c,
+	0x06, 0x70, 0x38, 0x33, 0x3c, 0x02, 0x2b, 0xea, 0x2e, 0x2c, 0x6e, 0x8b, 0x01, 0xf2, 0xe2, 0x88,
+	0xa7, 0xd8, 0xe3, 0x72, 0xfd, 0x97, 0xec, 0x6a, 0x3e, 0x62, 0x23, 0x61, 0xc8, 0x79, 0x28, 0xed,
+	0xcf, 0xa4, 0xb9, 0xa1, 0xad, 0xf0, 0x2d, 0x30, 0xa7, 0xef, 0x3a, 0x2d, 0x07, 0xea, 0xc1, 0x75,
+	0x66, 0x2d, 0xa8, 0x34, 0x3d, 0x76, 0x1a, 0x00, 0x6d, 0x50, 0xfe, 0xaa, 0x4d, 0xd2, 0x0b, 0x29,
+	0x6b, 0x40, 0x43, 0xca, 0xcd, 0x19, 0xd9, 0xf2, 0x1b, 0xf9, 0x7a, 0x1f, 0x00, 0x20, 0x67, 0x41,
+	0x5a, 0xf6, 0x30, 0xfb, 0x54, 0x9c, 0xe1, 0x07, 0x60, 0x5e, 0x74, 0xb8, 0x58, 0x9a, 0x6e, 0x3e,
+	0x33, 0x6b, 0xd7, 0x99, 0xf5, 0x30, 0xef, 0xff, 0x9e, 0x17, 0x39, 0x20, 0xc4, 0x1d, 0xb1, 0x4d,
+	0x77, 0x7c, 0x02, 0x1b, 0xa0, 0xdc, 0x4e, 0x5a, 0xc2, 0x45, 0x23, 0x4e, 0xd2, 0x73, 0x1c, 0x98,
+	0x77, 0x06, 0xcb, 0x0f, 0x00, 0x90, 0xb3, 0xa8, 0x2c, 0xfb, 0x3d, 0xc3, 0x73, 0x03, 0x94, 0x07,
+	0xb6, 0x3f, 0x5c, 0x06, 0x77, 0xe4, 0x0d, 0x95, 0x90, 0x8e, 0x3a, 0x40, 0x1b, 0xcc, 0x0a, 0x1e,
+	0x72, 0xc7, 0x95, 0xec, 0x9a, 0xe8, 0xc1, 0xbf, 0x32, 0xeb, 0xd1, 0x64, 0x9b, 0xd4, 0x91, 0xb1,
+	0xf0, 0x7d, 0x00, 0x54, 0xfd, 0x96, 0x8b, 0x95, 0x58, 0x33, 0xf6, 0x4a, 0x3e, 0x42, 0xb9, 0x0f,
+	0x39, 0x25, 0x7d, 0xd8, 0xe1, 0xe8, 0x57, 0x03, 0xac, 0x8c, 0x9c, 0x04, 0xa9, 0x9e, 0x5e, 0x58,
+	0x6e, 0x3b, 0x0d, 0xf4, 0x97, 0xef, 0x57, 0xaf, 0xcf, 0x2b, 0xd4, 0x53, 0x5b, 0xec, 0x38, 0x0d,
+	0xa0, 0x07, 0x40, 0x3e, 0x03, 0xfa, 0x52, 0x8d, 0xdb, 0x5d, 0x2a, 0x27, 0x9e, 0x67, 0x12, 0x45,
+	0xf2, 0xc3, 0xf3, 0x59, 0x50, 0x1e, 0x58, 0x25, 0xd0, 0x04, 0x77, 0x49, 0x84, 0x9b, 0x01, 0x69,
+	0x49, 0xba, 0xf7, 0x9c, 0xde, 0x11, 0x7e, 0x67, 0x00, 0x93, 0xe3, 0xd4, 0x27, 0xdc, 0x6d, 0x06,
+	0xb1, 0x77, 0xe6, 0xb6, 0x39, 0x0d, 0xe8, 0xd7, 0x98, 0xd3, 0x38, 0xd2, 0x0c, 0x3f, 0xbf, 0x35,
+	0x43, 0xbd, 0xe6, 0xc7, 0xe5, 0x45, 0xce, 0xaa, 0x72, 0xd9, 0xc2, 0x73, 0x9c, 0x3b, 0x60, 0x02,
+	0xca, 0xa2, 0xf7, 0xbc, 0x53, 0x1c, 0xf9, 0x44, 0xb6, 0xa0, 0xfc, 0x60, 0x25, 0xfb, 0x93, 0x5b,
+	0x73, 0x58, 0xcd, 0x5b, 0xb9, 0x2f, 0x1d, 0x72, 0x16, 0x42, 0xdc, 0x69, 0x48, 0x83, 0xec, 0xbb,
+	0x9f, 0x0c, 0xb0, 0x28, 0x30, 0x7d, 0x2f, 0x82, 0xd9, 0x09, 0x5e, 0x04, 0xa7, 0x7a, 0x1d, 0x9a,
+	0xc5, 0xd8, 0xc2, 0x9a, 0x59, 0xc9, 0x19, 0xfc, 0x8f, 0x97, 0x80, 0x18, 0xd5, 0xfc, 0x15, 0xf0,
+	0x11, 0x58, 0x2a, 0xa8, 0xea, 0x63, 0xa6, 0x07, 0xf0, 0xb5, 0xeb, 0xcc, 0x5a, 0x1b, 0xa1, 0xbb,
+	0x8f, 0x19, 0x72, 0x16, 0xfb, 0xf4, 0xde, 0xc3, 0xcc, 0xb6, 0x5f, 0x5e, 0x56, 0x8c, 0x57, 0x97,
+	0x15, 0xe3, 0x9f, 0xcb, 0x8a, 0xf1, 0xfd, 0x55, 0x65, 0xea, 0xd5, 0x55, 0x65, 0xea, 0x8f, 0xab,
+	0xca, 0xd4, 0x17, 0x5b, 0xc3, 0xdc, 0xe4, 0x3b, 0xbb, 0xd3, 0xf7, 0xd2, 0x96, 0x0c, 0x9b, 0x73,
+	0xf2, 0x49, 0xfc, 0xf4, 0xdf, 0x01, 0x00, 0xfb, 0xf4, 0x80, 0x00, 0x88, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -175,6 +591,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PricedDenomRates) > 0 {
+		for iNdEx := len(m.PricedDenomRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PricedDenomRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BaseGasPrices) > 0 {
+		for iNdEx := len(m.BaseGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -208,6 +652,68 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PricedDenoms != nil {
+		{
+			size, err := m.PricedDenoms.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MsgGasPriceMultipliers) > 0 {
+		for iNdEx := len(m.MsgGasPriceMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgGasPriceMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.FeeMarket != nil {
+		{
+			size, err := m.FeeMarket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for iNdEx := len(m.BypassMinFeeMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BypassMinFeeMsgTypes[iNdEx])
+			copy(dAtA[i:], m.BypassMinFeeMsgTypes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BypassMinFeeMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EnforceInDeliverTx {
+		i--
+		if m.EnforceInDeliverTx {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.MinimumGasPrices) > 0 {
 		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -225,50 +731,1103 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PricedDenomsParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+
+func (m *PricedDenomsParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PricedDenomsParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MinimumGasPrices) > 0 {
-		for _, e := range m.MinimumGasPrices {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.UpdateInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UpdateInterval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxRateAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxRateAge))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.QueryGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.QueryGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.PriceSourceContract) > 0 {
+		i -= len(m.PriceSourceContract)
+		copy(dAtA[i:], m.PriceSourceContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PriceSourceContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PricedDenomRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PricedDenomRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PricedDenomRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGasPriceMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGasPriceMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasPriceMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeMarketParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarketParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarketParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MaxGasPrices) > 0 {
+		for iNdEx := len(m.MaxGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TargetBlockUtilization.Size()
+		i -= size
+		if _, err := m.TargetBlockUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BaseGasPrices) > 0 {
+		for _, e := range m.BaseGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PricedDenomRates) > 0 {
+		for _, e := range m.PricedDenomRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EnforceInDeliverTx {
+		n += 2
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for _, s := range m.BypassMinFeeMsgTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+	}
+	if m.FeeMarket != nil {
+		l = m.FeeMarket.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MsgGasPriceMultipliers) > 0 {
+		for _, e := range m.MsgGasPriceMultipliers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PricedDenoms != nil {
+		l = m.PricedDenoms.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *PricedDenomsParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PriceSourceContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.QueryGasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.QueryGasLimit))
+	}
+	if m.MaxRateAge != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxRateAge))
+	}
+	if m.UpdateInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.UpdateInterval))
+	}
+	return n
+}
+
+func (m *PricedDenomRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.UpdatedAt != 0 {
+		n += 1 + sovGenesis(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *MsgGasPriceMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *FeeMarketParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.TargetBlockUtilization.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MaxGasPrices) > 0 {
+		for _, e := range m.MaxGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetBlockGas))
+	}
+	return n
 }
 
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseGasPrices = append(m.BaseGasPrices, types.DecCoin{})
+			if err := m.BaseGasPrices[len(m.BaseGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricedDenomRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PricedDenomRates = append(m.PricedDenomRates, PricedDenomRate{})
+			if err := m.PricedDenomRates[len(m.PricedDenomRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforceInDeliverTx", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnforceInDeliverTx = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassMinFeeMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BypassMinFeeMsgTypes = append(m.BypassMinFeeMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalBypassMinFeeMsgGasUsage", wireType)
+			}
+			m.MaxTotalBypassMinFeeMsgGasUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalBypassMinFeeMsgGasUsage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMarket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeMarket == nil {
+				m.FeeMarket = &FeeMarketParams{}
+			}
+			if err := m.FeeMarket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgGasPriceMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgGasPriceMultipliers = append(m.MsgGasPriceMultipliers, MsgGasPriceMultiplier{})
+			if err := m.MsgGasPriceMultipliers[len(m.MsgGasPriceMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricedDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PricedDenoms == nil {
+				m.PricedDenoms = &PricedDenomsParams{}
+			}
+			if err := m.PricedDenoms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PricedDenomsParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PricedDenomsParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PricedDenomsParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSourceContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSourceContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryGasLimit", wireType)
+			}
+			m.QueryGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRateAge", wireType)
+			}
+			m.MaxRateAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRateAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInterval", wireType)
+			}
+			m.UpdateInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PricedDenomRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PricedDenomRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PricedDenomRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *MsgGasPriceMultiplier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -291,17 +1850,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGasPriceMultiplier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGasPriceMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -311,22 +1870,55 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -351,7 +1943,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *FeeMarketParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -374,15 +1966,103 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeMarketParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeMarketParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetBlockUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -409,11 +2089,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
-			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MaxGasPrices = append(m.MaxGasPrices, types.DecCoin{})
+			if err := m.MaxGasPrices[len(m.MaxGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,14 @@
 package types
 
-var ParamsKey = []byte{0x00}
+var (
+	ParamsKey = []byte{0x00}
+
+	// BaseGasPricesKeyPrefix stores the fee market base gas price per denom
+	BaseGasPricesKeyPrefix = []byte{0x01}
+
+	// PricedDenomRatesKeyPrefix stores the conversion rate per priced denom
+	PricedDenomRatesKeyPrefix = []byte{0x02}
+)
 
 const (
 	// ModuleName is the name of the this module
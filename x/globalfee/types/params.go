@@ -2,6 +2,11 @@ package types
 
 import (
 	"fmt"
+	"strings"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -9,14 +14,148 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// DefaultMaxTotalBypassMinFeeMsgGasUsage is the default gas limit above which
+// a tx of bypass msgs must pay the minimum fee.
+// Lower back to 1 mil after https://github.com/cosmos/relayer/issues/1255
+const DefaultMaxTotalBypassMinFeeMsgGasUsage uint64 = 2_000_000
+
 // DefaultParams returns default parameters
 func DefaultParams() Params {
-	return Params{MinimumGasPrices: sdk.DecCoins(nil)}
+	return Params{
+		MinimumGasPrices:                sdk.DecCoins(nil),
+		EnforceInDeliverTx:              false,
+		BypassMinFeeMsgTypes:            DefaultBypassMinFeeMsgTypes(),
+		MaxTotalBypassMinFeeMsgGasUsage: DefaultMaxTotalBypassMinFeeMsgGasUsage,
+	}
+}
+
+// DefaultBypassMinFeeMsgTypes returns the IBC relayer msg types that are
+// accepted without the minimum fee by default.
+func DefaultBypassMinFeeMsgTypes() []string {
+	return []string{
+		sdk.MsgTypeURL(&ibcchanneltypes.MsgRecvPacket{}),
+		sdk.MsgTypeURL(&ibcchanneltypes.MsgAcknowledgement{}),
+		sdk.MsgTypeURL(&ibcclienttypes.MsgCreateClient{}),
+		sdk.MsgTypeURL(&ibcclienttypes.MsgUpdateClient{}),
+		sdk.MsgTypeURL(&ibcclienttypes.MsgSubmitMisbehaviour{}),
+		sdk.MsgTypeURL(&ibcclienttypes.MsgUpgradeClient{}),
+		sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}),
+		sdk.MsgTypeURL(&ibcchanneltypes.MsgTimeout{}),
+		sdk.MsgTypeURL(&ibcchanneltypes.MsgTimeoutOnClose{}),
+		sdk.MsgTypeURL(&ibcchanneltypes.MsgChannelOpenTry{}),
+		sdk.MsgTypeURL(&ibcchanneltypes.MsgChannelOpenConfirm{}),
+		sdk.MsgTypeURL(&ibcchanneltypes.MsgChannelOpenAck{}),
+	}
 }
 
 // Validate performs basic validation.
 func (p Params) Validate() error {
-	return validateMinimumGasPrices(p.MinimumGasPrices)
+	if err := validateMinimumGasPrices(p.MinimumGasPrices); err != nil {
+		return err
+	}
+
+	if err := validateBypassMinFeeMsgTypes(p.BypassMinFeeMsgTypes); err != nil {
+		return err
+	}
+
+	if err := validateMsgGasPriceMultipliers(p.MsgGasPriceMultipliers); err != nil {
+		return err
+	}
+
+	if p.PricedDenoms != nil {
+		if err := p.PricedDenoms.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if p.FeeMarket == nil {
+		return nil
+	}
+
+	return p.FeeMarket.Validate(p.MinimumGasPrices)
+}
+
+// FeeMarketEnabled returns true if the dynamic base gas prices are enabled.
+func (p Params) FeeMarketEnabled() bool {
+	return p.FeeMarket != nil && p.FeeMarket.Enabled
+}
+
+// Validate performs basic validation of the fee market params. The max gas
+// prices may not be lower than the given minimum gas prices.
+func (p FeeMarketParams) Validate(minGasPrices sdk.DecCoins) error {
+	if err := validateMinimumGasPrices(p.MaxGasPrices); err != nil {
+		return errorsmod.Wrap(err, "fee market max gas prices")
+	}
+	for _, maxPrice := range p.MaxGasPrices {
+		if maxPrice.Amount.LT(minGasPrices.AmountOf(maxPrice.Denom)) {
+			return fmt.Errorf("fee market max gas price %s is lower than the minimum gas price", maxPrice)
+		}
+	}
+
+	if !p.Enabled {
+		return nil
+	}
+
+	if err := validateFraction(p.TargetBlockUtilization); err != nil {
+		return errorsmod.Wrap(err, "fee market target block utilization")
+	}
+
+	return errorsmod.Wrap(validateFraction(p.MaxChangeRate), "fee market max change rate")
+}
+
+// validateFraction requires a value in (0, 1]
+func validateFraction(d sdk.Dec) error {
+	if d.IsNil() || !d.IsPositive() || d.GT(sdk.OneDec()) {
+		return fmt.Errorf("value must be in (0, 1]: %s", d)
+	}
+
+	return nil
+}
+
+// validateBypassMinFeeMsgTypes requires unique msg type URLs
+func validateBypassMinFeeMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected []string", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, msgType := range v {
+		if !strings.HasPrefix(msgType, "/") || len(msgType) == 1 {
+			return fmt.Errorf("invalid bypass msg type URL %q", msgType)
+		}
+		if seen[msgType] {
+			return fmt.Errorf("duplicate bypass msg type URL %s", msgType)
+		}
+		seen[msgType] = true
+	}
+
+	return nil
+}
+
+// validateMsgGasPriceMultipliers requires unique msg type URLs with a positive
+// multiplier
+func validateMsgGasPriceMultipliers(i interface{}) error {
+	v, ok := i.([]MsgGasPriceMultiplier)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected []MsgGasPriceMultiplier", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, m := range v {
+		if !strings.HasPrefix(m.MsgTypeUrl, "/") || len(m.MsgTypeUrl) == 1 {
+			return fmt.Errorf("invalid gas price multiplier msg type URL %q", m.MsgTypeUrl)
+		}
+		if seen[m.MsgTypeUrl] {
+			return fmt.Errorf("duplicate gas price multiplier msg type URL %s", m.MsgTypeUrl)
+		}
+		if m.Multiplier.IsNil() || !m.Multiplier.IsPositive() {
+			return fmt.Errorf("gas price multiplier of %s must be positive: %s", m.MsgTypeUrl, m.Multiplier)
+		}
+		seen[m.MsgTypeUrl] = true
+	}
+
+	return nil
 }
 
 // this requires the fee non-negative
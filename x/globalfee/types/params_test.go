@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -72,3 +73,262 @@ func Test_validateParams(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateBypassMinFeeMsgTypes(t *testing.T) {
+	tests := map[string]struct {
+		msgTypes  interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().BypassMinFeeMsgTypes,
+			false,
+		},
+		"empty, pass": {
+			[]string{},
+			false,
+		},
+		"wrong type, fail": {
+			"/ibc.core.client.v1.MsgUpdateClient",
+			true,
+		},
+		"missing leading slash, fail": {
+			[]string{"ibc.core.client.v1.MsgUpdateClient"},
+			true,
+		},
+		"only slash, fail": {
+			[]string{"/"},
+			true,
+		},
+		"duplicate msg types, fail": {
+			[]string{"/ibc.core.client.v1.MsgUpdateClient", "/ibc.core.client.v1.MsgUpdateClient"},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateBypassMinFeeMsgTypes(test.msgTypes)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFeeMarketParamsValidate(t *testing.T) {
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoin("photon", sdk.OneInt()))
+	validParams := func() FeeMarketParams {
+		return FeeMarketParams{
+			Enabled:                true,
+			TargetBlockUtilization: sdk.NewDecWithPrec(5, 1),
+			MaxChangeRate:          sdk.NewDecWithPrec(125, 3),
+			MaxGasPrices:           sdk.NewDecCoins(sdk.NewDecCoin("photon", sdk.NewInt(10))),
+		}
+	}
+
+	tests := map[string]struct {
+		malleate  func(p *FeeMarketParams)
+		expectErr bool
+	}{
+		"valid, pass": {
+			func(_ *FeeMarketParams) {},
+			false,
+		},
+		"disabled without rates, pass": {
+			func(p *FeeMarketParams) {
+				*p = FeeMarketParams{}
+			},
+			false,
+		},
+		"max gas price of another denom, pass": {
+			func(p *FeeMarketParams) {
+				p.MaxGasPrices = sdk.NewDecCoins(sdk.NewDecCoin("atom", sdk.OneInt()))
+			},
+			false,
+		},
+		"max gas price below minimum gas price, fail": {
+			func(p *FeeMarketParams) {
+				p.MaxGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("photon", sdk.NewDecWithPrec(5, 1))}
+			},
+			true,
+		},
+		"zero target block utilization, fail": {
+			func(p *FeeMarketParams) {
+				p.TargetBlockUtilization = sdk.ZeroDec()
+			},
+			true,
+		},
+		"target block utilization above one, fail": {
+			func(p *FeeMarketParams) {
+				p.TargetBlockUtilization = sdk.NewDecWithPrec(11, 1)
+			},
+			true,
+		},
+		"nil max change rate, fail": {
+			func(p *FeeMarketParams) {
+				p.MaxChangeRate = sdk.Dec{}
+			},
+			true,
+		},
+		"negative max change rate, fail": {
+			func(p *FeeMarketParams) {
+				p.MaxChangeRate = sdk.NewDecWithPrec(-1, 1)
+			},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := validParams()
+			test.malleate(&p)
+			err := p.Validate(minGasPrices)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateMsgGasPriceMultipliers(t *testing.T) {
+	tests := map[string]struct {
+		multipliers interface{}
+		expectErr   bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().MsgGasPriceMultipliers,
+			false,
+		},
+		"valid multipliers, pass": {
+			[]MsgGasPriceMultiplier{
+				{MsgTypeUrl: "/cosmos.gov.v1.MsgVote", Multiplier: sdk.NewDecWithPrec(5, 1)},
+				{MsgTypeUrl: "/cosmwasm.wasm.v1.MsgStoreCode", Multiplier: sdk.NewDec(10)},
+			},
+			false,
+		},
+		"wrong type, fail": {
+			[]string{"/cosmos.gov.v1.MsgVote"},
+			true,
+		},
+		"missing leading slash, fail": {
+			[]MsgGasPriceMultiplier{{MsgTypeUrl: "cosmos.gov.v1.MsgVote", Multiplier: sdk.OneDec()}},
+			true,
+		},
+		"duplicate msg types, fail": {
+			[]MsgGasPriceMultiplier{
+				{MsgTypeUrl: "/cosmos.gov.v1.MsgVote", Multiplier: sdk.OneDec()},
+				{MsgTypeUrl: "/cosmos.gov.v1.MsgVote", Multiplier: sdk.NewDec(2)},
+			},
+			true,
+		},
+		"zero multiplier, fail": {
+			[]MsgGasPriceMultiplier{{MsgTypeUrl: "/cosmos.gov.v1.MsgVote", Multiplier: sdk.ZeroDec()}},
+			true,
+		},
+		"nil multiplier, fail": {
+			[]MsgGasPriceMultiplier{{MsgTypeUrl: "/cosmos.gov.v1.MsgVote"}},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateMsgGasPriceMultipliers(test.multipliers)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPricedDenomsParamsValidate(t *testing.T) {
+	validParams := func() PricedDenomsParams {
+		return PricedDenomsParams{
+			PriceSourceContract: sdk.AccAddress("price_source_contract_address___").String(),
+			Denoms:              []string{"ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9", "factory/juno1/usd"},
+			QueryGasLimit:       100_000,
+			MaxRateAge:          600,
+			UpdateInterval:      10,
+		}
+	}
+
+	tests := map[string]struct {
+		malleate  func(p *PricedDenomsParams)
+		expectErr bool
+	}{
+		"valid, pass": {
+			func(_ *PricedDenomsParams) {},
+			false,
+		},
+		"invalid contract address, fail": {
+			func(p *PricedDenomsParams) {
+				p.PriceSourceContract = "invalid"
+			},
+			true,
+		},
+		"invalid denom, fail": {
+			func(p *PricedDenomsParams) {
+				p.Denoms = []string{"photon!"}
+			},
+			true,
+		},
+		"duplicate denoms, fail": {
+			func(p *PricedDenomsParams) {
+				p.Denoms = []string{"photon", "photon"}
+			},
+			true,
+		},
+		"too many denoms, fail": {
+			func(p *PricedDenomsParams) {
+				p.Denoms = nil
+				for i := 0; i <= MaxPricedDenoms; i++ {
+					p.Denoms = append(p.Denoms, fmt.Sprintf("photon%d", i))
+				}
+			},
+			true,
+		},
+		"zero query gas limit, fail": {
+			func(p *PricedDenomsParams) {
+				p.QueryGasLimit = 0
+			},
+			true,
+		},
+		"query gas limit too high, fail": {
+			func(p *PricedDenomsParams) {
+				p.QueryGasLimit = MaxPricedDenomsQueryGasLimit + 1
+			},
+			true,
+		},
+		"zero max rate age, fail": {
+			func(p *PricedDenomsParams) {
+				p.MaxRateAge = 0
+			},
+			true,
+		},
+		"zero update interval, fail": {
+			func(p *PricedDenomsParams) {
+				p.UpdateInterval = 0
+			},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := validParams()
+			test.malleate(&p)
+			err := p.Validate()
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
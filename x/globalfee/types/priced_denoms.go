@@ -0,0 +1,116 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxPricedDenoms caps the number of priced denoms, as each of them costs a
+	// price source query on every rate update.
+	MaxPricedDenoms = 10
+	// MaxPricedDenomsQueryGasLimit caps the gas limit of each conversion rate
+	// query.
+	MaxPricedDenomsQueryGasLimit = 1_000_000
+)
+
+// ConversionRateQuery is the query sent to the price source contract.
+type ConversionRateQuery struct {
+	ConversionRate ConversionRateRequest `json:"conversion_rate"`
+}
+
+// ConversionRateRequest asks for the conversion rate of a denom to the bond
+// denom.
+type ConversionRateRequest struct {
+	Denom string `json:"denom"`
+}
+
+// ConversionRateResponse is the answer of the price source contract.
+type ConversionRateResponse struct {
+	Rate      sdk.Dec `json:"rate"`
+	UpdatedAt int64   `json:"updated_at"`
+}
+
+// NewConversionRateQuery returns the JSON encoded conversion rate query of a
+// denom.
+func NewConversionRateQuery(denom string) ([]byte, error) {
+	return json.Marshal(ConversionRateQuery{
+		ConversionRate: ConversionRateRequest{Denom: denom},
+	})
+}
+
+// NewPricedDenomRate returns an instance of PricedDenomRate
+func NewPricedDenomRate(denom string, rate sdk.Dec, updatedAt int64) PricedDenomRate {
+	return PricedDenomRate{
+		Denom:     denom,
+		Rate:      rate,
+		UpdatedAt: updatedAt,
+	}
+}
+
+// Validate performs a stateless validation of a PricedDenomRate
+func (r PricedDenomRate) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+
+	if r.Rate.IsNil() || !r.Rate.IsPositive() {
+		return fmt.Errorf("conversion rate of %s must be positive: %s", r.Denom, r.Rate)
+	}
+
+	return nil
+}
+
+// IsStale returns true if the rate was last updated more than maxAge seconds
+// before blockTime.
+func (r PricedDenomRate) IsStale(blockTime int64, maxAge uint64) bool {
+	return blockTime-r.UpdatedAt > int64(maxAge)
+}
+
+// Validate performs basic validation of the priced denoms params.
+func (p PricedDenomsParams) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.PriceSourceContract); err != nil {
+		return fmt.Errorf("invalid price source contract address: %w", err)
+	}
+
+	if len(p.Denoms) > MaxPricedDenoms {
+		return fmt.Errorf("too many priced denoms: %d > %d", len(p.Denoms), MaxPricedDenoms)
+	}
+
+	seen := make(map[string]bool, len(p.Denoms))
+	for _, denom := range p.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate priced denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	if p.QueryGasLimit == 0 {
+		return fmt.Errorf("priced denoms query gas limit must be positive")
+	}
+
+	if p.QueryGasLimit > MaxPricedDenomsQueryGasLimit {
+		return fmt.Errorf("priced denoms query gas limit too high: %d > %d", p.QueryGasLimit, MaxPricedDenomsQueryGasLimit)
+	}
+
+	if p.MaxRateAge == 0 {
+		return fmt.Errorf("priced denoms max rate age must be positive")
+	}
+
+	if p.UpdateInterval == 0 {
+		return fmt.Errorf("priced denoms update interval must be positive")
+	}
+
+	return nil
+}
+
+// IsUpdateHeight returns true if the conversion rates are updated at the given
+// block height.
+func (p PricedDenomsParams) IsUpdateHeight(height int64) bool {
+	return height%int64(p.UpdateInterval) == 0
+}
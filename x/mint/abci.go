@@ -34,7 +34,7 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 	if nextPhase != minter.Phase {
 		// store new inflation rate by phase
-		newInflation := minter.PhaseInflationRate(nextPhase)
+		newInflation := minter.PhaseInflationRate(params, nextPhase)
 		minter.Inflation = newInflation
 		minter.Phase = nextPhase
 		minter.StartPhaseBlock = currentBlock
@@ -48,21 +48,32 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		}
 	}
 
-	// mint coins, update supply
-	mintedCoin := minter.BlockProvision(params, totalSupply)
-	mintedCoins := sdk.NewCoins(mintedCoin)
+	// the time since the previous block is only known once a block has been
+	// minted
+	var elapsed time.Duration
+	lastProvision, found := k.GetLastBlockProvision(ctx)
+	if found && ctx.BlockTime().After(lastProvision.Time) {
+		elapsed = ctx.BlockTime().Sub(lastProvision.Time)
+	}
 
-	err := k.MintCoins(ctx, mintedCoins)
-	if err != nil {
-		panic(err)
+	var mintedCoin sdk.Coin
+	if params.TimeBasedProvisions && elapsed > 0 {
+		mintedCoin = minter.TimeBasedBlockProvision(params, totalSupply, elapsed)
+	} else {
+		mintedCoin = minter.BlockProvision(params, totalSupply)
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
-	if err != nil {
+	// mint coins, update supply, and send them to the distribution recipients
+	if err := k.DistributeProvision(ctx, params, mintedCoin); err != nil {
 		panic(err)
 	}
 
+	k.SetLastBlockProvision(ctx, types.LastBlockProvision{
+		Time:    ctx.BlockTime(),
+		Elapsed: elapsed,
+		Amount:  mintedCoin.Amount,
+	})
+
 	if mintedCoin.Amount.IsInt64() {
 		defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
 	}
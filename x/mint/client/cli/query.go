@@ -26,6 +26,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
 		GetCmqQueryTargetSupply(),
+		GetCmdQueryActualInflation(),
+		GetCmdQueryMintedSupply(),
+		GetCmdQueryBurnedSupply(),
 	)
 
 	return mintingQueryCmd
@@ -145,3 +148,90 @@ func GetCmqQueryTargetSupply() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryActualInflation implements a command to return the annualized
+// inflation rate of the previous block.
+func GetCmdQueryActualInflation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "actual-inflation",
+		Short: "Query the annualized inflation rate of the previous block, given the actual block time",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryActualInflationRequest{}
+			res, err := queryClient.ActualInflation(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.ActualInflation))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMintedSupply implements a command to return the amount minted in
+// each phase.
+func GetCmdQueryMintedSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minted-supply",
+		Short: "Query the amount minted in each phase",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryMintedSupplyRequest{}
+			res, err := queryClient.MintedSupply(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBurnedSupply implements a command to return the coins burned
+// through each source.
+func GetCmdQueryBurnedSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-supply",
+		Short: "Query the coins burned through contracts, the junoburn module, tokenfactory and the mint distribution",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBurnedSupplyRequest{}
+			res, err := queryClient.BurnedSupply(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -13,6 +13,12 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, ak types.AccountKeeper,
 	if err := keeper.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
+	for _, minted := range data.MintedSupply {
+		keeper.SetMintedSupply(ctx, minted.Phase, minted.Amount)
+	}
+	for _, burned := range data.BurnedSupply {
+		keeper.SetBurnedSupply(ctx, burned.Source, burned.Coins)
+	}
 	ak.GetModuleAccount(ctx, types.ModuleName)
 }
 
@@ -20,5 +26,8 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, ak types.AccountKeeper,
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	minter := keeper.GetMinter(ctx)
 	params := keeper.GetParams(ctx)
-	return types.NewGenesisState(minter, params)
+	genesis := types.NewGenesisState(minter, params)
+	genesis.MintedSupply = keeper.GetAllMintedSupply(ctx)
+	genesis.BurnedSupply = keeper.GetAllBurnedSupply(ctx)
+	return genesis
 }
@@ -41,3 +41,46 @@ func (k Keeper) TargetSupply(c context.Context, _ *types.QueryTargetSupplyReques
 
 	return &types.QueryTargetSupplyResponse{TargetSupply: minter.TargetSupply}, nil
 }
+
+// ActualInflation returns the annualized inflation rate of the provision minted
+// in the previous block, given the actual time between blocks.
+func (k Keeper) ActualInflation(c context.Context, _ *types.QueryActualInflationRequest) (*types.QueryActualInflationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	provision, found := k.GetLastBlockProvision(ctx)
+	if !found {
+		return &types.QueryActualInflationResponse{ActualInflation: sdk.ZeroDec()}, nil
+	}
+
+	params := k.GetParams(ctx)
+	totalSupply := k.TokenSupply(ctx, params.MintDenom)
+
+	return &types.QueryActualInflationResponse{
+		ActualInflation: types.AnnualizedInflation(provision.Amount, provision.Elapsed, totalSupply),
+	}, nil
+}
+
+// MintedSupply returns the amount minted in each phase.
+func (k Keeper) MintedSupply(c context.Context, _ *types.QueryMintedSupplyRequest) (*types.QueryMintedSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	phases := k.GetAllMintedSupply(ctx)
+
+	total := sdk.ZeroInt()
+	for _, p := range phases {
+		total = total.Add(p.Amount)
+	}
+
+	return &types.QueryMintedSupplyResponse{Phases: phases, Total: total}, nil
+}
+
+// BurnedSupply returns the coins burned through each source.
+func (k Keeper) BurnedSupply(c context.Context, _ *types.QueryBurnedSupplyRequest) (*types.QueryBurnedSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sources := k.GetAllBurnedSupply(ctx)
+
+	total := sdk.NewCoins()
+	for _, s := range sources {
+		total = total.Add(s.Coins...)
+	}
+
+	return &types.QueryBurnedSupplyResponse{Sources: sources, Total: total}, nil
+}
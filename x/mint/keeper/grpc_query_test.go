@@ -3,6 +3,7 @@ package keeper_test
 import (
 	gocontext "context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -10,8 +11,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/CosmosContracts/juno/v26/app"
+	burntypes "github.com/CosmosContracts/juno/v26/x/burn/types"
+	"github.com/CosmosContracts/juno/v26/x/mint"
 	"github.com/CosmosContracts/juno/v26/x/mint/types"
 )
 
@@ -55,6 +59,147 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, app.AppKeepers.MintKeeper.GetMinter(ctx).AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCActualInflation() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	mintKeeper := app.AppKeepers.MintKeeper
+
+	params := mintKeeper.GetParams(ctx)
+	params.MintDenom = app.AppKeepers.StakingKeeper.BondDenom(ctx)
+	params.TimeBasedProvisions = true
+	suite.Require().NoError(mintKeeper.SetParams(ctx, params))
+	mintKeeper.SetMinter(ctx, types.DefaultInitialMinter())
+
+	// no block minted yet
+	res, err := queryClient.ActualInflation(gocontext.Background(), &types.QueryActualInflationRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(res.ActualInflation.IsZero())
+
+	// the first block falls back to blocks per year, later blocks mint pro rata
+	// of the time elapsed since the previous block
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	mint.BeginBlocker(ctx.WithBlockHeight(1).WithBlockTime(blockTime), mintKeeper)
+
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(blockTime.Add(10 * time.Second))
+	supply := mintKeeper.TokenSupply(ctx, params.MintDenom)
+	mint.BeginBlocker(ctx, mintKeeper)
+
+	minter := mintKeeper.GetMinter(ctx)
+	expMinted := minter.AnnualProvisions.MulInt64(int64(10 * time.Second)).QuoInt64(int64(types.YearDuration)).TruncateInt()
+	suite.Require().Equal(expMinted, mintKeeper.TokenSupply(ctx, params.MintDenom).Sub(supply))
+
+	provision, found := mintKeeper.GetLastBlockProvision(ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(10*time.Second, provision.Elapsed)
+	suite.Require().Equal(expMinted, provision.Amount)
+
+	res, err = queryClient.ActualInflation(gocontext.Background(), &types.QueryActualInflationRequest{})
+	suite.Require().NoError(err)
+	expInflation := types.AnnualizedInflation(expMinted, 10*time.Second, mintKeeper.TokenSupply(ctx, params.MintDenom))
+	suite.Require().Equal(expInflation, res.ActualInflation)
+	suite.Require().True(res.ActualInflation.IsPositive())
+}
+
+func (suite *MintTestSuite) TestDistributeProvision() {
+	app, ctx := suite.app, suite.ctx
+	mintKeeper := app.AppKeepers.MintKeeper
+
+	bondDenom := app.AppKeepers.StakingKeeper.BondDenom(ctx)
+	recipient := sdk.AccAddress([]byte("mint_recipient______"))
+	feeCollector := app.AppKeepers.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	params := mintKeeper.GetParams(ctx)
+	params.MintDenom = bondDenom
+	params.Distribution = []types.DistributionRecipient{
+		types.NewDistributionRecipient(types.RecipientFeeCollector, sdk.NewDecWithPrec(5, 1)),
+		types.NewDistributionRecipient(types.RecipientCommunityPool, sdk.NewDecWithPrec(2, 1)),
+		types.NewDistributionRecipient(types.RecipientBurn, sdk.NewDecWithPrec(1, 1)),
+		types.NewDistributionRecipient(recipient.String(), sdk.NewDecWithPrec(2, 1)),
+	}
+	suite.Require().NoError(mintKeeper.SetParams(ctx, params))
+
+	minter := mintKeeper.GetMinter(ctx)
+	minter.TargetSupply = sdk.NewInt(1_000_000_000_000_000)
+	mintKeeper.SetMinter(ctx, minter)
+
+	supply := mintKeeper.TokenSupply(ctx, bondDenom)
+	feeCollectorBalance := mintKeeper.GetBalance(ctx, feeCollector, bondDenom)
+	communityPool := app.AppKeepers.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(bondDenom)
+
+	suite.Require().NoError(mintKeeper.DistributeProvision(ctx, params, sdk.NewInt64Coin(bondDenom, 1000)))
+
+	// the burned share is not minted
+	suite.Require().Equal(supply.AddRaw(900), mintKeeper.TokenSupply(ctx, bondDenom))
+	suite.Require().Equal(feeCollectorBalance.AddRaw(500), mintKeeper.GetBalance(ctx, feeCollector, bondDenom))
+	suite.Require().Equal(communityPool.Add(sdk.NewDec(200)), app.AppKeepers.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(bondDenom))
+	suite.Require().Equal(sdk.NewInt(200), mintKeeper.GetBalance(ctx, recipient, bondDenom))
+	suite.Require().Equal(minter.TargetSupply.SubRaw(100), mintKeeper.GetMinter(ctx).TargetSupply)
+
+	// the whole provision is recorded as minted and the burned share as burned
+	minted := mintKeeper.GetMintedSupply(ctx, minter.Phase)
+	burned := mintKeeper.GetBurnedSupply(ctx, types.BurnSourceMint).AmountOf(bondDenom)
+	suite.Require().Equal(sdk.NewInt(1000), minted)
+	suite.Require().Equal(sdk.NewInt(100), burned)
+	suite.Require().Equal(mintKeeper.TokenSupply(ctx, bondDenom).Sub(supply), minted.Sub(burned))
+
+	// the burned share goes through the x/burn accounting
+	suite.Require().Equal(sdk.NewInt64Coin(bondDenom, 100), app.AppKeepers.BurnKeeper.GetBurned(ctx, bondDenom))
+
+	var distributions, burns int
+	for _, event := range ctx.EventManager().Events() {
+		switch event.Type {
+		case types.EventTypeMintDistribution:
+			distributions++
+		case burntypes.EventTypeBurn:
+			burns++
+		}
+	}
+	suite.Require().Equal(len(params.Distribution), distributions)
+	suite.Require().Equal(1, burns)
+}
+
+func (suite *MintTestSuite) TestGRPCSupplyAccounting() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	mintKeeper := app.AppKeepers.MintKeeper
+
+	mintKeeper.RecordMinted(ctx, 1, sdk.NewInt(1000))
+	mintKeeper.RecordMinted(ctx, 1, sdk.NewInt(500))
+	mintKeeper.RecordMinted(ctx, 2, sdk.NewInt(200))
+	mintKeeper.RecordMinted(ctx, 3, sdk.ZeroInt())
+
+	mintKeeper.RecordBurn(ctx, types.BurnSourceWasm, sdk.NewCoins(sdk.NewInt64Coin("ujuno", 10)))
+	mintKeeper.RecordBurn(ctx, types.BurnSourceWasm, sdk.NewCoins(sdk.NewInt64Coin("ujuno", 5), sdk.NewInt64Coin("uatom", 1)))
+	mintKeeper.RecordBurn(ctx, types.BurnSourceTokenFactory, sdk.NewCoins(sdk.NewInt64Coin("factory/juno1/token", 7)))
+
+	minted, err := queryClient.MintedSupply(gocontext.Background(), &types.QueryMintedSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PhaseMinted{
+		{Phase: 1, Amount: sdk.NewInt(1500)},
+		{Phase: 2, Amount: sdk.NewInt(200)},
+	}, minted.Phases)
+	suite.Require().Equal(sdk.NewInt(1700), minted.Total)
+
+	burned, err := queryClient.BurnedSupply(gocontext.Background(), &types.QueryBurnedSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.BurnedCoins{
+		{Source: types.BurnSourceWasm, Coins: sdk.NewCoins(sdk.NewInt64Coin("ujuno", 15), sdk.NewInt64Coin("uatom", 1))},
+		{Source: types.BurnSourceTokenFactory, Coins: sdk.NewCoins(sdk.NewInt64Coin("factory/juno1/token", 7))},
+	}, burned.Sources)
+	suite.Require().Equal(sdk.NewCoins(
+		sdk.NewInt64Coin("ujuno", 15),
+		sdk.NewInt64Coin("uatom", 1),
+		sdk.NewInt64Coin("factory/juno1/token", 7),
+	), burned.Total)
+
+	// the counters survive a genesis export and import
+	genesis := mint.ExportGenesis(ctx, mintKeeper)
+	suite.Require().NoError(types.ValidateGenesis(*genesis))
+
+	suite.SetupTest()
+	mint.InitGenesis(suite.ctx, suite.app.AppKeepers.MintKeeper, suite.app.AppKeepers.AccountKeeper, genesis)
+	suite.Require().Equal(genesis.MintedSupply, suite.app.AppKeepers.MintKeeper.GetAllMintedSupply(suite.ctx))
+	suite.Require().Equal(genesis.BurnedSupply, suite.app.AppKeepers.MintKeeper.GetAllBurnedSupply(suite.ctx))
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
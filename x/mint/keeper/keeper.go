@@ -21,6 +21,8 @@ type Keeper struct {
 	stakingKeeper    types.StakingKeeper
 	accountKeeper    types.AccountKeeper
 	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistrKeeper
+	burnRecorder     types.BurnRecorder
 	feeCollectorName string
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -35,6 +37,8 @@ func NewKeeper(
 	sk types.StakingKeeper,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
+	dk types.DistrKeeper,
+	br types.BurnRecorder,
 	feeCollectorName string,
 	authority string,
 ) Keeper {
@@ -49,6 +53,8 @@ func NewKeeper(
 		stakingKeeper:    sk,
 		bankKeeper:       bk,
 		accountKeeper:    ak,
+		distrKeeper:      dk,
+		burnRecorder:     br,
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
 	}
@@ -85,6 +91,25 @@ func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
 	store.Set(types.MinterKey, bz)
 }
 
+// GetLastBlockProvision returns the provision minted in the previous block, if any.
+func (k Keeper) GetLastBlockProvision(ctx sdk.Context) (provision types.LastBlockProvision, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastBlockProvisionKey)
+	if bz == nil {
+		return provision, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &provision)
+	return provision, true
+}
+
+// SetLastBlockProvision sets the provision minted in the previous block.
+func (k Keeper) SetLastBlockProvision(ctx sdk.Context, provision types.LastBlockProvision) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&provision)
+	store.Set(types.LastBlockProvisionKey, bz)
+}
+
 // ______________________________________________________________________
 
 // SetParams sets the x/mint module parameters.
@@ -172,3 +197,77 @@ func (k Keeper) ReduceTargetSupply(ctx sdk.Context, burnCoin sdk.Coin) error {
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// DistributeProvision mints the block provision and distributes it between the
+// recipients of the distribution params. The share of the burn recipient is not
+// minted, and the target supply of the phase is reduced by it instead. The whole
+// provision is recorded as minted and that share as burned, so the minted supply
+// less the burned supply matches the change of the token supply.
+func (k Keeper) DistributeProvision(ctx sdk.Context, params types.Params, provision sdk.Coin) error {
+	recipients := params.DistributionOrDefault()
+	shares := types.SplitProvision(recipients, provision.Amount)
+
+	toMint := provision.Amount
+	for i, r := range recipients {
+		if r.Recipient == types.RecipientBurn {
+			toMint = toMint.Sub(shares[i])
+		}
+	}
+
+	if err := k.MintCoins(ctx, sdk.NewCoins(sdk.NewCoin(provision.Denom, toMint))); err != nil {
+		return err
+	}
+	k.RecordMinted(ctx, k.GetMinter(ctx).Phase, provision.Amount)
+
+	for i, r := range recipients {
+		share := sdk.NewCoin(provision.Denom, shares[i])
+		if share.IsZero() {
+			continue
+		}
+
+		if err := k.sendShare(ctx, r.Recipient, share); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMintDistribution,
+				sdk.NewAttribute(types.AttributeKeyRecipient, r.Recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, share.Amount.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// sendShare sends a share of the minted tokens to a distribution recipient.
+func (k Keeper) sendShare(ctx sdk.Context, recipient string, share sdk.Coin) error {
+	coins := sdk.NewCoins(share)
+
+	switch recipient {
+	case types.RecipientFeeCollector:
+		return k.AddCollectedFees(ctx, coins)
+
+	case types.RecipientCommunityPool:
+		return k.distrKeeper.FundCommunityPool(ctx, coins, k.accountKeeper.GetModuleAddress(types.ModuleName))
+
+	case types.RecipientBurn:
+		// the burn is recorded through x/burn, which accounts for every burn of
+		// the chain and reduces the target supply
+		return k.burnRecorder.RecordBurn(ctx, types.BurnSourceMint, coins)
+
+	default:
+		// An account that can't receive funds, e.g. a blocked module account,
+		// must not halt the chain: its share goes to the fee collector instead.
+		addr, err := sdk.AccAddressFromBech32(recipient)
+		if err == nil {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+		}
+		if err != nil {
+			k.Logger(ctx).Error("failed to send minted tokens, sending them to the fee collector", "recipient", recipient, "error", err)
+			return k.AddCollectedFees(ctx, coins)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/armon/go-metrics"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/mint/types"
+)
+
+// GetMintedSupply returns the amount minted in a phase.
+func (k Keeper) GetMintedSupply(ctx sdk.Context, phase uint64) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintedSupplyKeyPrefix)
+	bz := store.Get(sdk.Uint64ToBigEndian(phase))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// SetMintedSupply sets the amount minted in a phase.
+func (k Keeper) SetMintedSupply(ctx sdk.Context, phase uint64, amount math.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintedSupplyKeyPrefix)
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(sdk.Uint64ToBigEndian(phase), bz)
+}
+
+// GetAllMintedSupply returns the amount minted in each phase.
+func (k Keeper) GetAllMintedSupply(ctx sdk.Context) []types.PhaseMinted {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintedSupplyKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	minted := []types.PhaseMinted{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		minted = append(minted, types.PhaseMinted{
+			Phase:  binary.BigEndian.Uint64(iterator.Key()),
+			Amount: amount,
+		})
+	}
+	return minted
+}
+
+// RecordMinted adds the amount to the amount minted in the phase.
+func (k Keeper) RecordMinted(ctx sdk.Context, phase uint64, amount math.Int) {
+	if !amount.IsPositive() {
+		return
+	}
+
+	total := k.GetMintedSupply(ctx, phase).Add(amount)
+	k.SetMintedSupply(ctx, phase, total)
+
+	if total.IsInt64() {
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, "phase_minted_tokens"},
+			float32(total.Int64()),
+			[]metrics.Label{telemetry.NewLabel("phase", strconv.FormatUint(phase, 10))},
+		)
+	}
+}
+
+// GetBurnedSupply returns the coins burned through a source.
+func (k Keeper) GetBurnedSupply(ctx sdk.Context, source string) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetBurnedSupplySourceKey(source))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	coins := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		coins = coins.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return coins
+}
+
+// SetBurnedSupply sets the coins burned through a source.
+func (k Keeper) SetBurnedSupply(ctx sdk.Context, source string, coins sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range coins {
+		bz, err := coin.Amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(types.GetBurnedSupplyKey(source, coin.Denom), bz)
+	}
+}
+
+// GetAllBurnedSupply returns the coins burned through each source.
+func (k Keeper) GetAllBurnedSupply(ctx sdk.Context) []types.BurnedCoins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnedSupplyKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	burned := []types.BurnedCoins{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		sourceLen := int(key[0])
+		source, denom := string(key[1:1+sourceLen]), string(key[1+sourceLen:])
+
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		if n := len(burned); n == 0 || burned[n-1].Source != source {
+			burned = append(burned, types.BurnedCoins{Source: source, Coins: sdk.NewCoins()})
+		}
+		burned[len(burned)-1].Coins = burned[len(burned)-1].Coins.Add(sdk.NewCoin(denom, amount))
+	}
+	return burned
+}
+
+// RecordBurn adds the coins to the coins burned through the source.
+func (k Keeper) RecordBurn(ctx sdk.Context, source string, coins sdk.Coins) {
+	if coins.IsZero() {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range coins {
+		key := types.GetBurnedSupplyKey(source, coin.Denom)
+
+		total := coin.Amount
+		if bz := store.Get(key); bz != nil {
+			var amount math.Int
+			if err := amount.Unmarshal(bz); err != nil {
+				panic(err)
+			}
+			total = total.Add(amount)
+		}
+
+		bz, err := total.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(key, bz)
+
+		if total.IsInt64() {
+			telemetry.SetGaugeWithLabels(
+				[]string{types.ModuleName, "burned_tokens", source},
+				float32(total.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", coin.Denom)},
+			)
+		}
+	}
+}
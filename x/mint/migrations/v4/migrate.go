@@ -0,0 +1,42 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/mint/types"
+)
+
+const (
+	ModuleName = "mint"
+)
+
+var ParamsKey = []byte{0x01}
+
+// Migrate migrates the x/mint module state from the consensus version 3 to
+// version 4. Specifically, it moves the phase inflation rates that were
+// hardcoded in the minter into the x/mint module params.
+func Migrate(
+	_ sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	var params types.Params
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		panic("stored mint params should not have been nil")
+	}
+
+	cdc.MustUnmarshal(bz, &params)
+
+	// Phase 1: 40%, phase 2: 20%, phase 3: 10%, then phase 4 to 12: 9% to 1%
+	params.PhaseInflationRates = types.DefaultPhaseInflationRates()
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
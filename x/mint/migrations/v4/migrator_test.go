@@ -0,0 +1,43 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/CosmosContracts/juno/v26/x/mint"
+	v4 "github.com/CosmosContracts/juno/v26/x/mint/migrations/v4"
+	"github.com/CosmosContracts/juno/v26/x/mint/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(mint.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(v4.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	store.Set(v4.ParamsKey, cdc.MustMarshal(&types.Params{
+		MintDenom:     "ujuno",
+		BlocksPerYear: 5048093,
+	}))
+	require.NoError(t, v4.Migrate(ctx, store, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v4.ParamsKey), &res))
+	require.Equal(t, "ujuno", res.MintDenom)
+	require.Equal(t, uint64(5048093), res.BlocksPerYear)
+
+	// the schedule matches the rates previously hardcoded in the minter
+	expRates := []string{"0.40", "0.20", "0.10", "0.09", "0.08", "0.07", "0.06", "0.05", "0.04", "0.03", "0.02", "0.01"}
+	require.Len(t, res.PhaseInflationRates, len(expRates))
+	for i, rate := range expRates {
+		require.True(t, sdk.MustNewDecFromStr(rate).Equal(res.PhaseInflationRates[i]), "phase %d: %s", i+1, res.PhaseInflationRates[i])
+	}
+}
@@ -29,7 +29,7 @@ var (
 	_ module.AppModuleSimulation = AppModule{}
 )
 
-const ConsensusVersion = 3
+const ConsensusVersion = 4
 
 // AppModuleBasic defines the basic application module used by the mint module.
 type AppModuleBasic struct {
@@ -134,6 +134,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the mint module. It returns
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Named recipients of the minted tokens. Any other recipient is an account
+// address.
+const (
+	// RecipientFeeCollector sends the share to the fee collector, which pays
+	// the stakers.
+	RecipientFeeCollector = "fee_collector"
+	// RecipientCommunityPool funds the community pool with the share.
+	RecipientCommunityPool = "community_pool"
+	// RecipientBurn burns the share. The share is not minted, and the target
+	// supply of the phase is reduced by it.
+	RecipientBurn = "burn"
+)
+
+// NewDistributionRecipient returns a new DistributionRecipient.
+func NewDistributionRecipient(recipient string, weight sdk.Dec) DistributionRecipient {
+	return DistributionRecipient{
+		Recipient: recipient,
+		Weight:    weight,
+	}
+}
+
+// DefaultDistribution sends all minted tokens to the fee collector.
+func DefaultDistribution() []DistributionRecipient {
+	return []DistributionRecipient{
+		NewDistributionRecipient(RecipientFeeCollector, sdk.OneDec()),
+	}
+}
+
+// IsNamedRecipient returns true if the recipient is a named recipient rather
+// than an account address.
+func IsNamedRecipient(recipient string) bool {
+	switch recipient {
+	case RecipientFeeCollector, RecipientCommunityPool, RecipientBurn:
+		return true
+	default:
+		return false
+	}
+}
+
+// DistributionOrDefault returns the recipients of the minted tokens, or the
+// default distribution when none are set.
+func (p Params) DistributionOrDefault() []DistributionRecipient {
+	if len(p.Distribution) == 0 {
+		return DefaultDistribution()
+	}
+	return p.Distribution
+}
+
+// SplitProvision splits the amount between the recipients by weight. Each
+// share is truncated, and the last recipient receives the remainder.
+func SplitProvision(recipients []DistributionRecipient, amount math.Int) []math.Int {
+	shares := make([]math.Int, len(recipients))
+	remaining := amount
+	for i, r := range recipients {
+		if i == len(recipients)-1 {
+			shares[i] = remaining
+			break
+		}
+
+		shares[i] = r.Weight.MulInt(amount).TruncateInt()
+		remaining = remaining.Sub(shares[i])
+	}
+	return shares
+}
+
+func validateDistribution(i interface{}) error {
+	v, ok := i.([]DistributionRecipient)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) == 0 {
+		return nil
+	}
+
+	total := sdk.ZeroDec()
+	seen := make(map[string]bool, len(v))
+	for _, r := range v {
+		if !IsNamedRecipient(r.Recipient) {
+			if _, err := sdk.AccAddressFromBech32(r.Recipient); err != nil {
+				return fmt.Errorf("invalid distribution recipient %q: must be %s, %s, %s or an account address",
+					r.Recipient, RecipientFeeCollector, RecipientCommunityPool, RecipientBurn)
+			}
+		}
+
+		if seen[r.Recipient] {
+			return fmt.Errorf("duplicate distribution recipient: %s", r.Recipient)
+		}
+		seen[r.Recipient] = true
+
+		if r.Weight.IsNil() || !r.Weight.IsPositive() {
+			return fmt.Errorf("weight of distribution recipient %s must be positive: %s", r.Recipient, r.Weight)
+		}
+		total = total.Add(r.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("distribution weights must sum to 1: %s", total)
+	}
+
+	return nil
+}
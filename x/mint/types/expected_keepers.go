@@ -32,6 +32,17 @@ type BankKeeper interface {
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
+// DistrKeeper defines the contract needed to fund the community pool.
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// BurnRecorder defines the contract needed to record the share of the minted
+// tokens distributed to the burn recipient.
+type BurnRecorder interface {
+	RecordBurn(ctx sdk.Context, source string, coins sdk.Coins) error
+}
+
 // NB: I may have introduced a bug here.  Please verify that this is functioning as intended. - Jacob
 // NB: It compiles now but something is not right here.
 // NB: SupplyI left the bank module in 0.43.0
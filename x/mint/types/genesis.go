@@ -23,5 +23,9 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if err := ValidateSupplyAccounting(data.MintedSupply, data.BurnedSupply); err != nil {
+		return err
+	}
+
 	return ValidateMinter(data.Minter)
 }
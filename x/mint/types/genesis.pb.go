@@ -29,6 +29,10 @@ type GenesisState struct {
 	Minter Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// minted_supply is the amount minted in each phase.
+	MintedSupply []PhaseMinted `protobuf:"bytes,3,rep,name=minted_supply,json=mintedSupply,proto3" json:"minted_supply"`
+	// burned_supply is the coins burned through each source.
+	BurnedSupply []BurnedCoins `protobuf:"bytes,4,rep,name=burned_supply,json=burnedSupply,proto3" json:"burned_supply"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +82,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetMintedSupply() []PhaseMinted {
+	if m != nil {
+		return m.MintedSupply
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBurnedSupply() []BurnedCoins {
+	if m != nil {
+		return m.BurnedSupply
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "juno.mint.GenesisState")
 }
@@ -85,20 +103,24 @@ func init() {
 func init() { proto.RegisterFile("juno/mint/genesis.proto", fileDescriptor_6ca2177ac9d4c4e4) }
 
 var fileDescriptor_6ca2177ac9d4c4e4 = []byte{
-	// 211 bytes of a gzipped FileDescriptorProto
+	// 275 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcf, 0x2a, 0xcd, 0xcb,
 	0xd7, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28,
 	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x04, 0x49, 0xe8, 0x81, 0x24, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0xa2, 0xfa, 0x20, 0x16, 0x44, 0x81, 0x94, 0x08, 0x42, 0x27, 0x88, 0x80, 0x88, 0x2a, 0x15,
-	0x70, 0xf1, 0xb8, 0x43, 0xcc, 0x09, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xd2, 0xe7, 0x62, 0x03, 0xc9,
-	0xa6, 0x16, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x09, 0xea, 0xc1, 0xcd, 0xd5, 0xf3, 0x05,
-	0x4b, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55, 0x06, 0xd2, 0x50, 0x90, 0x58, 0x94,
-	0x98, 0x5b, 0x2c, 0xc1, 0x84, 0xa1, 0x21, 0x00, 0x2c, 0x01, 0xd3, 0x00, 0x51, 0xe6, 0xe4, 0x76,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x3a, 0xe9, 0x99, 0x25, 0x19, 0xa5,
-	0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xce, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xce, 0xf9, 0x79, 0x25,
-	0x45, 0x89, 0xc9, 0x25, 0xc5, 0xfa, 0x60, 0xc7, 0x57, 0x40, 0x9c, 0x5f, 0x52, 0x59, 0x90, 0x5a,
-	0x9c, 0xc4, 0x06, 0xf6, 0x80, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x38, 0xb2, 0x67, 0x12,
+	0xc1, 0xa2, 0xfa, 0x20, 0x16, 0x44, 0x81, 0x94, 0x08, 0x42, 0x27, 0x88, 0x80, 0x88, 0x2a, 0x7d,
+	0x63, 0xe4, 0xe2, 0x71, 0x87, 0x18, 0x14, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xa4, 0xcf, 0xc5, 0x06,
+	0x92, 0x4e, 0x2d, 0x92, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x12, 0xd4, 0x83, 0x1b, 0xac, 0xe7,
+	0x0b, 0x96, 0x70, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0x0c, 0xa4, 0xa1, 0x20, 0xb1,
+	0x28, 0x31, 0xb7, 0x58, 0x82, 0x09, 0x43, 0x43, 0x00, 0x58, 0x02, 0xa6, 0x01, 0xa2, 0x4c, 0xc8,
+	0x91, 0x8b, 0x17, 0xac, 0x35, 0x25, 0xbe, 0xb8, 0xb4, 0xa0, 0x20, 0xa7, 0x52, 0x82, 0x59, 0x81,
+	0x59, 0x83, 0xdb, 0x48, 0x0c, 0x59, 0x5f, 0x46, 0x62, 0x71, 0x2a, 0xd8, 0xb6, 0x14, 0xa8, 0x66,
+	0x1e, 0x88, 0x96, 0x60, 0xb0, 0x0e, 0x90, 0x11, 0x49, 0xa5, 0x45, 0x79, 0x08, 0x23, 0x58, 0x30,
+	0x8c, 0x70, 0x02, 0xcb, 0x3b, 0xe7, 0x67, 0xe6, 0xc1, 0xec, 0xe7, 0x81, 0x68, 0x81, 0x18, 0xe1,
+	0xe4, 0x76, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
+	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x3a, 0xe9, 0x99, 0x25,
+	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xce, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xce, 0xf9,
+	0x79, 0x25, 0x45, 0x89, 0xc9, 0x25, 0xc5, 0xfa, 0xe0, 0x30, 0xac, 0x80, 0x84, 0x62, 0x49, 0x65,
+	0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x1c, 0x8d, 0x01, 0x03, 0x00, 0xf5, 0xe7, 0xe2, 0xf8, 0x99,
 	0x01, 0x00, 0x00,
 }
 
@@ -122,6 +144,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BurnedSupply) > 0 {
+		for iNdEx := len(m.BurnedSupply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedSupply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MintedSupply) > 0 {
+		for iNdEx := len(m.MintedSupply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintedSupply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -166,6 +216,18 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MintedSupply) > 0 {
+		for _, e := range m.MintedSupply {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BurnedSupply) > 0 {
+		for _, e := range m.BurnedSupply {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -270,6 +332,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintedSupply = append(m.MintedSupply, PhaseMinted{})
+			if err := m.MintedSupply[len(m.MintedSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedSupply = append(m.BurnedSupply, BurnedCoins{})
+			if err := m.BurnedSupply[len(m.BurnedSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
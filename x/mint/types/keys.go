@@ -4,6 +4,12 @@ var (
 	// MinterKey is the key to use for the keeper store.
 	MinterKey = []byte{0x00}
 	ParamsKey = []byte{0x01}
+	// LastBlockProvisionKey is the key of the provision minted in the previous block.
+	LastBlockProvisionKey = []byte{0x02}
+	// MintedSupplyKeyPrefix is the prefix of the amount minted in each phase.
+	MintedSupplyKeyPrefix = []byte{0x03}
+	// BurnedSupplyKeyPrefix is the prefix of the coins burned through each source.
+	BurnedSupplyKeyPrefix = []byte{0x04}
 )
 
 const (
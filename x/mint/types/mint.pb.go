@@ -6,17 +6,23 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -88,6 +94,15 @@ type Params struct {
 	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,2,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty" yaml:"blocks_per_year"`
+	// annual inflation rate of each phase, starting with phase 1. Minting stops
+	// once the last phase ends.
+	PhaseInflationRates []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,rep,name=phase_inflation_rates,json=phaseInflationRates,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"phase_inflation_rates" yaml:"phase_inflation_rates"`
+	// mint the annual provisions pro rata of the time elapsed since the
+	// previous block instead of dividing them by blocks_per_year
+	TimeBasedProvisions bool `protobuf:"varint,4,opt,name=time_based_provisions,json=timeBasedProvisions,proto3" json:"time_based_provisions,omitempty" yaml:"time_based_provisions"`
+	// recipients of the minted tokens, with weights summing to 1. All minted
+	// tokens go to the fee collector when empty.
+	Distribution []DistributionRecipient `protobuf:"bytes,5,rep,name=distribution,proto3" json:"distribution"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -136,41 +151,286 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetTimeBasedProvisions() bool {
+	if m != nil {
+		return m.TimeBasedProvisions
+	}
+	return false
+}
+
+func (m *Params) GetDistribution() []DistributionRecipient {
+	if m != nil {
+		return m.Distribution
+	}
+	return nil
+}
+
+// DistributionRecipient is a recipient of a share of the minted tokens.
+type DistributionRecipient struct {
+	// fee_collector, community_pool, burn or an account address
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// share of the minted tokens
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *DistributionRecipient) Reset()         { *m = DistributionRecipient{} }
+func (m *DistributionRecipient) String() string { return proto.CompactTextString(m) }
+func (*DistributionRecipient) ProtoMessage()    {}
+func (*DistributionRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0bccce3b583aa44, []int{2}
+}
+func (m *DistributionRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionRecipient.Merge(m, src)
+}
+func (m *DistributionRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionRecipient proto.InternalMessageInfo
+
+func (m *DistributionRecipient) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// LastBlockProvision holds the provision minted in the previous block.
+type LastBlockProvision struct {
+	// time of the block
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// time elapsed since the block before it
+	Elapsed time.Duration `protobuf:"bytes,2,opt,name=elapsed,proto3,stdduration" json:"elapsed"`
+	// amount minted in the block
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *LastBlockProvision) Reset()         { *m = LastBlockProvision{} }
+func (m *LastBlockProvision) String() string { return proto.CompactTextString(m) }
+func (*LastBlockProvision) ProtoMessage()    {}
+func (*LastBlockProvision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0bccce3b583aa44, []int{3}
+}
+func (m *LastBlockProvision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastBlockProvision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastBlockProvision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LastBlockProvision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastBlockProvision.Merge(m, src)
+}
+func (m *LastBlockProvision) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastBlockProvision) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastBlockProvision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastBlockProvision proto.InternalMessageInfo
+
+func (m *LastBlockProvision) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *LastBlockProvision) GetElapsed() time.Duration {
+	if m != nil {
+		return m.Elapsed
+	}
+	return 0
+}
+
+// PhaseMinted holds the amount minted during a phase.
+type PhaseMinted struct {
+	Phase  uint64                                 `protobuf:"varint,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *PhaseMinted) Reset()         { *m = PhaseMinted{} }
+func (m *PhaseMinted) String() string { return proto.CompactTextString(m) }
+func (*PhaseMinted) ProtoMessage()    {}
+func (*PhaseMinted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0bccce3b583aa44, []int{4}
+}
+func (m *PhaseMinted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PhaseMinted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PhaseMinted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PhaseMinted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PhaseMinted.Merge(m, src)
+}
+func (m *PhaseMinted) XXX_Size() int {
+	return m.Size()
+}
+func (m *PhaseMinted) XXX_DiscardUnknown() {
+	xxx_messageInfo_PhaseMinted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PhaseMinted proto.InternalMessageInfo
+
+func (m *PhaseMinted) GetPhase() uint64 {
+	if m != nil {
+		return m.Phase
+	}
+	return 0
+}
+
+// BurnedCoins holds the coins burned through a source: wasm, junoburn,
+// tokenfactory or mint.
+type BurnedCoins struct {
+	Source string                                   `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Coins  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *BurnedCoins) Reset()         { *m = BurnedCoins{} }
+func (m *BurnedCoins) String() string { return proto.CompactTextString(m) }
+func (*BurnedCoins) ProtoMessage()    {}
+func (*BurnedCoins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0bccce3b583aa44, []int{5}
+}
+func (m *BurnedCoins) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnedCoins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnedCoins.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnedCoins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnedCoins.Merge(m, src)
+}
+func (m *BurnedCoins) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnedCoins) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnedCoins.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnedCoins proto.InternalMessageInfo
+
+func (m *BurnedCoins) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *BurnedCoins) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "juno.mint.Minter")
 	proto.RegisterType((*Params)(nil), "juno.mint.Params")
+	proto.RegisterType((*DistributionRecipient)(nil), "juno.mint.DistributionRecipient")
+	proto.RegisterType((*LastBlockProvision)(nil), "juno.mint.LastBlockProvision")
+	proto.RegisterType((*PhaseMinted)(nil), "juno.mint.PhaseMinted")
+	proto.RegisterType((*BurnedCoins)(nil), "juno.mint.BurnedCoins")
 }
 
 func init() { proto.RegisterFile("juno/mint/mint.proto", fileDescriptor_e0bccce3b583aa44) }
 
 var fileDescriptor_e0bccce3b583aa44 = []byte{
-	// 410 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0xae, 0xd3, 0x30,
-	0x14, 0x86, 0x13, 0x6e, 0x6f, 0xa5, 0x58, 0x5c, 0x95, 0x5a, 0x11, 0x8a, 0x2a, 0x48, 0xaa, 0x0c,
-	0xa8, 0x03, 0x24, 0x03, 0x5b, 0xc7, 0xb4, 0xaa, 0x00, 0x81, 0x14, 0x85, 0x09, 0x96, 0xc8, 0x4d,
-	0x4d, 0x1a, 0x9a, 0xd8, 0xc1, 0x76, 0x80, 0xbc, 0x05, 0x23, 0x03, 0x03, 0x8f, 0xd3, 0xb1, 0x23,
-	0x62, 0x88, 0x50, 0xfb, 0x06, 0x7d, 0x02, 0x64, 0x1b, 0x51, 0xb8, 0x9d, 0xba, 0x24, 0xfe, 0x3f,
-	0xfd, 0x3a, 0xbf, 0x8f, 0xcf, 0x01, 0xf6, 0xfb, 0x86, 0xd0, 0xb0, 0x2a, 0x88, 0x50, 0x9f, 0xa0,
-	0x66, 0x54, 0x50, 0x68, 0x49, 0x1a, 0x48, 0x30, 0xb2, 0x73, 0x9a, 0x53, 0x45, 0x43, 0x79, 0xd2,
-	0x06, 0xff, 0xdb, 0x15, 0xe8, 0xbf, 0x2a, 0x88, 0xc0, 0x0c, 0xbe, 0x04, 0x56, 0x41, 0xde, 0x95,
-	0x48, 0x14, 0x94, 0x38, 0xe6, 0xd8, 0x9c, 0x58, 0x51, 0xb0, 0xed, 0x3c, 0xe3, 0x67, 0xe7, 0x3d,
-	0xca, 0x0b, 0xb1, 0x6e, 0x96, 0x41, 0x46, 0xab, 0x30, 0xa3, 0xbc, 0xa2, 0xfc, 0xcf, 0xef, 0x09,
-	0x5f, 0x6d, 0x42, 0xd1, 0xd6, 0x98, 0x07, 0x73, 0x9c, 0x25, 0xa7, 0x02, 0xd0, 0x06, 0xd7, 0xf5,
-	0x1a, 0x71, 0xec, 0xdc, 0x19, 0x9b, 0x93, 0x5e, 0xa2, 0x05, 0x7c, 0x06, 0x86, 0x5c, 0x20, 0x26,
-	0x52, 0x25, 0xd3, 0x65, 0x49, 0xb3, 0x8d, 0x73, 0x25, 0x1d, 0xd1, 0x83, 0x63, 0xe7, 0x39, 0x2d,
-	0xaa, 0xca, 0xa9, 0x7f, 0x66, 0xf1, 0x93, 0x81, 0x62, 0xb1, 0x44, 0x91, 0x24, 0xf0, 0x13, 0x18,
-	0x22, 0x42, 0x1a, 0x54, 0xa6, 0x35, 0xa3, 0x1f, 0x0b, 0x5e, 0x50, 0xc2, 0x9d, 0x9e, 0xba, 0xf5,
-	0x8b, 0xcb, 0x6e, 0x7d, 0xca, 0x3d, 0x2b, 0xe8, 0x27, 0xf7, 0x34, 0x8b, 0xff, 0x22, 0xb8, 0x01,
-	0x37, 0x02, 0xb1, 0x1c, 0x8b, 0x94, 0x37, 0x75, 0x5d, 0xb6, 0xce, 0xb5, 0x0a, 0x5d, 0x5c, 0x10,
-	0xfa, 0x9c, 0x88, 0x63, 0xe7, 0xd9, 0x3a, 0xf4, 0xbf, 0x62, 0x7e, 0x72, 0x57, 0xeb, 0xd7, 0x5a,
-	0x7e, 0x00, 0xfd, 0x18, 0x31, 0x54, 0x71, 0xf8, 0x10, 0x00, 0x39, 0xc6, 0x74, 0x85, 0x09, 0xad,
-	0xf4, 0x78, 0x12, 0x4b, 0x92, 0xb9, 0x04, 0x30, 0x02, 0x03, 0xf5, 0x52, 0x3c, 0xad, 0x31, 0x4b,
-	0x5b, 0x8c, 0x98, 0x7e, 0xf8, 0x68, 0x74, 0xec, 0xbc, 0xfb, 0x3a, 0xe9, 0x96, 0xc1, 0x4f, 0x6e,
-	0x34, 0x89, 0x31, 0x7b, 0x83, 0x11, 0x9b, 0xf6, 0xbe, 0x7e, 0xf7, 0x8c, 0x68, 0xb1, 0xdd, 0xbb,
-	0xe6, 0x6e, 0xef, 0x9a, 0xbf, 0xf6, 0xae, 0xf9, 0xe5, 0xe0, 0x1a, 0xbb, 0x83, 0x6b, 0xfc, 0x38,
-	0xb8, 0xc6, 0xdb, 0xc7, 0xff, 0xb4, 0x36, 0x53, 0x3d, 0xcd, 0x28, 0x11, 0x0c, 0x65, 0x82, 0x87,
-	0x6a, 0xfb, 0x3e, 0xeb, 0xfd, 0x53, 0x4d, 0x2e, 0xfb, 0x6a, 0xc1, 0x9e, 0xfe, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0xcf, 0xa2, 0xd3, 0xd4, 0x99, 0x02, 0x00, 0x00,
+	// 761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbd, 0x6e, 0xf3, 0x36,
+	0x14, 0xb5, 0xfc, 0xd7, 0xcf, 0xf4, 0x17, 0xa4, 0x61, 0x9c, 0x40, 0x31, 0x52, 0xc9, 0xd0, 0x50,
+	0x78, 0x68, 0xa5, 0x26, 0x5d, 0x8a, 0x00, 0x5d, 0x14, 0x23, 0x68, 0x82, 0xb4, 0x30, 0xd4, 0x2c,
+	0xed, 0x22, 0xd0, 0x12, 0xe3, 0xa8, 0xb6, 0x48, 0x81, 0xa4, 0x92, 0x7a, 0xe8, 0xd2, 0xa9, 0x63,
+	0xc6, 0x0c, 0x1d, 0x3a, 0xf7, 0x49, 0x32, 0x66, 0x29, 0x50, 0x74, 0x70, 0x8a, 0xe4, 0x0d, 0xf2,
+	0x04, 0x05, 0x49, 0xf9, 0x27, 0x3f, 0x43, 0xdd, 0x2e, 0xb6, 0xee, 0x11, 0xcf, 0x39, 0x97, 0xbc,
+	0xbc, 0x57, 0xa0, 0xf5, 0x43, 0x4e, 0xa8, 0x97, 0x26, 0x44, 0xa8, 0x1f, 0x37, 0x63, 0x54, 0x50,
+	0xd8, 0x90, 0xa8, 0x2b, 0x81, 0xb6, 0x15, 0x51, 0x9e, 0x52, 0xee, 0x0d, 0x10, 0xc7, 0xde, 0xe5,
+	0xde, 0x00, 0x0b, 0xb4, 0xe7, 0x45, 0x34, 0x21, 0x7a, 0x69, 0xbb, 0x35, 0xa4, 0x43, 0xaa, 0x1e,
+	0x3d, 0xf9, 0x54, 0xa0, 0xd6, 0x90, 0xd2, 0xe1, 0x18, 0x7b, 0x2a, 0x1a, 0xe4, 0xe7, 0x5e, 0x9c,
+	0x33, 0x24, 0x12, 0x3a, 0x63, 0xd9, 0x2f, 0xdf, 0x8b, 0x24, 0xc5, 0x5c, 0xa0, 0x34, 0xd3, 0x0b,
+	0x9c, 0x5f, 0x2b, 0xa0, 0xfe, 0x75, 0x42, 0x04, 0x66, 0xf0, 0x14, 0x34, 0x12, 0x72, 0x3e, 0x56,
+	0x74, 0xd3, 0xe8, 0x18, 0xdd, 0x86, 0xef, 0xde, 0x4e, 0xed, 0xd2, 0x5f, 0x53, 0xfb, 0xe3, 0x61,
+	0x22, 0x2e, 0xf2, 0x81, 0x1b, 0xd1, 0xd4, 0x2b, 0xf2, 0xd4, 0x7f, 0x9f, 0xf2, 0x78, 0xe4, 0x89,
+	0x49, 0x86, 0xb9, 0xdb, 0xc3, 0x51, 0xb0, 0x10, 0x80, 0x2d, 0x50, 0xcb, 0x2e, 0x10, 0xc7, 0x66,
+	0xb9, 0x63, 0x74, 0xab, 0x81, 0x0e, 0xe0, 0x57, 0x60, 0x83, 0x0b, 0xc4, 0x44, 0xa8, 0xc2, 0x70,
+	0x30, 0xa6, 0xd1, 0xc8, 0xac, 0xc8, 0x15, 0xfe, 0xee, 0xd3, 0xd4, 0x36, 0x27, 0x28, 0x1d, 0x1f,
+	0x38, 0xaf, 0x96, 0x38, 0xc1, 0xba, 0xc2, 0xfa, 0x12, 0xf2, 0x25, 0x02, 0xaf, 0xc0, 0x06, 0x22,
+	0x24, 0x47, 0xe3, 0x30, 0x63, 0xf4, 0x32, 0xe1, 0x09, 0x25, 0xdc, 0xac, 0xaa, 0xac, 0x4f, 0x56,
+	0xcb, 0x7a, 0xe1, 0xfb, 0x4a, 0xd0, 0x09, 0x3e, 0xd4, 0x58, 0x7f, 0x0e, 0xc1, 0x11, 0x58, 0x13,
+	0x88, 0x0d, 0xb1, 0x08, 0x79, 0x9e, 0x65, 0xe3, 0x89, 0x59, 0x53, 0xa6, 0x47, 0x2b, 0x98, 0x1e,
+	0x13, 0xf1, 0x34, 0xb5, 0x5b, 0xda, 0xf4, 0x99, 0x98, 0x13, 0xbc, 0xd7, 0xf1, 0xb7, 0x3a, 0xbc,
+	0xa9, 0x80, 0x7a, 0x1f, 0x31, 0x94, 0x72, 0xf8, 0x11, 0x00, 0xf2, 0xa2, 0x84, 0x31, 0x26, 0x34,
+	0xd5, 0xf5, 0x09, 0x1a, 0x12, 0xe9, 0x49, 0x00, 0xfa, 0x60, 0x5d, 0x1d, 0x15, 0x0f, 0x33, 0xcc,
+	0xc2, 0x09, 0x46, 0x4c, 0x9f, 0xbc, 0xdf, 0x7e, 0x9a, 0xda, 0xdb, 0xda, 0xea, 0xc5, 0x02, 0x27,
+	0x58, 0xd3, 0x48, 0x1f, 0xb3, 0xef, 0x30, 0x62, 0xf0, 0x67, 0x03, 0x6c, 0xe9, 0x53, 0x9f, 0xd7,
+	0x31, 0x64, 0x48, 0x60, 0x6e, 0x56, 0x3a, 0x95, 0x6e, 0xc3, 0xff, 0x66, 0xe5, 0x83, 0xdd, 0xd5,
+	0xc6, 0x6f, 0x8a, 0x3a, 0xc1, 0xa6, 0xc2, 0x8f, 0x67, 0x70, 0x20, 0x51, 0x78, 0x06, 0xb6, 0xe4,
+	0x25, 0x0d, 0x65, 0x23, 0xc4, 0x2f, 0x8b, 0xfb, 0xce, 0xef, 0x2c, 0x54, 0xdf, 0x5c, 0xe6, 0x04,
+	0x9b, 0x12, 0xf7, 0x25, 0xbc, 0x54, 0xb5, 0x13, 0xf0, 0x3e, 0x4e, 0xb8, 0x60, 0xc9, 0x20, 0x57,
+	0xf7, 0xbb, 0xd6, 0xa9, 0x74, 0x9b, 0xfb, 0x1d, 0x77, 0xde, 0x80, 0x6e, 0x6f, 0xe9, 0x75, 0x80,
+	0xa3, 0x24, 0x4b, 0x30, 0x11, 0x7e, 0x55, 0x6e, 0x39, 0x78, 0xc6, 0x3d, 0xa8, 0xde, 0xfc, 0x66,
+	0x97, 0x9c, 0x9f, 0xc0, 0xd6, 0x9b, 0x14, 0xb8, 0x0b, 0x1a, 0x6c, 0x16, 0xcc, 0xea, 0x34, 0x07,
+	0xe0, 0x11, 0xa8, 0x5f, 0xe1, 0x64, 0x78, 0x21, 0xcc, 0xf2, 0x7f, 0x6a, 0xb1, 0x82, 0xed, 0xfc,
+	0x61, 0x00, 0x78, 0x8a, 0xb8, 0x50, 0xdd, 0x30, 0xdf, 0x28, 0xfc, 0x02, 0x54, 0xe5, 0xf6, 0x95,
+	0x6f, 0x73, 0xbf, 0xed, 0xea, 0xfe, 0x77, 0x67, 0xfd, 0xef, 0x9e, 0xcd, 0xfa, 0xdf, 0x7f, 0x27,
+	0x8d, 0xaf, 0xef, 0x6d, 0x23, 0x50, 0x0c, 0xf8, 0x25, 0xf8, 0x00, 0x8f, 0x51, 0xc6, 0x71, 0xac,
+	0x32, 0x6b, 0xee, 0xef, 0xbc, 0x22, 0xf7, 0x8a, 0xe1, 0xa2, 0xb9, 0x37, 0x92, 0x3b, 0xe3, 0xc8,
+	0x7d, 0xa1, 0x94, 0xe6, 0x44, 0x98, 0x95, 0x95, 0xf7, 0x75, 0x4c, 0x44, 0x50, 0xb0, 0x9d, 0x11,
+	0x68, 0xaa, 0x2e, 0x57, 0x43, 0x29, 0x5e, 0x8c, 0x11, 0x63, 0x79, 0x8c, 0x2c, 0xcc, 0xca, 0xff,
+	0xcb, 0xec, 0x17, 0x03, 0x34, 0xfd, 0x9c, 0x11, 0x1c, 0x1f, 0xd2, 0x84, 0x70, 0xb8, 0x0d, 0xea,
+	0x9c, 0xe6, 0x2c, 0xc2, 0x45, 0xdd, 0x8a, 0x08, 0x22, 0x50, 0x93, 0xa3, 0x98, 0x9b, 0x65, 0x75,
+	0x6d, 0x76, 0x5c, 0xad, 0xea, 0xca, 0xcb, 0xe7, 0x16, 0xc3, 0xda, 0x95, 0x12, 0xfe, 0x67, 0x32,
+	0x93, 0xdf, 0xef, 0xed, 0xee, 0xbf, 0xc8, 0x44, 0x79, 0x06, 0x5a, 0xd9, 0x3f, 0xba, 0x7d, 0xb0,
+	0x8c, 0xbb, 0x07, 0xcb, 0xf8, 0xfb, 0xc1, 0x32, 0xae, 0x1f, 0xad, 0xd2, 0xdd, 0xa3, 0x55, 0xfa,
+	0xf3, 0xd1, 0x2a, 0x7d, 0xff, 0xc9, 0x92, 0xd4, 0xa1, 0xd2, 0x38, 0xa4, 0x44, 0x30, 0x14, 0x09,
+	0xee, 0xa9, 0xaf, 0xca, 0x8f, 0xfa, 0xbb, 0xa2, 0x44, 0x07, 0x75, 0x55, 0xad, 0xcf, 0xff, 0x19,
+	0x00, 0x6e, 0xdf, 0x20, 0x0a, 0x71, 0x06, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -256,6 +516,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Distribution) > 0 {
+		for iNdEx := len(m.Distribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TimeBasedProvisions {
+		i--
+		if m.TimeBasedProvisions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PhaseInflationRates) > 0 {
+		for iNdEx := len(m.PhaseInflationRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PhaseInflationRates[iNdEx].Size()
+				i -= size
+				if _, err := m.PhaseInflationRates[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -271,6 +569,177 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DistributionRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastBlockProvision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastBlockProvision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastBlockProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Elapsed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Elapsed):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMint(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PhaseMinted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PhaseMinted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PhaseMinted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Phase != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnedCoins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnedCoins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnedCoins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -296,36 +765,495 @@ func (m *Minter) Size() (n int) {
 	if m.StartPhaseBlock != 0 {
 		n += 1 + sovMint(uint64(m.StartPhaseBlock))
 	}
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.TargetSupply.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
-}
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.TargetSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	}
+	if len(m.PhaseInflationRates) > 0 {
+		for _, e := range m.PhaseInflationRates {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.TimeBasedProvisions {
+		n += 2
+	}
+	if len(m.Distribution) > 0 {
+		for _, e := range m.Distribution {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *LastBlockProvision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Elapsed)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *PhaseMinted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		n += 1 + sovMint(uint64(m.Phase))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *BurnedCoins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPhaseBlock", wireType)
+			}
+			m.StartPhaseBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartPhaseBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseInflationRates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.PhaseInflationRates = append(m.PhaseInflationRates, v)
+			if err := m.PhaseInflationRates[len(m.PhaseInflationRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeBasedProvisions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeBasedProvisions = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distribution = append(m.Distribution, DistributionRecipient{})
+			if err := m.Distribution[len(m.Distribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
-	}
-	if m.BlocksPerYear != 0 {
-		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *DistributionRecipient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -348,15 +1276,15 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: DistributionRecipient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DistributionRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -384,15 +1312,97 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Phase = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastBlockProvision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastBlockProvision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastBlockProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -402,16 +1412,30 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Phase |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartPhaseBlock", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMint
 			}
-			m.StartPhaseBlock = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elapsed", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -421,14 +1445,28 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartPhaseBlock |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Elapsed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -456,13 +1494,82 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PhaseMinted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PhaseMinted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PhaseMinted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -490,7 +1597,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TargetSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -515,7 +1622,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *BurnedCoins) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -538,15 +1645,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: BurnedCoins: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BurnedCoins: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -574,13 +1681,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
-			m.BlocksPerYear = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -590,11 +1697,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -2,12 +2,17 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// YearDuration is the length of a year used to prorate the annual provisions
+// by the time elapsed between blocks, matching the default BlocksPerYear.
+const YearDuration = 8766 * time.Hour
+
 // NewMinter returns a new Minter object with the given inflation and annual
 // provisions values.
 func NewMinter(inflation, annualProvisions sdk.Dec, phase, startPhaseBlock uint64, targetSupply math.Int) Minter {
@@ -48,33 +53,19 @@ func ValidateMinter(minter Minter) error {
 	return nil
 }
 
-// PhaseInflationRate returns the inflation rate by phase.
-func (m Minter) PhaseInflationRate(phase uint64) sdk.Dec {
-	switch {
-	case phase > 12:
+// PhaseInflationRate returns the inflation rate of the phase in the phase
+// schedule of the params. Phases past the end of the schedule have no inflation.
+func (m Minter) PhaseInflationRate(params Params, phase uint64) sdk.Dec {
+	if phase == 0 || phase > uint64(len(params.PhaseInflationRates)) {
 		return sdk.ZeroDec()
-
-	case phase == 1:
-		return sdk.NewDecWithPrec(40, 2)
-
-	case phase == 2:
-		return sdk.NewDecWithPrec(20, 2)
-
-	case phase == 3:
-		return sdk.NewDecWithPrec(10, 2)
-
-	default:
-		// Phase4:  9%
-		// Phase5:  8%
-		// Phase6:  7%
-		// ...
-		// Phase12: 1%
-		return sdk.NewDecWithPrec(13-int64(phase), 2)
 	}
+
+	return params.PhaseInflationRates[phase-1]
 }
 
-// NextPhase returns the new phase.
-func (m Minter) NextPhase(_ Params, currentSupply math.Int) uint64 {
+// NextPhase returns the new phase. Once the phase schedule of the params has
+// ended, the phase no longer changes.
+func (m Minter) NextPhase(params Params, currentSupply math.Int) uint64 {
 	nonePhase := m.Phase == 0
 	if nonePhase {
 		return 1
@@ -84,6 +75,10 @@ func (m Minter) NextPhase(_ Params, currentSupply math.Int) uint64 {
 		return m.Phase
 	}
 
+	if m.Phase > uint64(len(params.PhaseInflationRates)) {
+		return m.Phase
+	}
+
 	return m.Phase + 1
 }
 
@@ -106,3 +101,27 @@ func (m Minter) BlockProvision(params Params, totalSupply math.Int) sdk.Coin {
 
 	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
 }
+
+// TimeBasedBlockProvision returns the provisions for a block based on the
+// annual provisions rate and the time elapsed since the previous block.
+func (m Minter) TimeBasedBlockProvision(params Params, totalSupply math.Int, elapsed time.Duration) sdk.Coin {
+	provisionAmt := m.AnnualProvisions.MulInt64(int64(elapsed)).QuoInt64(int64(YearDuration))
+
+	// Because of rounding, we might mint too many tokens in this phase, let's limit it
+	futureSupply := totalSupply.Add(provisionAmt.TruncateInt())
+	if futureSupply.GT(m.TargetSupply) {
+		return sdk.NewCoin(params.MintDenom, m.TargetSupply.Sub(totalSupply))
+	}
+
+	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
+}
+
+// AnnualizedInflation returns the inflation rate of a block provision minted
+// over the elapsed time, annualized and relative to the total supply.
+func AnnualizedInflation(provision math.Int, elapsed time.Duration, totalSupply math.Int) sdk.Dec {
+	if elapsed <= 0 || !totalSupply.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.NewDecFromInt(provision).MulInt64(int64(YearDuration)).QuoInt64(int64(elapsed)).QuoInt(totalSupply)
+}
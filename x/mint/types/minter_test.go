@@ -3,6 +3,7 @@ package types
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -13,6 +14,7 @@ import (
 
 func TestPhaseInflation(t *testing.T) {
 	minter := DefaultInitialMinter()
+	params := DefaultParams()
 
 	// Governing Mechanism:
 	//    Juno tokenomics
@@ -51,13 +53,30 @@ func TestPhaseInflation(t *testing.T) {
 		{23, sdk.NewDecWithPrec(0, 2)},
 	}
 	for i, tc := range tests {
-		inflation := minter.PhaseInflationRate(tc.phase)
+		inflation := minter.PhaseInflationRate(params, tc.phase)
 
 		require.True(t, inflation.Equal(tc.expInflation),
 			"Test Index: %v\nInflation:  %v\nExpected: %v\n", i, inflation, tc.expInflation)
 	}
 }
 
+func TestCustomPhaseInflation(t *testing.T) {
+	minter := DefaultInitialMinter()
+	params := DefaultParams()
+	params.PhaseInflationRates = []sdk.Dec{sdk.NewDecWithPrec(15, 2), sdk.NewDecWithPrec(5, 2)}
+
+	require.True(t, minter.PhaseInflationRate(params, 0).IsZero())
+	require.Equal(t, sdk.NewDecWithPrec(15, 2), minter.PhaseInflationRate(params, 1))
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), minter.PhaseInflationRate(params, 2))
+	require.True(t, minter.PhaseInflationRate(params, 3).IsZero())
+
+	minter.TargetSupply = sdk.NewInt(100)
+	minter.Phase = 2
+	require.Equal(t, uint64(3), minter.NextPhase(params, sdk.NewInt(100)))
+	minter.Phase = 3
+	require.Equal(t, uint64(3), minter.NextPhase(params, sdk.NewInt(100)))
+}
+
 func TestNextPhase(t *testing.T) {
 	minter := DefaultInitialMinter()
 	params := DefaultParams()
@@ -78,6 +97,9 @@ func TestNextPhase(t *testing.T) {
 		// since currentSupply is larger than targetSupply
 		// next phase returns phase + 1 regardless of inputs
 		{102, 2, 101, blocksPerYear, 3, sdk.NewInt(29000), sdk.NewInt(14000)},
+		{1200, 12, 1100, blocksPerYear, 13, sdk.NewInt(29000), sdk.NewInt(14000)},
+		// the phase schedule has ended
+		{1300, 13, 1200, blocksPerYear, 13, sdk.NewInt(29000), sdk.NewInt(14000)},
 	}
 	for i, tc := range tests {
 		minter.Phase = tc.currentPhase
@@ -135,6 +157,44 @@ func TestBlockProvision(t *testing.T) {
 	}
 }
 
+func TestTimeBasedBlockProvision(t *testing.T) {
+	minter := InitialMinter(sdk.NewDecWithPrec(1, 1))
+	params := DefaultParams()
+	minter.AnnualProvisions = sdk.NewDec(int64(YearDuration / time.Second))
+	totalSupply := sdk.NewInt(1_000_000_000)
+	minter.TargetSupply = totalSupply.Add(minter.AnnualProvisions.TruncateInt())
+
+	tests := []struct {
+		elapsed       time.Duration
+		expProvisions int64
+	}{
+		{time.Second, 1},
+		{5 * time.Second, 5},
+		{6500 * time.Millisecond, 6},
+		{time.Hour, 3600},
+		{0, 0},
+	}
+	for i, tc := range tests {
+		provisions := minter.TimeBasedBlockProvision(params, totalSupply, tc.elapsed)
+		require.True(t, sdk.NewInt64Coin(params.MintDenom, tc.expProvisions).IsEqual(provisions),
+			"test: %v\n\tExp: %v\n\tGot: %v\n", i, tc.expProvisions, provisions)
+	}
+
+	// the provisions are capped by the target supply
+	minter.TargetSupply = totalSupply.AddRaw(10)
+	provisions := minter.TimeBasedBlockProvision(params, totalSupply, time.Hour)
+	require.Equal(t, sdk.NewInt(10), provisions.Amount)
+}
+
+func TestAnnualizedInflation(t *testing.T) {
+	totalSupply := sdk.NewInt(int64(YearDuration / time.Second))
+
+	require.Equal(t, sdk.OneDec(), AnnualizedInflation(sdk.NewInt(1), time.Second, totalSupply))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), AnnualizedInflation(sdk.NewInt(1), 2*time.Second, totalSupply))
+	require.True(t, AnnualizedInflation(sdk.NewInt(1), 0, totalSupply).IsZero())
+	require.True(t, AnnualizedInflation(sdk.NewInt(1), time.Second, sdk.ZeroInt()).IsZero())
+}
+
 // Benchmarking :)
 // previously using math.Int operations:
 // BenchmarkBlockProvision-4 5000000 220 ns/op
@@ -163,11 +223,12 @@ func BenchmarkBlockProvision(b *testing.B) {
 func BenchmarkPhaseInflation(b *testing.B) {
 	b.ReportAllocs()
 	minter := InitialMinter(sdk.NewDecWithPrec(1, 1))
+	params := DefaultParams()
 	phase := uint64(4)
 
 	// run the PhaseInflationRate function b.N times
 	for n := 0; n < b.N; n++ {
-		minter.PhaseInflationRate(phase)
+		minter.PhaseInflationRate(params, phase)
 	}
 }
 
@@ -11,19 +11,37 @@ import (
 )
 
 func NewParams(
-	mintDenom string, blocksPerYear uint64,
+	mintDenom string, blocksPerYear uint64, phaseInflationRates []sdk.Dec,
 ) Params {
 	return Params{
-		MintDenom:     mintDenom,
-		BlocksPerYear: blocksPerYear,
+		MintDenom:           mintDenom,
+		BlocksPerYear:       blocksPerYear,
+		PhaseInflationRates: phaseInflationRates,
 	}
 }
 
+// DefaultPhaseInflationRates returns the Juno tokenomics inflation schedule:
+// 40%, 20% and 10% for the first three phases, then one percent less each
+// phase down to 1% in phase 12.
+func DefaultPhaseInflationRates() []sdk.Dec {
+	rates := []sdk.Dec{
+		sdk.NewDecWithPrec(40, 2),
+		sdk.NewDecWithPrec(20, 2),
+		sdk.NewDecWithPrec(10, 2),
+	}
+	for phase := int64(4); phase <= 12; phase++ {
+		rates = append(rates, sdk.NewDecWithPrec(13-phase, 2))
+	}
+	return rates
+}
+
 // default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		MintDenom:     sdk.DefaultBondDenom,
-		BlocksPerYear: uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		MintDenom:           sdk.DefaultBondDenom,
+		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		PhaseInflationRates: DefaultPhaseInflationRates(),
+		Distribution:        DefaultDistribution(),
 	}
 }
 
@@ -32,9 +50,13 @@ func (p Params) Validate() error {
 	if err := validateMintDenom(p.MintDenom); err != nil {
 		return err
 	}
-	err := validateBlocksPerYear(p.BlocksPerYear)
-
-	return err
+	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
+		return err
+	}
+	if err := validatePhaseInflationRates(p.PhaseInflationRates); err != nil {
+		return err
+	}
+	return validateDistribution(p.Distribution)
 }
 
 // String implements the Stringer interface.
@@ -68,3 +90,21 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validatePhaseInflationRates(i interface{}) error {
+	v, ok := i.([]sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, rate := range v {
+		if rate.IsNil() || !rate.IsPositive() {
+			return fmt.Errorf("inflation rate of phase %d must be positive: %s", i+1, rate)
+		}
+		if rate.GT(sdk.OneDec()) {
+			return fmt.Errorf("inflation rate of phase %d cannot be greater than 1: %s", i+1, rate)
+		}
+	}
+
+	return nil
+}
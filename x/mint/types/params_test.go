@@ -0,0 +1,127 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidatePhaseInflationRates(t *testing.T) {
+	tests := []struct {
+		name   string
+		rates  []sdk.Dec
+		expErr bool
+	}{
+		{"default schedule", DefaultPhaseInflationRates(), false},
+		{"empty schedule", nil, false},
+		{"full inflation", []sdk.Dec{sdk.OneDec()}, false},
+		{"zero rate", []sdk.Dec{sdk.NewDecWithPrec(10, 2), sdk.ZeroDec()}, true},
+		{"negative rate", []sdk.Dec{sdk.NewDecWithPrec(-1, 2)}, true},
+		{"rate above one", []sdk.Dec{sdk.NewDecWithPrec(101, 2)}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.PhaseInflationRates = tc.rates
+
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParamsValidateDistribution(t *testing.T) {
+	addr := sdk.AccAddress(make([]byte, 20)).String()
+
+	tests := []struct {
+		name         string
+		distribution []DistributionRecipient
+		expErr       bool
+	}{
+		{"default distribution", DefaultDistribution(), false},
+		{"empty distribution", nil, false},
+		{
+			"all recipients",
+			[]DistributionRecipient{
+				NewDistributionRecipient(RecipientFeeCollector, sdk.NewDecWithPrec(6, 1)),
+				NewDistributionRecipient(RecipientCommunityPool, sdk.NewDecWithPrec(2, 1)),
+				NewDistributionRecipient(RecipientBurn, sdk.NewDecWithPrec(1, 1)),
+				NewDistributionRecipient(addr, sdk.NewDecWithPrec(1, 1)),
+			},
+			false,
+		},
+		{
+			"weights below 1",
+			[]DistributionRecipient{NewDistributionRecipient(RecipientFeeCollector, sdk.NewDecWithPrec(9, 1))},
+			true,
+		},
+		{
+			"weights above 1",
+			[]DistributionRecipient{
+				NewDistributionRecipient(RecipientFeeCollector, sdk.OneDec()),
+				NewDistributionRecipient(RecipientBurn, sdk.NewDecWithPrec(1, 1)),
+			},
+			true,
+		},
+		{
+			"zero weight",
+			[]DistributionRecipient{
+				NewDistributionRecipient(RecipientFeeCollector, sdk.OneDec()),
+				NewDistributionRecipient(RecipientBurn, sdk.ZeroDec()),
+			},
+			true,
+		},
+		{
+			"duplicate recipient",
+			[]DistributionRecipient{
+				NewDistributionRecipient(RecipientFeeCollector, sdk.NewDecWithPrec(5, 1)),
+				NewDistributionRecipient(RecipientFeeCollector, sdk.NewDecWithPrec(5, 1)),
+			},
+			true,
+		},
+		{
+			"invalid recipient",
+			[]DistributionRecipient{NewDistributionRecipient("distribution", sdk.OneDec())},
+			true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.Distribution = tc.distribution
+
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSplitProvision(t *testing.T) {
+	recipients := []DistributionRecipient{
+		NewDistributionRecipient(RecipientFeeCollector, sdk.NewDecWithPrec(333, 3)),
+		NewDistributionRecipient(RecipientCommunityPool, sdk.NewDecWithPrec(333, 3)),
+		NewDistributionRecipient(RecipientBurn, sdk.NewDecWithPrec(334, 3)),
+	}
+
+	shares := SplitProvision(recipients, sdk.NewInt(100))
+	require.Equal(t, []math.Int{sdk.NewInt(33), sdk.NewInt(33), sdk.NewInt(34)}, shares)
+
+	// the last recipient receives the remainder of the truncated shares
+	shares = SplitProvision(recipients, sdk.NewInt(10))
+	require.Equal(t, []math.Int{sdk.NewInt(3), sdk.NewInt(3), sdk.NewInt(4)}, shares)
+
+	shares = SplitProvision(DefaultDistribution(), sdk.NewInt(7))
+	require.Equal(t, []math.Int{sdk.NewInt(7)}, shares)
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -346,6 +347,265 @@ func (m *QueryTargetSupplyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTargetSupplyResponse proto.InternalMessageInfo
 
+// QueryActualInflationRequest is the request type for the
+// Query/ActualInflation RPC method.
+type QueryActualInflationRequest struct {
+}
+
+func (m *QueryActualInflationRequest) Reset()         { *m = QueryActualInflationRequest{} }
+func (m *QueryActualInflationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActualInflationRequest) ProtoMessage()    {}
+func (*QueryActualInflationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{8}
+}
+func (m *QueryActualInflationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActualInflationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActualInflationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActualInflationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActualInflationRequest.Merge(m, src)
+}
+func (m *QueryActualInflationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActualInflationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActualInflationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActualInflationRequest proto.InternalMessageInfo
+
+// QueryActualInflationResponse is the response type for the
+// Query/ActualInflation RPC method.
+type QueryActualInflationResponse struct {
+	// actual_inflation is the annualized inflation rate of the previous block.
+	ActualInflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=actual_inflation,json=actualInflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"actual_inflation"`
+}
+
+func (m *QueryActualInflationResponse) Reset()         { *m = QueryActualInflationResponse{} }
+func (m *QueryActualInflationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActualInflationResponse) ProtoMessage()    {}
+func (*QueryActualInflationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{9}
+}
+func (m *QueryActualInflationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActualInflationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActualInflationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActualInflationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActualInflationResponse.Merge(m, src)
+}
+func (m *QueryActualInflationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActualInflationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActualInflationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActualInflationResponse proto.InternalMessageInfo
+
+// QueryMintedSupplyRequest is the request type for the
+// Query/MintedSupply RPC method.
+type QueryMintedSupplyRequest struct {
+}
+
+func (m *QueryMintedSupplyRequest) Reset()         { *m = QueryMintedSupplyRequest{} }
+func (m *QueryMintedSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintedSupplyRequest) ProtoMessage()    {}
+func (*QueryMintedSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{10}
+}
+func (m *QueryMintedSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintedSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintedSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintedSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintedSupplyRequest.Merge(m, src)
+}
+func (m *QueryMintedSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintedSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintedSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintedSupplyRequest proto.InternalMessageInfo
+
+// QueryMintedSupplyResponse is the response type for the
+// Query/MintedSupply RPC method.
+type QueryMintedSupplyResponse struct {
+	// phases is the amount minted in each phase.
+	Phases []PhaseMinted `protobuf:"bytes,1,rep,name=phases,proto3" json:"phases"`
+	// total is the amount minted in all phases.
+	Total github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total"`
+}
+
+func (m *QueryMintedSupplyResponse) Reset()         { *m = QueryMintedSupplyResponse{} }
+func (m *QueryMintedSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintedSupplyResponse) ProtoMessage()    {}
+func (*QueryMintedSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{11}
+}
+func (m *QueryMintedSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintedSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintedSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintedSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintedSupplyResponse.Merge(m, src)
+}
+func (m *QueryMintedSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintedSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintedSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintedSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryMintedSupplyResponse) GetPhases() []PhaseMinted {
+	if m != nil {
+		return m.Phases
+	}
+	return nil
+}
+
+// QueryBurnedSupplyRequest is the request type for the
+// Query/BurnedSupply RPC method.
+type QueryBurnedSupplyRequest struct {
+}
+
+func (m *QueryBurnedSupplyRequest) Reset()         { *m = QueryBurnedSupplyRequest{} }
+func (m *QueryBurnedSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedSupplyRequest) ProtoMessage()    {}
+func (*QueryBurnedSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{12}
+}
+func (m *QueryBurnedSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedSupplyRequest.Merge(m, src)
+}
+func (m *QueryBurnedSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedSupplyRequest proto.InternalMessageInfo
+
+// QueryBurnedSupplyResponse is the response type for the
+// Query/BurnedSupply RPC method.
+type QueryBurnedSupplyResponse struct {
+	// sources is the coins burned through each source.
+	Sources []BurnedCoins `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources"`
+	// total is the coins burned through all sources.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+}
+
+func (m *QueryBurnedSupplyResponse) Reset()         { *m = QueryBurnedSupplyResponse{} }
+func (m *QueryBurnedSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedSupplyResponse) ProtoMessage()    {}
+func (*QueryBurnedSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{13}
+}
+func (m *QueryBurnedSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedSupplyResponse.Merge(m, src)
+}
+func (m *QueryBurnedSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryBurnedSupplyResponse) GetSources() []BurnedCoins {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *QueryBurnedSupplyResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "juno.mint.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "juno.mint.QueryParamsResponse")
@@ -355,45 +615,66 @@ func init() {
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "juno.mint.QueryAnnualProvisionsResponse")
 	proto.RegisterType((*QueryTargetSupplyRequest)(nil), "juno.mint.QueryTargetSupplyRequest")
 	proto.RegisterType((*QueryTargetSupplyResponse)(nil), "juno.mint.QueryTargetSupplyResponse")
+	proto.RegisterType((*QueryActualInflationRequest)(nil), "juno.mint.QueryActualInflationRequest")
+	proto.RegisterType((*QueryActualInflationResponse)(nil), "juno.mint.QueryActualInflationResponse")
+	proto.RegisterType((*QueryMintedSupplyRequest)(nil), "juno.mint.QueryMintedSupplyRequest")
+	proto.RegisterType((*QueryMintedSupplyResponse)(nil), "juno.mint.QueryMintedSupplyResponse")
+	proto.RegisterType((*QueryBurnedSupplyRequest)(nil), "juno.mint.QueryBurnedSupplyRequest")
+	proto.RegisterType((*QueryBurnedSupplyResponse)(nil), "juno.mint.QueryBurnedSupplyResponse")
 }
 
 func init() { proto.RegisterFile("juno/mint/query.proto", fileDescriptor_a6f0d4f2a25816bd) }
 
 var fileDescriptor_a6f0d4f2a25816bd = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6b, 0x13, 0x41,
-	0x14, 0xc6, 0xb3, 0x52, 0x0b, 0x79, 0x46, 0x68, 0xc7, 0x54, 0xeb, 0xda, 0x6c, 0xe3, 0xb6, 0xc4,
-	0x20, 0xba, 0x43, 0xeb, 0x5f, 0x60, 0x2a, 0x85, 0x82, 0x87, 0x9a, 0x7a, 0xd2, 0x43, 0x99, 0xc4,
-	0xed, 0xba, 0xba, 0x99, 0x99, 0xee, 0xcc, 0x96, 0x06, 0x3c, 0x09, 0x9e, 0x15, 0xc4, 0xff, 0xa9,
-	0xc7, 0x82, 0x17, 0xf1, 0x50, 0x24, 0xf1, 0x0f, 0x91, 0x7d, 0x3b, 0x9b, 0xa6, 0xdb, 0x4d, 0x85,
-	0x5e, 0x92, 0xf0, 0xbe, 0xc7, 0xf7, 0xfd, 0xc8, 0xfb, 0x18, 0x58, 0xfa, 0x90, 0x70, 0x41, 0x07,
-	0x21, 0xd7, 0xf4, 0x30, 0xf1, 0xe3, 0xa1, 0x27, 0x63, 0xa1, 0x05, 0xa9, 0xa6, 0x63, 0x2f, 0x1d,
-	0xdb, 0xf5, 0x40, 0x04, 0x02, 0xa7, 0x34, 0xfd, 0x95, 0x2d, 0xd8, 0x2b, 0x81, 0x10, 0x41, 0xe4,
-	0x53, 0x26, 0x43, 0xca, 0x38, 0x17, 0x9a, 0xe9, 0x50, 0x70, 0x65, 0xd4, 0xfa, 0xb9, 0x6b, 0xfa,
-	0x91, 0x4d, 0xdd, 0x3a, 0x90, 0x57, 0x69, 0xc6, 0x2e, 0x8b, 0xd9, 0x40, 0x75, 0xfd, 0xc3, 0xc4,
-	0x57, 0xda, 0xdd, 0x86, 0x3b, 0x17, 0xa6, 0x4a, 0x0a, 0xae, 0x7c, 0x42, 0x61, 0x5e, 0xe2, 0x64,
-	0xd9, 0x6a, 0x5a, 0xed, 0x5b, 0x9b, 0x8b, 0xde, 0x04, 0xc9, 0xcb, 0x56, 0x3b, 0x73, 0x27, 0x67,
-	0xab, 0x95, 0xae, 0x59, 0x73, 0xef, 0xc1, 0x12, 0xfa, 0xec, 0xf0, 0x83, 0x08, 0x61, 0xf2, 0x80,
-	0x03, 0xb8, 0x5b, 0x14, 0x4c, 0xc6, 0x4b, 0xa8, 0x86, 0xf9, 0x10, 0x63, 0x6a, 0x1d, 0x2f, 0xf5,
-	0xfc, 0x7d, 0xb6, 0xda, 0x0a, 0x42, 0xfd, 0x3e, 0xe9, 0x79, 0x7d, 0x31, 0xa0, 0x7d, 0xa1, 0x06,
-	0x42, 0x99, 0xaf, 0xa7, 0xea, 0xdd, 0x47, 0xaa, 0x87, 0xd2, 0x57, 0xde, 0x0b, 0xbf, 0xdf, 0x3d,
-	0x37, 0x70, 0x1d, 0x58, 0xc1, 0x9c, 0xe7, 0x9c, 0x27, 0x2c, 0xda, 0x8d, 0xc5, 0x51, 0xa8, 0xd2,
-	0xff, 0x24, 0xe7, 0xf8, 0x04, 0x8d, 0x19, 0xba, 0xc1, 0x79, 0x0b, 0x8b, 0x0c, 0xb5, 0x7d, 0x39,
-	0x11, 0xaf, 0x89, 0xb5, 0xc0, 0x0a, 0x21, 0xae, 0x0d, 0xcb, 0x98, 0xfe, 0x9a, 0xc5, 0x81, 0xaf,
-	0xf7, 0x12, 0x29, 0xa3, 0x61, 0x4e, 0x26, 0xe1, 0x7e, 0x89, 0x66, 0xa8, 0xf6, 0xe0, 0xb6, 0xc6,
-	0xf9, 0xbe, 0x42, 0xe1, 0x1a, 0x44, 0x3b, 0x5c, 0x77, 0x6b, 0x7a, 0xca, 0x7c, 0xf3, 0xeb, 0x1c,
-	0xdc, 0xc4, 0x48, 0x12, 0xc1, 0x7c, 0x76, 0x4e, 0xd2, 0x98, 0xba, 0xf0, 0xe5, 0x9e, 0xd8, 0xce,
-	0x2c, 0x39, 0xe3, 0x74, 0xd7, 0x3e, 0xff, 0xfc, 0xfb, 0xfd, 0x46, 0x83, 0x3c, 0xc8, 0x31, 0xb0,
-	0x7e, 0x47, 0x1b, 0x3d, 0x5f, 0xb3, 0x0d, 0x9a, 0x95, 0x84, 0x1c, 0x43, 0x75, 0x52, 0x03, 0xd2,
-	0x2c, 0x3a, 0x16, 0xab, 0x63, 0x3f, 0xbc, 0x62, 0xc3, 0xc4, 0xb6, 0x30, 0xb6, 0x49, 0x9c, 0xd2,
-	0xd8, 0x49, 0x3b, 0xc8, 0x0f, 0x0b, 0x16, 0x8a, 0x97, 0x27, 0x8f, 0x8a, 0xfe, 0x33, 0xba, 0x63,
-	0xb7, 0xff, 0xbf, 0x68, 0x78, 0x3c, 0xe4, 0x69, 0x93, 0x56, 0x29, 0xcf, 0xa5, 0x7e, 0x91, 0x2f,
-	0x16, 0xd4, 0xa6, 0xef, 0x4e, 0xd6, 0x8a, 0x51, 0x25, 0x8d, 0xb1, 0xd7, 0xaf, 0x5e, 0x32, 0x2c,
-	0x8f, 0x91, 0x65, 0x9d, 0xb8, 0xa5, 0x2c, 0x17, 0x5a, 0xd5, 0xd9, 0x3e, 0x19, 0x39, 0xd6, 0xe9,
-	0xc8, 0xb1, 0xfe, 0x8c, 0x1c, 0xeb, 0xdb, 0xd8, 0xa9, 0x9c, 0x8e, 0x9d, 0xca, 0xaf, 0xb1, 0x53,
-	0x79, 0xf3, 0x64, 0xaa, 0x61, 0x5b, 0xe8, 0xb3, 0x25, 0xb8, 0x8e, 0x59, 0x5f, 0x2b, 0x8a, 0xef,
-	0xcc, 0x71, 0xe6, 0x8b, 0x5d, 0xeb, 0xcd, 0xe3, 0x5b, 0xf3, 0xec, 0x5f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xa7, 0x3d, 0x8c, 0xe6, 0xd9, 0x04, 0x00, 0x00,
+	// 760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x4f, 0x4f, 0xd4, 0x4c,
+	0x1c, 0xc7, 0xb7, 0x3c, 0x0f, 0x4b, 0x76, 0xc0, 0x00, 0x23, 0x20, 0x14, 0xb6, 0xac, 0x65, 0x5d,
+	0x36, 0x46, 0x5a, 0x41, 0xe3, 0xdd, 0x85, 0x90, 0x90, 0x68, 0x82, 0x8b, 0x17, 0xf5, 0x40, 0x66,
+	0x4b, 0x59, 0xaa, 0xdd, 0x99, 0xd2, 0x99, 0x12, 0x36, 0xf1, 0x64, 0xe2, 0x5d, 0x63, 0x8c, 0xef,
+	0xc1, 0xab, 0x89, 0xaf, 0x81, 0x23, 0x89, 0x17, 0xe3, 0x01, 0x0d, 0xf8, 0x42, 0xcc, 0x4c, 0xa7,
+	0xa5, 0xdb, 0x2d, 0xcb, 0x8a, 0x17, 0xd8, 0xfc, 0xfe, 0x7d, 0x3f, 0xf3, 0xeb, 0xf4, 0x9b, 0x82,
+	0xc9, 0x97, 0x01, 0x26, 0x66, 0xcb, 0xc1, 0xcc, 0xdc, 0x0f, 0x6c, 0xbf, 0x6d, 0x78, 0x3e, 0x61,
+	0x04, 0x16, 0x78, 0xd8, 0xe0, 0x61, 0x55, 0xb3, 0x08, 0x6d, 0x11, 0x6a, 0x36, 0x10, 0xb5, 0xcd,
+	0x83, 0xe5, 0x86, 0xcd, 0xd0, 0xb2, 0x69, 0x11, 0x07, 0x87, 0xa5, 0xea, 0x44, 0x93, 0x34, 0x89,
+	0xf8, 0x69, 0xf2, 0x5f, 0x32, 0x3a, 0xd7, 0x24, 0xa4, 0xe9, 0xda, 0x26, 0xf2, 0x1c, 0x13, 0x61,
+	0x4c, 0x18, 0x62, 0x0e, 0xc1, 0x34, 0xea, 0x39, 0x57, 0xe5, 0x7f, 0xc2, 0xa8, 0x3e, 0x01, 0xe0,
+	0x13, 0xce, 0xb0, 0x89, 0x7c, 0xd4, 0xa2, 0x75, 0x7b, 0x3f, 0xb0, 0x29, 0xd3, 0xd7, 0xc1, 0xf5,
+	0x8e, 0x28, 0xf5, 0x08, 0xa6, 0x36, 0x34, 0x41, 0xde, 0x13, 0x91, 0x69, 0xa5, 0xa4, 0x54, 0x87,
+	0x57, 0xc6, 0x8d, 0x18, 0xd9, 0x08, 0x4b, 0x6b, 0xff, 0x1f, 0x9d, 0xcc, 0xe7, 0xea, 0xb2, 0x4c,
+	0xbf, 0x01, 0x26, 0xc5, 0x9c, 0x0d, 0xbc, 0xeb, 0x0a, 0x98, 0x48, 0x60, 0x17, 0x4c, 0xa5, 0x13,
+	0x52, 0xe3, 0x11, 0x28, 0x38, 0x51, 0x50, 0xc8, 0x8c, 0xd4, 0x0c, 0x3e, 0xf3, 0xc7, 0xc9, 0x7c,
+	0xa5, 0xe9, 0xb0, 0xbd, 0xa0, 0x61, 0x58, 0xa4, 0x65, 0xca, 0x05, 0x85, 0xff, 0x96, 0xe8, 0xce,
+	0x2b, 0x93, 0xb5, 0x3d, 0x9b, 0x1a, 0x6b, 0xb6, 0x55, 0x3f, 0x1f, 0xa0, 0x6b, 0x60, 0x4e, 0xe8,
+	0x3c, 0xc4, 0x38, 0x40, 0xee, 0xa6, 0x4f, 0x0e, 0x1c, 0xca, 0x77, 0x12, 0x71, 0xbc, 0x06, 0xc5,
+	0x0b, 0xf2, 0x12, 0xe7, 0x05, 0x18, 0x47, 0x22, 0xb7, 0xed, 0xc5, 0xc9, 0x2b, 0x62, 0x8d, 0xa1,
+	0x94, 0x88, 0xae, 0x82, 0x69, 0xa1, 0xfe, 0x14, 0xf9, 0x4d, 0x9b, 0x6d, 0x05, 0x9e, 0xe7, 0xb6,
+	0x23, 0x32, 0x0f, 0xcc, 0x64, 0xe4, 0x24, 0xd5, 0x16, 0xb8, 0xc6, 0x44, 0x7c, 0x9b, 0x8a, 0xc4,
+	0x15, 0x88, 0x36, 0x30, 0xab, 0x8f, 0xb0, 0xc4, 0x70, 0xbd, 0x08, 0x66, 0xc3, 0x5d, 0x58, 0x2c,
+	0x40, 0x6e, 0xd7, 0x23, 0x6b, 0x83, 0xb9, 0xec, 0xb4, 0x64, 0x7a, 0x06, 0xc6, 0x90, 0x48, 0x6d,
+	0xff, 0xeb, 0xf3, 0x1b, 0x45, 0x9d, 0x12, 0xf1, 0x9e, 0x1e, 0x3b, 0x98, 0xd9, 0x3b, 0x9d, 0x7b,
+	0xfa, 0xa4, 0x80, 0x99, 0x8c, 0xa4, 0x84, 0xba, 0x0f, 0xf2, 0xde, 0x1e, 0xa2, 0x36, 0x7f, 0x66,
+	0xff, 0x55, 0x87, 0x57, 0xa6, 0x92, 0x37, 0x96, 0x27, 0xc2, 0xae, 0xf8, 0xda, 0x8a, 0x5a, 0xb8,
+	0x06, 0x06, 0x19, 0x61, 0xc8, 0x9d, 0x1e, 0x28, 0x29, 0xd5, 0xc2, 0x5f, 0xaf, 0x35, 0x6c, 0x8e,
+	0xa9, 0x6b, 0x81, 0x8f, 0xd3, 0xd4, 0x5f, 0x23, 0xea, 0xce, 0xa4, 0xa4, 0x7e, 0x00, 0x86, 0x28,
+	0x09, 0x7c, 0x2b, 0x13, 0x3b, 0xec, 0x58, 0x25, 0x0e, 0x8e, 0xde, 0xb6, 0xa8, 0x18, 0xa2, 0x73,
+	0x6e, 0xde, 0x35, 0x63, 0x84, 0x78, 0x06, 0xb7, 0x11, 0x43, 0xda, 0x88, 0xc1, 0x3b, 0x6b, 0x77,
+	0x79, 0xe3, 0xe7, 0x9f, 0xf3, 0xd5, 0x3e, 0x8e, 0x24, 0xa4, 0xe4, 0xa1, 0x56, 0xbe, 0x0c, 0x81,
+	0x41, 0x01, 0x0e, 0x5d, 0x90, 0x0f, 0xdf, 0x79, 0x58, 0x4c, 0xd0, 0x75, 0x9b, 0x89, 0xaa, 0x5d,
+	0x94, 0x0e, 0x4f, 0xab, 0x2f, 0xbc, 0xf9, 0xf6, 0xfb, 0xc3, 0x40, 0x11, 0xce, 0x46, 0x04, 0xbc,
+	0x32, 0x76, 0xbd, 0xd0, 0x49, 0xe0, 0x21, 0x28, 0xc4, 0xf7, 0x01, 0x96, 0xd2, 0x13, 0xd3, 0x97,
+	0x55, 0xbd, 0xd9, 0xa3, 0x42, 0xca, 0x56, 0x84, 0x6c, 0x09, 0x6a, 0x99, 0xb2, 0xf1, 0x1d, 0x86,
+	0x1f, 0x15, 0x30, 0x96, 0xb6, 0x07, 0xb8, 0x98, 0x9e, 0x7f, 0x81, 0xc1, 0xa8, 0xd5, 0xcb, 0x0b,
+	0x25, 0x8f, 0x21, 0x78, 0xaa, 0xb0, 0x92, 0xc9, 0xd3, 0x65, 0x42, 0xf0, 0xad, 0x02, 0x46, 0x92,
+	0xe6, 0x00, 0x17, 0xd2, 0x52, 0x19, 0xb6, 0xa2, 0x96, 0x7b, 0x17, 0x49, 0x96, 0xdb, 0x82, 0xa5,
+	0x0c, 0xf5, 0x4c, 0x96, 0x0e, 0xeb, 0x81, 0xef, 0x15, 0x30, 0x9a, 0xf2, 0x04, 0x58, 0xe9, 0x3a,
+	0x75, 0xa6, 0xa7, 0xa8, 0x8b, 0x97, 0xd6, 0x49, 0xa0, 0x25, 0x01, 0xb4, 0x08, 0x6f, 0x65, 0x2f,
+	0x27, 0xe5, 0x3b, 0x62, 0x37, 0x49, 0x3f, 0xe8, 0xde, 0x4d, 0x86, 0x95, 0xa8, 0xe5, 0xde, 0x45,
+	0x7d, 0xed, 0xa6, 0x25, 0x5a, 0xa2, 0xdd, 0x70, 0x8e, 0xe4, 0x1b, 0xde, 0xcd, 0x91, 0x61, 0x0e,
+	0x6a, 0xb9, 0x77, 0x51, 0x5f, 0x1c, 0x0d, 0xd1, 0x22, 0x39, 0x6a, 0xeb, 0x47, 0xa7, 0x9a, 0x72,
+	0x7c, 0xaa, 0x29, 0xbf, 0x4e, 0x35, 0xe5, 0xdd, 0x99, 0x96, 0x3b, 0x3e, 0xd3, 0x72, 0xdf, 0xcf,
+	0xb4, 0xdc, 0xf3, 0x3b, 0x09, 0x03, 0x58, 0x15, 0x73, 0x56, 0x09, 0x66, 0x3e, 0xb2, 0x18, 0x35,
+	0xc5, 0x07, 0xc3, 0x61, 0x38, 0x57, 0x58, 0x41, 0x23, 0x2f, 0x3e, 0x1a, 0xee, 0xfd, 0x19, 0x00,
+	0x6f, 0x7b, 0x23, 0x51, 0xc2, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -416,6 +697,13 @@ type QueryClient interface {
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
 	// TargetSupply current target supply for this phase value.
 	TargetSupply(ctx context.Context, in *QueryTargetSupplyRequest, opts ...grpc.CallOption) (*QueryTargetSupplyResponse, error)
+	// ActualInflation returns the annualized inflation rate of the provision
+	// minted in the previous block, given the actual time between blocks.
+	ActualInflation(ctx context.Context, in *QueryActualInflationRequest, opts ...grpc.CallOption) (*QueryActualInflationResponse, error)
+	// MintedSupply returns the amount minted in each phase.
+	MintedSupply(ctx context.Context, in *QueryMintedSupplyRequest, opts ...grpc.CallOption) (*QueryMintedSupplyResponse, error)
+	// BurnedSupply returns the coins burned through each source.
+	BurnedSupply(ctx context.Context, in *QueryBurnedSupplyRequest, opts ...grpc.CallOption) (*QueryBurnedSupplyResponse, error)
 }
 
 type queryClient struct {
@@ -462,6 +750,33 @@ func (c *queryClient) TargetSupply(ctx context.Context, in *QueryTargetSupplyReq
 	return out, nil
 }
 
+func (c *queryClient) ActualInflation(ctx context.Context, in *QueryActualInflationRequest, opts ...grpc.CallOption) (*QueryActualInflationResponse, error) {
+	out := new(QueryActualInflationResponse)
+	err := c.cc.Invoke(ctx, "/juno.mint.Query/ActualInflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintedSupply(ctx context.Context, in *QueryMintedSupplyRequest, opts ...grpc.CallOption) (*QueryMintedSupplyResponse, error) {
+	out := new(QueryMintedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/juno.mint.Query/MintedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnedSupply(ctx context.Context, in *QueryBurnedSupplyRequest, opts ...grpc.CallOption) (*QueryBurnedSupplyResponse, error) {
+	out := new(QueryBurnedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/juno.mint.Query/BurnedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -472,6 +787,13 @@ type QueryServer interface {
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
 	// TargetSupply current target supply for this phase value.
 	TargetSupply(context.Context, *QueryTargetSupplyRequest) (*QueryTargetSupplyResponse, error)
+	// ActualInflation returns the annualized inflation rate of the provision
+	// minted in the previous block, given the actual time between blocks.
+	ActualInflation(context.Context, *QueryActualInflationRequest) (*QueryActualInflationResponse, error)
+	// MintedSupply returns the amount minted in each phase.
+	MintedSupply(context.Context, *QueryMintedSupplyRequest) (*QueryMintedSupplyResponse, error)
+	// BurnedSupply returns the coins burned through each source.
+	BurnedSupply(context.Context, *QueryBurnedSupplyRequest) (*QueryBurnedSupplyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -490,6 +812,15 @@ func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) TargetSupply(ctx context.Context, req *QueryTargetSupplyRequest) (*QueryTargetSupplyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TargetSupply not implemented")
 }
+func (*UnimplementedQueryServer) ActualInflation(ctx context.Context, req *QueryActualInflationRequest) (*QueryActualInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActualInflation not implemented")
+}
+func (*UnimplementedQueryServer) MintedSupply(ctx context.Context, req *QueryMintedSupplyRequest) (*QueryMintedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintedSupply not implemented")
+}
+func (*UnimplementedQueryServer) BurnedSupply(ctx context.Context, req *QueryBurnedSupplyRequest) (*QueryBurnedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedSupply not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -567,6 +898,60 @@ func _Query_TargetSupply_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ActualInflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActualInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActualInflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.mint.Query/ActualInflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActualInflation(ctx, req.(*QueryActualInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.mint.Query/MintedSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintedSupply(ctx, req.(*QueryMintedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.mint.Query/BurnedSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedSupply(ctx, req.(*QueryBurnedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "juno.mint.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -587,6 +972,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TargetSupply",
 			Handler:    _Query_TargetSupply_Handler,
 		},
+		{
+			MethodName: "ActualInflation",
+			Handler:    _Query_ActualInflation_Handler,
+		},
+		{
+			MethodName: "MintedSupply",
+			Handler:    _Query_MintedSupply_Handler,
+		},
+		{
+			MethodName: "BurnedSupply",
+			Handler:    _Query_BurnedSupply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "juno/mint/query.proto",
@@ -816,48 +1213,248 @@ func (m *QueryTargetSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryActualInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryActualInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryActualInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInflationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryActualInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryInflationResponse) Size() (n int) {
-	if m == nil {
+func (m *QueryActualInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActualInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ActualInflation.Size()
+		i -= size
+		if _, err := m.ActualInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintedSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Phases) > 0 {
+		for iNdEx := len(m.Phases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Phases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -871,49 +1468,524 @@ func (m *QueryAnnualProvisionsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnnualProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTargetSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTargetSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TargetSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryActualInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActualInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ActualInflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintedSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMintedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Phases) > 0 {
+		for _, e := range m.Phases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBurnedSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAnnualProvisionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryTargetSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryTargetSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TargetSupply.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTargetSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -936,10 +2008,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTargetSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTargetSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -963,7 +2035,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTargetSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -986,17 +2058,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTargetSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTargetSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSupply", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1006,22 +2078,22 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1046,7 +2118,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActualInflationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1069,10 +2141,10 @@ func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActualInflationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActualInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1096,7 +2168,7 @@ func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActualInflationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1119,15 +2191,15 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActualInflationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActualInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualInflation", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1154,7 +2226,7 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ActualInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1179,7 +2251,7 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintedSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1202,10 +2274,10 @@ func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintedSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1229,7 +2301,7 @@ func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintedSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1252,17 +2324,17 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintedSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1272,22 +2344,57 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Phases = append(m.Phases, PhaseMinted{})
+			if err := m.Phases[len(m.Phases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1312,7 +2419,7 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTargetSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnedSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1335,10 +2442,10 @@ func (m *QueryTargetSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTargetSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnedSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTargetSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1362,7 +2469,7 @@ func (m *QueryTargetSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTargetSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnedSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1385,17 +2492,17 @@ func (m *QueryTargetSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTargetSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnedSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTargetSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1405,22 +2512,57 @@ func (m *QueryTargetSupplyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TargetSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sources = append(m.Sources, BurnedCoins{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// Sources of burned coins tracked by the module.
+const (
+	// BurnSourceWasm is the burn of coins by contracts, with a bank burn msg.
+	BurnSourceWasm = "wasm"
+	// BurnSourceJunoBurn is the burn of coins by other modules through the
+	// junoburn module.
+	BurnSourceJunoBurn = "junoburn"
+	// BurnSourceUser is the burn of coins by accounts, with a junoburn burn msg.
+	BurnSourceUser = "user"
+	// BurnSourceTokenFactory is the burn of tokenfactory denoms.
+	BurnSourceTokenFactory = "tokenfactory"
+	// BurnSourceMint is the share of the minted tokens distributed to the burn
+	// recipient.
+	BurnSourceMint = "mint"
+)
+
+// GetBurnedSupplyKey returns the key of the amount of a denom burned through a
+// source.
+func GetBurnedSupplyKey(source, denom string) []byte {
+	return append(GetBurnedSupplySourceKey(source), []byte(denom)...)
+}
+
+// GetBurnedSupplySourceKey returns the key prefix of the coins burned through a
+// source.
+func GetBurnedSupplySourceKey(source string) []byte {
+	return append(append([]byte{}, BurnedSupplyKeyPrefix...), address.MustLengthPrefix([]byte(source))...)
+}
+
+// ValidateSupplyAccounting validates the minted and burned supply of a genesis
+// state.
+func ValidateSupplyAccounting(minted []PhaseMinted, burned []BurnedCoins) error {
+	phases := make(map[uint64]bool, len(minted))
+	for _, m := range minted {
+		if phases[m.Phase] {
+			return fmt.Errorf("duplicate minted supply for phase %d", m.Phase)
+		}
+		phases[m.Phase] = true
+
+		if m.Amount.IsNil() || m.Amount.IsNegative() {
+			return fmt.Errorf("minted supply of phase %d cannot be negative: %s", m.Phase, m.Amount)
+		}
+	}
+
+	sources := make(map[string]bool, len(burned))
+	for _, b := range burned {
+		if b.Source == "" {
+			return fmt.Errorf("burned supply source cannot be blank")
+		}
+		if sources[b.Source] {
+			return fmt.Errorf("duplicate burned supply for source %s", b.Source)
+		}
+		sources[b.Source] = true
+
+		if err := b.Coins.Validate(); err != nil {
+			return fmt.Errorf("invalid burned supply of source %s: %w", b.Source, err)
+		}
+	}
+
+	return nil
+}
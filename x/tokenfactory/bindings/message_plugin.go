@@ -2,6 +2,8 @@ package bindings
 
 import (
 	"encoding/json"
+	"math"
+	"time"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
@@ -64,6 +66,18 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if contractMsg.ForceTransfer != nil {
 			return m.forceTransfer(ctx, contractAddr, contractMsg.ForceTransfer)
 		}
+		if contractMsg.GrantRole != nil {
+			return m.grantRole(ctx, contractAddr, contractMsg.GrantRole)
+		}
+		if contractMsg.RevokeRole != nil {
+			return m.revokeRole(ctx, contractAddr, contractMsg.RevokeRole)
+		}
+		if contractMsg.SetMaxSupply != nil {
+			return m.setMaxSupply(ctx, contractAddr, contractMsg.SetMaxSupply)
+		}
+		if contractMsg.SetMintRateLimit != nil {
+			return m.setMintRateLimit(ctx, contractAddr, contractMsg.SetMintRateLimit)
+		}
 	}
 	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 }
@@ -263,6 +277,124 @@ func PerformForceTransfer(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contrac
 	return nil
 }
 
+// grantRole grants a role over a denom.
+func (m *CustomMessenger) grantRole(ctx sdk.Context, contractAddr sdk.AccAddress, grantRole *bindingstypes.GrantRole) ([]sdk.Event, [][]byte, error) {
+	err := PerformGrantRole(m.tokenFactory, ctx, contractAddr, grantRole)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform grant role")
+	}
+	return nil, nil, nil
+}
+
+// PerformGrantRole is used with grantRole to validate grantRole messages and to dispatch.
+func PerformGrantRole(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, grantRole *bindingstypes.GrantRole) error {
+	if grantRole == nil {
+		return wasmvmtypes.InvalidRequest{Err: "grant role null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgGrantRole(contractAddr.String(), grantRole.Denom, grantRole.Role, grantRole.Address)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.GrantRole(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "granting role from message")
+	}
+	return nil
+}
+
+// revokeRole revokes a role over a denom.
+func (m *CustomMessenger) revokeRole(ctx sdk.Context, contractAddr sdk.AccAddress, revokeRole *bindingstypes.RevokeRole) ([]sdk.Event, [][]byte, error) {
+	err := PerformRevokeRole(m.tokenFactory, ctx, contractAddr, revokeRole)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform revoke role")
+	}
+	return nil, nil, nil
+}
+
+// PerformRevokeRole is used with revokeRole to validate revokeRole messages and to dispatch.
+func PerformRevokeRole(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, revokeRole *bindingstypes.RevokeRole) error {
+	if revokeRole == nil {
+		return wasmvmtypes.InvalidRequest{Err: "revoke role null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgRevokeRole(contractAddr.String(), revokeRole.Denom, revokeRole.Role, revokeRole.Address)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.RevokeRole(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "revoking role from message")
+	}
+	return nil
+}
+
+// setMaxSupply caps the supply of a denom.
+func (m *CustomMessenger) setMaxSupply(ctx sdk.Context, contractAddr sdk.AccAddress, setMaxSupply *bindingstypes.SetMaxSupply) ([]sdk.Event, [][]byte, error) {
+	err := PerformSetMaxSupply(m.tokenFactory, ctx, contractAddr, setMaxSupply)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform set max supply")
+	}
+	return nil, nil, nil
+}
+
+// PerformSetMaxSupply is used with setMaxSupply to validate setMaxSupply messages and to dispatch.
+func PerformSetMaxSupply(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, setMaxSupply *bindingstypes.SetMaxSupply) error {
+	if setMaxSupply == nil {
+		return wasmvmtypes.InvalidRequest{Err: "set max supply null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgSetMaxSupply(contractAddr.String(), setMaxSupply.Denom, setMaxSupply.MaxSupply, setMaxSupply.Immutable)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.SetMaxSupply(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "setting max supply from message")
+	}
+	return nil
+}
+
+// setMintRateLimit rate limits the minting of a denom.
+func (m *CustomMessenger) setMintRateLimit(ctx sdk.Context, contractAddr sdk.AccAddress, setMintRateLimit *bindingstypes.SetMintRateLimit) ([]sdk.Event, [][]byte, error) {
+	err := PerformSetMintRateLimit(m.tokenFactory, ctx, contractAddr, setMintRateLimit)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform set mint rate limit")
+	}
+	return nil, nil, nil
+}
+
+// PerformSetMintRateLimit is used with setMintRateLimit to validate setMintRateLimit messages and to dispatch.
+func PerformSetMintRateLimit(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, setMintRateLimit *bindingstypes.SetMintRateLimit) error {
+	if setMintRateLimit == nil {
+		return wasmvmtypes.InvalidRequest{Err: "set mint rate limit null"}
+	}
+
+	// the window must fit in a time.Duration
+	if setMintRateLimit.WindowSeconds > uint64(math.MaxInt64/int64(time.Second)) {
+		return wasmvmtypes.InvalidRequest{Err: "set mint rate limit window too long"}
+	}
+
+	window := time.Duration(setMintRateLimit.WindowSeconds) * time.Second
+	sdkMsg := tokenfactorytypes.NewMsgSetMintRateLimit(contractAddr.String(), setMintRateLimit.Denom, setMintRateLimit.Amount, window)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.SetMintRateLimit(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "setting mint rate limit from message")
+	}
+	return nil
+}
+
 // createDenom creates a new token denom
 func (m *CustomMessenger) setMetadata(ctx sdk.Context, contractAddr sdk.AccAddress, setMetadata *bindingstypes.SetMetadata) ([]sdk.Event, [][]byte, error) {
 	err := PerformSetMetadata(m.tokenFactory, m.bank, ctx, contractAddr, setMetadata.Denom, setMetadata.Metadata)
@@ -275,13 +407,13 @@ func (m *CustomMessenger) setMetadata(ctx sdk.Context, contractAddr sdk.AccAddre
 // PerformSetMetadata is used with setMetadata to add new metadata
 // It also is called inside CreateDenom if optional metadata field is set
 func PerformSetMetadata(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, denom string, metadata bindingstypes.Metadata) error {
-	// ensure contract address is admin of denom
+	// ensure contract address can set the metadata of denom
 	auth, err := f.GetAuthorityMetadata(ctx, denom)
 	if err != nil {
 		return err
 	}
-	if auth.Admin != contractAddr.String() {
-		return wasmvmtypes.InvalidRequest{Err: "only admin can set metadata"}
+	if !auth.HasRole(tokenfactorytypes.RoleMetadata, contractAddr.String()) {
+		return wasmvmtypes.InvalidRequest{Err: "only admin or metadata role can set metadata"}
 	}
 
 	// ensure we are setting proper denom metadata (bank uses Base field, fill it if missing)
@@ -32,6 +32,31 @@ func (qp QueryPlugin) GetDenomAdmin(ctx sdk.Context, denom string) (*bindingstyp
 	return &bindingstypes.AdminResponse{Admin: metadata.Admin}, nil
 }
 
+// GetDenomRoles is a query to get the roles of an address over a denom.
+func (qp QueryPlugin) GetDenomRoles(ctx sdk.Context, denom string, address string) (*bindingstypes.RolesResponse, error) {
+	metadata, err := qp.tokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles for denom: %s", denom)
+	}
+	return &bindingstypes.RolesResponse{Roles: metadata.GetRoles(address)}, nil
+}
+
+// GetMintLimits is a query to get the max supply and mint rate limit of a denom.
+func (qp QueryPlugin) GetMintLimits(ctx sdk.Context, denom string) (*bindingstypes.MintLimitsResponse, error) {
+	limits, _ := qp.tokenFactoryKeeper.GetMintLimits(ctx, denom)
+	res := &bindingstypes.MintLimitsResponse{
+		MaxSupply:          limits.MaxSupply,
+		MaxSupplyImmutable: limits.MaxSupplyImmutable,
+		RateLimit:          limits.RateLimit,
+		WindowSeconds:      uint64(limits.RateLimitWindow.Seconds()),
+		WindowMinted:       limits.WindowMinted,
+	}
+	if mintable, limited := qp.tokenFactoryKeeper.GetMintable(ctx, denom); limited {
+		res.Mintable = &mintable
+	}
+	return res, nil
+}
+
 func (qp QueryPlugin) GetDenomsByCreator(ctx sdk.Context, creator string) (*bindingstypes.DenomsByCreatorResponse, error) {
 	// TODO: validate creator address
 	denoms := qp.tokenFactoryKeeper.GetDenomsFromCreator(ctx, creator)
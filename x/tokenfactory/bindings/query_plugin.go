@@ -94,6 +94,32 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.Roles != nil:
+			res, err := qp.GetDenomRoles(ctx, contractQuery.Roles.Denom, contractQuery.Roles.Address)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal RolesResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case contractQuery.MintLimits != nil:
+			res, err := qp.GetMintLimits(ctx, contractQuery.MintLimits.Denom)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal MintLimitsResponse: %w", err)
+			}
+
+			return bz, nil
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown token query variant"}
 		}
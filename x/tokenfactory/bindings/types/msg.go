@@ -19,6 +19,14 @@ type TokenFactoryMsg struct {
 	SetMetadata *SetMetadata `json:"set_metadata,omitempty"`
 	/// Forces a transfer of tokens from one address to another.
 	ForceTransfer *ForceTransfer `json:"force_transfer,omitempty"`
+	/// Contracts can grant a role over a denom that they are the admin of.
+	GrantRole *GrantRole `json:"grant_role,omitempty"`
+	/// Contracts can revoke a role over a denom that they are the admin of.
+	RevokeRole *RevokeRole `json:"revoke_role,omitempty"`
+	/// Contracts can cap the supply of a denom that they are the admin of.
+	SetMaxSupply *SetMaxSupply `json:"set_max_supply,omitempty"`
+	/// Contracts can rate limit the minting of a denom that they are the admin of.
+	SetMintRateLimit *SetMintRateLimit `json:"set_mint_rate_limit,omitempty"`
 }
 
 // CreateDenom creates a new factory denom, of denomination:
@@ -62,3 +70,34 @@ type ForceTransfer struct {
 	FromAddress string   `json:"from_address"`
 	ToAddress   string   `json:"to_address"`
 }
+
+// GrantRole grants a role over a factory denom to an address.
+// The role is one of minter, burner, metadata or force_transfer.
+type GrantRole struct {
+	Denom   string `json:"denom"`
+	Role    string `json:"role"`
+	Address string `json:"address"`
+}
+
+// RevokeRole revokes a role over a factory denom granted to an address.
+type RevokeRole struct {
+	Denom   string `json:"denom"`
+	Role    string `json:"role"`
+	Address string `json:"address"`
+}
+
+// SetMaxSupply caps the supply of a factory denom. Once set, the max supply
+// can only be lowered, and not at all once immutable.
+type SetMaxSupply struct {
+	Denom     string   `json:"denom"`
+	MaxSupply math.Int `json:"max_supply"`
+	Immutable bool     `json:"immutable"`
+}
+
+// SetMintRateLimit caps the amount of a factory denom minted during each
+// window of WindowSeconds. A zero amount removes the rate limit.
+type SetMintRateLimit struct {
+	Denom         string   `json:"denom"`
+	Amount        math.Int `json:"amount"`
+	WindowSeconds uint64   `json:"window_seconds"`
+}
@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/math"
+
 // See https://github.com/CosmWasm/token-bindings/blob/main/packages/bindings/src/query.rs
 type TokenFactoryQuery struct {
 	/// Given a subdenom minted by a contract via `OsmosisMsg::MintTokens`,
@@ -9,6 +11,8 @@ type TokenFactoryQuery struct {
 	Metadata        *GetMetadata     `json:"metadata,omitempty"`
 	DenomsByCreator *DenomsByCreator `json:"denoms_by_creator,omitempty"`
 	Params          *GetParams       `json:"params,omitempty"`
+	Roles           *DenomRoles      `json:"roles,omitempty"`
+	MintLimits      *MintLimits      `json:"mint_limits,omitempty"`
 }
 
 // query types
@@ -32,6 +36,15 @@ type DenomsByCreator struct {
 
 type GetParams struct{}
 
+type MintLimits struct {
+	Denom string `json:"denom"`
+}
+
+type DenomRoles struct {
+	Denom   string `json:"denom"`
+	Address string `json:"address"`
+}
+
 // responses
 
 type FullDenomResponse struct {
@@ -53,3 +66,16 @@ type DenomsByCreatorResponse struct {
 type ParamsResponse struct {
 	Params Params `json:"params"`
 }
+
+type RolesResponse struct {
+	Roles []string `json:"roles"`
+}
+
+type MintLimitsResponse struct {
+	MaxSupply          math.Int  `json:"max_supply"`
+	MaxSupplyImmutable bool      `json:"max_supply_immutable"`
+	RateLimit          math.Int  `json:"rate_limit"`
+	WindowSeconds      uint64    `json:"window_seconds"`
+	WindowMinted       math.Int  `json:"window_minted"`
+	Mintable           *math.Int `json:"mintable,omitempty"`
+}
@@ -2,7 +2,9 @@ package bindings_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -413,3 +415,78 @@ func TestBurn(t *testing.T) {
 		})
 	}
 }
+
+func TestGrantRevokeRole(t *testing.T) {
+	creator := RandomAccountAddress()
+	junoapp, ctx := SetupCustomApp(t, creator)
+
+	fundAccount(t, ctx, junoapp, creator, types.DefaultParams().DenomCreationFee)
+	_, err := wasmbinding.PerformCreateDenom(&junoapp.AppKeepers.TokenFactoryKeeper, junoapp.AppKeepers.BankKeeper, ctx, creator, &bindings.CreateDenom{Subdenom: "MOON"})
+	require.NoError(t, err)
+	denom := fmt.Sprintf("factory/%s/MOON", creator.String())
+
+	bridge := RandomAccountAddress()
+	grant := &bindings.GrantRole{Denom: denom, Role: types.RoleMinter, Address: bridge.String()}
+
+	// only the admin can grant roles
+	require.Error(t, wasmbinding.PerformGrantRole(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, bridge, grant))
+	require.Error(t, wasmbinding.PerformGrantRole(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, creator, &bindings.GrantRole{Denom: denom, Role: "admin", Address: bridge.String()}))
+	require.Error(t, wasmbinding.PerformGrantRole(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, creator, nil))
+	require.NoError(t, wasmbinding.PerformGrantRole(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, creator, grant))
+
+	// the bridge mints with its role
+	mint := &bindings.MintTokens{Denom: denom, Amount: sdk.NewInt(100), MintToAddress: bridge.String()}
+	require.NoError(t, wasmbinding.PerformMint(&junoapp.AppKeepers.TokenFactoryKeeper, junoapp.AppKeepers.BankKeeper, ctx, bridge, mint))
+	require.Equal(t, int64(100), junoapp.AppKeepers.BankKeeper.GetBalance(ctx, bridge, denom).Amount.Int64())
+
+	revoke := &bindings.RevokeRole{Denom: denom, Role: types.RoleMinter, Address: bridge.String()}
+	require.NoError(t, wasmbinding.PerformRevokeRole(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, creator, revoke))
+	require.Error(t, wasmbinding.PerformRevokeRole(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, creator, revoke))
+	require.Error(t, wasmbinding.PerformMint(&junoapp.AppKeepers.TokenFactoryKeeper, junoapp.AppKeepers.BankKeeper, ctx, bridge, mint))
+}
+
+func TestMintLimits(t *testing.T) {
+	creator := RandomAccountAddress()
+	junoapp, ctx := SetupCustomApp(t, creator)
+
+	fundAccount(t, ctx, junoapp, creator, types.DefaultParams().DenomCreationFee)
+	_, err := wasmbinding.PerformCreateDenom(&junoapp.AppKeepers.TokenFactoryKeeper, junoapp.AppKeepers.BankKeeper, ctx, creator, &bindings.CreateDenom{Subdenom: "MOON"})
+	require.NoError(t, err)
+	denom := fmt.Sprintf("factory/%s/MOON", creator.String())
+
+	// only the admin can limit the minting
+	setMaxSupply := &bindings.SetMaxSupply{Denom: denom, MaxSupply: sdk.NewInt(1_000)}
+	require.Error(t, wasmbinding.PerformSetMaxSupply(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, RandomAccountAddress(), setMaxSupply))
+	require.Error(t, wasmbinding.PerformSetMaxSupply(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, creator, &bindings.SetMaxSupply{Denom: denom}))
+	require.NoError(t, wasmbinding.PerformSetMaxSupply(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, creator, setMaxSupply))
+	// windows overflowing a time.Duration are rejected instead of wrapping
+	overflowing := &bindings.SetMintRateLimit{Denom: denom, Amount: sdk.NewInt(600), WindowSeconds: 18_446_744_074}
+	require.Error(t, wasmbinding.PerformSetMintRateLimit(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, creator, overflowing))
+	overflowing.WindowSeconds = math.MaxInt64/uint64(time.Second) + 1
+	require.Error(t, wasmbinding.PerformSetMintRateLimit(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, creator, overflowing))
+	setMintRateLimit := &bindings.SetMintRateLimit{Denom: denom, Amount: sdk.NewInt(600), WindowSeconds: 3600}
+	require.NoError(t, wasmbinding.PerformSetMintRateLimit(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, creator, setMintRateLimit))
+
+	// MintTokens enforces the limits
+	mint := &bindings.MintTokens{Denom: denom, Amount: sdk.NewInt(500), MintToAddress: creator.String()}
+	require.NoError(t, wasmbinding.PerformMint(&junoapp.AppKeepers.TokenFactoryKeeper, junoapp.AppKeepers.BankKeeper, ctx, creator, mint))
+	require.Error(t, wasmbinding.PerformMint(&junoapp.AppKeepers.TokenFactoryKeeper, junoapp.AppKeepers.BankKeeper, ctx, creator, mint))
+
+	queryPlugin := wasmbinding.NewQueryPlugin(junoapp.AppKeepers.BankKeeper, &junoapp.AppKeepers.TokenFactoryKeeper)
+	res, err := queryPlugin.GetMintLimits(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1_000), res.MaxSupply)
+	require.Equal(t, sdk.NewInt(600), res.RateLimit)
+	require.Equal(t, uint64(3600), res.WindowSeconds)
+	require.Equal(t, sdk.NewInt(500), res.WindowMinted)
+	require.Equal(t, sdk.NewInt(100), *res.Mintable)
+
+	// the next window allows minting up to the max supply
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	res, err = queryPlugin.GetMintLimits(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(500), *res.Mintable)
+	require.NoError(t, wasmbinding.PerformMint(&junoapp.AppKeepers.TokenFactoryKeeper, junoapp.AppKeepers.BankKeeper, ctx, creator, mint))
+	mint.Amount = sdk.NewInt(1)
+	require.Error(t, wasmbinding.PerformMint(&junoapp.AppKeepers.TokenFactoryKeeper, junoapp.AppKeepers.BankKeeper, ctx, creator, mint))
+}
@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	wasmbinding "github.com/CosmosContracts/juno/v26/x/tokenfactory/bindings"
+	"github.com/CosmosContracts/juno/v26/x/tokenfactory/types"
 )
 
 func TestFullDenom(t *testing.T) {
@@ -115,3 +116,53 @@ func TestDenomAdmin(t *testing.T) {
 		})
 	}
 }
+
+func TestDenomRoles(t *testing.T) {
+	addr := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, addr)
+
+	// set token creation fee to zero to make testing easier
+	tfParams := app.AppKeepers.TokenFactoryKeeper.GetParams(ctx)
+	tfParams.DenomCreationFee = sdk.NewCoins()
+	require.NoError(t, app.AppKeepers.TokenFactoryKeeper.SetParams(ctx, tfParams))
+
+	admin := sdk.AccAddress([]byte("addr1_______________"))
+	frontend := sdk.AccAddress([]byte("addr2_______________"))
+	tfDenom, err := app.AppKeepers.TokenFactoryKeeper.CreateDenom(ctx, admin.String(), "subdenom")
+	require.NoError(t, err)
+	require.NoError(t, app.AppKeepers.TokenFactoryKeeper.GrantRole(ctx, tfDenom, types.RoleMetadata, frontend.String()))
+
+	queryPlugin := wasmbinding.NewQueryPlugin(app.AppKeepers.BankKeeper, &app.AppKeepers.TokenFactoryKeeper)
+
+	testCases := []struct {
+		name        string
+		address     string
+		expectRoles []string
+	}{
+		{
+			name:        "admin",
+			address:     admin.String(),
+			expectRoles: types.Roles,
+		},
+		{
+			name:        "granted role",
+			address:     frontend.String(),
+			expectRoles: []string{types.RoleMetadata},
+		},
+		{
+			name:        "no role",
+			address:     addr.String(),
+			expectRoles: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := queryPlugin.GetDenomRoles(ctx, tfDenom, tc.address)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectRoles, resp.Roles)
+		})
+	}
+}
@@ -26,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 		GetParams(),
 		GetCmdDenomAuthorityMetadata(),
 		GetCmdDenomsFromCreator(),
+		GetCmdDenomRoles(),
+		GetCmdDenomMintLimits(),
 	)
 
 	return cmd
@@ -115,3 +117,62 @@ func GetCmdDenomsFromCreator() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdDenomRoles returns the roles of an address over a queried denom
+func GetCmdDenomRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-roles [denom] [address] [flags]",
+		Short: "Get the roles of an address over a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomRoles(cmd.Context(), &types.QueryDenomRolesRequest{
+				Denom:   args[0],
+				Address: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomMintLimits returns the max supply and mint rate limit of a queried denom
+func GetCmdDenomMintLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-limits [denom] [flags]",
+		Short: "Get the max supply and mint rate limit of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomMintLimits(cmd.Context(), &types.QueryDenomMintLimitsRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
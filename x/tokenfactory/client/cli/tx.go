@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -16,6 +19,9 @@ import (
 	"github.com/CosmosContracts/juno/v26/x/tokenfactory/types"
 )
 
+// FlagImmutable makes the max supply of a denom immutable
+const FlagImmutable = "immutable"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -34,6 +40,10 @@ func GetTxCmd() *cobra.Command {
 		NewBurnFromCmd(),
 		NewForceTransferCmd(),
 		NewChangeAdminCmd(),
+		NewGrantRoleCmd(),
+		NewRevokeRoleCmd(),
+		NewSetMaxSupplyCmd(),
+		NewSetMintRateLimitCmd(),
 		NewModifyDenomMetadataCmd(),
 	)
 
@@ -299,6 +309,165 @@ func NewChangeAdminCmd() *cobra.Command {
 	return cmd
 }
 
+// NewGrantRoleCmd broadcast MsgGrantRole
+func NewGrantRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [denom] [role] [address] [flags]",
+		Short: "Grants a role over a factory-created denom to an address. Must have admin authority to do so.",
+		Long:  "Grants a role over a factory-created denom to an address. The role is one of minter, burner, metadata or force_transfer. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgGrantRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRevokeRoleCmd broadcast MsgRevokeRole
+func NewRevokeRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [denom] [role] [address] [flags]",
+		Short: "Revokes a role over a factory-created denom from an address. Must have admin authority to do so.",
+		Long:  "Revokes a role over a factory-created denom from an address. The role is one of minter, burner, metadata or force_transfer. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgRevokeRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetMaxSupplyCmd broadcast MsgSetMaxSupply
+func NewSetMaxSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-max-supply [denom] [max-supply] [flags]",
+		Short: "Caps the supply of a factory-created denom. Must have admin authority to do so.",
+		Long: `Caps the supply of a factory-created denom. Once set, the max supply can only be lowered,
+down to the current supply. With --immutable, the max supply can not be changed anymore.
+Must have admin authority to do so.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			maxSupply, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max supply: %s", args[1])
+			}
+
+			immutable, err := cmd.Flags().GetBool(FlagImmutable)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMaxSupply(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				maxSupply,
+				immutable,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagImmutable, false, "Forbid any later change of the max supply")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetMintRateLimitCmd broadcast MsgSetMintRateLimit
+func NewSetMintRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-mint-rate-limit [denom] [amount] [window] [flags]",
+		Short:   "Caps the amount of a factory-created denom minted during each window. A zero amount removes the rate limit. Must have admin authority to do so.",
+		Example: "set-mint-rate-limit factory/juno1.../token 1000000 24h",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			amount, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[1])
+			}
+
+			window, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMintRateLimit(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				amount,
+				window,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewModifyDenomMetadataCmd broadcast a Bank Metadata modification transaction
 func NewModifyDenomMetadataCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -38,13 +38,50 @@ func (k Keeper) setAuthorityMetadata(ctx sdk.Context, denom string, metadata typ
 	return nil
 }
 
+// setAdmin sets the admin of a denom. The roles granted by the previous admin
+// are revoked when the admin changes, so the new admin starts from a clean slate.
 func (k Keeper) setAdmin(ctx sdk.Context, denom string, admin string) error {
 	metadata, err := k.GetAuthorityMetadata(ctx, denom)
 	if err != nil {
 		return err
 	}
 
+	if metadata.Admin != admin {
+		metadata.Minters = nil
+		metadata.Burners = nil
+		metadata.MetadataSetters = nil
+		metadata.ForceTransferrers = nil
+	}
+
 	metadata.Admin = admin
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
+
+// GrantRole grants a role over a denom to an address
+func (k Keeper) GrantRole(ctx sdk.Context, denom string, role string, addr string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if err := metadata.GrantRole(role, addr); err != nil {
+		return err
+	}
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// RevokeRole revokes a role over a denom granted to an address
+func (k Keeper) RevokeRole(ctx sdk.Context, denom string, role string, addr string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if err := metadata.RevokeRole(role, addr); err != nil {
+		return err
+	}
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
@@ -13,6 +13,10 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return err
 	}
 
+	if err := k.useMintLimits(ctx, amount); err != nil {
+		return err
+	}
+
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 	if err != nil {
 		return err
@@ -32,6 +36,12 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		sdk.NewCoins(amount))
 }
 
+// BurnFrom burns a tokenfactory denom held by an account. Callers are
+// responsible for checking the burner role of the denom.
+func (k Keeper) BurnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) error {
+	return k.burnFrom(ctx, amount, burnFrom)
+}
+
 func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) error {
 	// verify that denom is an x/tokenfactory denom
 	_, _, err := types.DeconstructDenom(amount.Denom)
@@ -56,7 +66,14 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 		return err
 	}
 
-	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	if k.burnRecorder != nil {
+		return k.burnRecorder.RecordBurn(ctx, types.ModuleName, sdk.NewCoins(amount))
+	}
+	return nil
 }
 
 func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string, toAddr string) error {
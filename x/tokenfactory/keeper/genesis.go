@@ -31,6 +31,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		if genDenom.MintLimits != nil {
+			err = k.setMintLimits(ctx, genDenom.GetDenom(), *genDenom.MintLimits)
+			if err != nil {
+				panic(err)
+			}
+		}
 	}
 }
 
@@ -47,10 +53,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
-		genDenoms = append(genDenoms, types.GenesisDenom{
+		genDenom := types.GenesisDenom{
 			Denom:             denom,
 			AuthorityMetadata: authorityMetadata,
-		})
+		}
+		if limits, found := k.GetMintLimits(ctx, denom); found {
+			genDenom.MintLimits = &limits
+		}
+
+		genDenoms = append(genDenoms, genDenom)
 	}
 
 	return &types.GenesisState{
@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -27,6 +31,14 @@ func (suite *KeeperTestSuite) TestGenesis() {
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "juno1t7egva48prqmzl59x5ngv4zx0dtrwewcmjwfym",
 				},
+				MintLimits: &types.DenomMintLimits{
+					MaxSupply:          math.NewInt(21_000_000),
+					MaxSupplyImmutable: true,
+					RateLimit:          math.NewInt(1_000),
+					RateLimitWindow:    time.Hour,
+					WindowStart:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					WindowMinted:       math.NewInt(10),
+				},
 			},
 		},
 	}
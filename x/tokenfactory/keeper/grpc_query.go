@@ -33,3 +33,26 @@ func (k Keeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFro
 	denoms := k.GetDenomsFromCreator(sdkCtx, req.GetCreator())
 	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms}, nil
 }
+
+func (k Keeper) DenomRoles(ctx context.Context, req *types.QueryDenomRolesRequest) (*types.QueryDenomRolesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	authorityMetadata, err := k.GetAuthorityMetadata(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomRolesResponse{Roles: authorityMetadata.GetRoles(req.GetAddress())}, nil
+}
+
+func (k Keeper) DenomMintLimits(ctx context.Context, req *types.QueryDenomMintLimitsRequest) (*types.QueryDenomMintLimitsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	limits, _ := k.GetMintLimits(sdkCtx, req.GetDenom())
+	res := &types.QueryDenomMintLimitsResponse{MintLimits: limits}
+	if mintable, limited := k.GetMintable(sdkCtx, req.GetDenom()); limited {
+		res.Mintable = &mintable
+	}
+
+	return res, nil
+}
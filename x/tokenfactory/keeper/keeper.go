@@ -24,6 +24,7 @@ type (
 		accountKeeper       types.AccountKeeper
 		bankKeeper          types.BankKeeper
 		communityPoolKeeper types.CommunityPoolKeeper
+		burnRecorder        types.BurnRecorder
 
 		enabledCapabilities []string
 
@@ -41,6 +42,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	communityPoolKeeper types.CommunityPoolKeeper,
+	burnRecorder types.BurnRecorder,
 	enabledCapabilities []string,
 	authority string,
 ) Keeper {
@@ -61,6 +63,7 @@ func NewKeeper(
 		accountKeeper:       accountKeeper,
 		bankKeeper:          bankKeeper,
 		communityPoolKeeper: communityPoolKeeper,
+		burnRecorder:        burnRecorder,
 
 		enabledCapabilities: enabledCapabilities,
 
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/tokenfactory/types"
+)
+
+// GetMintLimits returns the mint limits of a denom, and false if the admin
+// never limited the minting of the denom.
+func (k Keeper) GetMintLimits(ctx sdk.Context, denom string) (types.DenomMintLimits, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomMintLimitsKey))
+	if bz == nil {
+		return types.DefaultDenomMintLimits(), false
+	}
+
+	limits := types.DenomMintLimits{}
+	if err := proto.Unmarshal(bz, &limits); err != nil {
+		panic(err)
+	}
+	return limits, true
+}
+
+// setMintLimits stores the mint limits of a denom
+func (k Keeper) setMintLimits(ctx sdk.Context, denom string, limits types.DenomMintLimits) error {
+	if err := limits.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&limits)
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set([]byte(types.DenomMintLimitsKey), bz)
+	return nil
+}
+
+// SetMaxSupply caps the supply of a denom. Once set, the max supply can only
+// be lowered down to the current supply, and not at all once immutable.
+func (k Keeper) SetMaxSupply(ctx sdk.Context, denom string, maxSupply math.Int, immutable bool) error {
+	limits, _ := k.GetMintLimits(ctx, denom)
+
+	if limits.MaxSupplyImmutable {
+		return types.ErrMaxSupplyImmutable.Wrapf("denom: %s", denom)
+	}
+
+	if limits.HasMaxSupply() && maxSupply.GT(limits.MaxSupply) {
+		return types.ErrInvalidMaxSupply.Wrapf("max supply can only be lowered, current max supply: %s", limits.MaxSupply)
+	}
+
+	if supply := k.bankKeeper.GetSupply(ctx, denom).Amount; maxSupply.LT(supply) {
+		return types.ErrInvalidMaxSupply.Wrapf("max supply is below the current supply: %s", supply)
+	}
+
+	limits.MaxSupply = maxSupply
+	limits.MaxSupplyImmutable = immutable
+	return k.setMintLimits(ctx, denom, limits)
+}
+
+// SetMintRateLimit limits the amount of a denom minted during each window,
+// starting a new window. A zero amount removes the rate limit.
+func (k Keeper) SetMintRateLimit(ctx sdk.Context, denom string, amount math.Int, window time.Duration) error {
+	limits, _ := k.GetMintLimits(ctx, denom)
+
+	limits.RateLimit = amount
+	limits.RateLimitWindow = window
+	limits.WindowStart = ctx.BlockTime()
+	limits.WindowMinted = math.ZeroInt()
+	if !amount.IsPositive() {
+		limits.RateLimitWindow = 0
+	}
+
+	return k.setMintLimits(ctx, denom, limits)
+}
+
+// GetMintable returns the amount of a denom which can currently be minted,
+// and false if the minting of the denom is not limited.
+func (k Keeper) GetMintable(ctx sdk.Context, denom string) (math.Int, bool) {
+	limits, found := k.GetMintLimits(ctx, denom)
+	if !found {
+		return math.Int{}, false
+	}
+
+	return limits.Mintable(k.bankKeeper.GetSupply(ctx, denom).Amount, ctx.BlockTime())
+}
+
+// useMintLimits checks that an amount can be minted within the limits of its
+// denom, and records it in the current rate limit window.
+func (k Keeper) useMintLimits(ctx sdk.Context, amount sdk.Coin) error {
+	limits, found := k.GetMintLimits(ctx, amount.Denom)
+	if !found {
+		return nil
+	}
+
+	if limits.HasMaxSupply() {
+		supply := k.bankKeeper.GetSupply(ctx, amount.Denom).Amount
+		if supply.Add(amount.Amount).GT(limits.MaxSupply) {
+			return types.ErrMaxSupplyExceeded.Wrapf("supply: %s, max supply: %s", supply, limits.MaxSupply)
+		}
+	}
+
+	if !limits.HasRateLimit() {
+		return nil
+	}
+
+	limits.RecordMint(amount.Amount, ctx.BlockTime())
+	if limits.WindowMinted.GT(limits.RateLimit) {
+		return types.ErrMintRateLimitExceeded.Wrapf(
+			"rate limit: %s per %s, window start: %s", limits.RateLimit, limits.RateLimitWindow, limits.WindowStart,
+		)
+	}
+
+	return k.setMintLimits(ctx, amount.Denom, limits)
+}
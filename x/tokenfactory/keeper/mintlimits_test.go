@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v26/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestMaxSupply() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+
+	// the minting of the denom is not limited by default
+	res, err := suite.queryClient.DenomMintLimits(suite.Ctx.Context(), &types.QueryDenomMintLimitsRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultDenomMintLimits(), res.MintLimits)
+	suite.Require().Nil(res.Mintable)
+
+	for _, tc := range []struct {
+		desc   string
+		msg    *types.MsgSetMaxSupply
+		expErr error
+	}{
+		{
+			desc:   "Fail - Not the admin",
+			msg:    types.NewMsgSetMaxSupply(suite.TestAccs[1].String(), suite.defaultDenom, math.NewInt(1_000), false),
+			expErr: types.ErrUnauthorized,
+		},
+		{
+			desc:   "Fail - Below the current supply",
+			msg:    types.NewMsgSetMaxSupply(admin, suite.defaultDenom, math.NewInt(99), false),
+			expErr: types.ErrInvalidMaxSupply,
+		},
+		{
+			desc: "Success - Set the max supply",
+			msg:  types.NewMsgSetMaxSupply(admin, suite.defaultDenom, math.NewInt(1_000), false),
+		},
+		{
+			desc:   "Fail - Raise the max supply",
+			msg:    types.NewMsgSetMaxSupply(admin, suite.defaultDenom, math.NewInt(1_001), false),
+			expErr: types.ErrInvalidMaxSupply,
+		},
+		{
+			desc: "Success - Lower the max supply and make it immutable",
+			msg:  types.NewMsgSetMaxSupply(admin, suite.defaultDenom, math.NewInt(500), true),
+		},
+		{
+			desc:   "Fail - Immutable max supply",
+			msg:    types.NewMsgSetMaxSupply(admin, suite.defaultDenom, math.NewInt(400), false),
+			expErr: types.ErrMaxSupplyImmutable,
+		},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			_, err := suite.msgServer.SetMaxSupply(sdk.WrapSDKContext(suite.Ctx), tc.msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	res, err = suite.queryClient.DenomMintLimits(suite.Ctx.Context(), &types.QueryDenomMintLimitsRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(500), res.MintLimits.MaxSupply)
+	suite.Require().True(res.MintLimits.MaxSupplyImmutable)
+	suite.Require().Equal(math.NewInt(400), *res.Mintable)
+
+	// minting up to the max supply
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 401)))
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 400)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(500), suite.App.AppKeepers.BankKeeper.GetSupply(suite.Ctx, suite.defaultDenom).Amount.Int64())
+
+	// burned tokens can be minted again
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(admin, sdk.NewInt64Coin(suite.defaultDenom, 50)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 50)))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestMintRateLimit() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	start := suite.Ctx.BlockTime()
+
+	_, err := suite.msgServer.SetMintRateLimit(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMintRateLimit(suite.TestAccs[1].String(), suite.defaultDenom, math.NewInt(100), time.Hour))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.SetMintRateLimit(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMintRateLimit(admin, suite.defaultDenom, math.NewInt(100), time.Hour))
+	suite.Require().NoError(err)
+
+	// minting within the window
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 60)))
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(30 * time.Minute))
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 41)))
+	suite.Require().ErrorIs(err, types.ErrMintRateLimitExceeded)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 40)))
+	suite.Require().NoError(err)
+
+	mintable, limited := suite.App.AppKeepers.TokenFactoryKeeper.GetMintable(suite.Ctx, suite.defaultDenom)
+	suite.Require().True(limited)
+	suite.Require().True(mintable.IsZero())
+
+	// a new window starts once the current one ended
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(time.Hour))
+	mintable, _ = suite.App.AppKeepers.TokenFactoryKeeper.GetMintable(suite.Ctx, suite.defaultDenom)
+	suite.Require().Equal(math.NewInt(100), mintable)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+
+	limits, found := suite.App.AppKeepers.TokenFactoryKeeper.GetMintLimits(suite.Ctx, suite.defaultDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(start.Add(time.Hour), limits.WindowStart)
+	suite.Require().Equal(math.NewInt(100), limits.WindowMinted)
+
+	// removing the rate limit
+	_, err = suite.msgServer.SetMintRateLimit(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMintRateLimit(admin, suite.defaultDenom, math.ZeroInt(), 0))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 1_000)))
+	suite.Require().NoError(err)
+	_, limited = suite.App.AppKeepers.TokenFactoryKeeper.GetMintable(suite.Ctx, suite.defaultDenom)
+	suite.Require().False(limited)
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	minttypes "github.com/CosmosContracts/juno/v26/x/mint/types"
 	"github.com/CosmosContracts/juno/v26/x/tokenfactory/types"
 )
 
@@ -87,6 +88,10 @@ func (suite *KeeperTestSuite) TestBurnDenomMsg() {
 			suite.AssertEventEmitted(ctx, types.TypeMsgBurn, tc.expectedMessageEvents)
 		})
 	}
+
+	// the burn is recorded in the x/mint burned supply
+	burned := suite.App.AppKeepers.MintKeeper.GetBurnedSupply(suite.Ctx, minttypes.BurnSourceTokenFactory)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10)), burned)
 }
 
 // TestCreateDenomMsg tests TypeMsgCreateDenom message is emitted on a successful denom creation
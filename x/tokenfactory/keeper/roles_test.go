@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/CosmosContracts/juno/v26/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestDenomRoles() {
+	suite.CreateDefaultDenom()
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+	admin, bridge, frontend := suite.TestAccs[0].String(), suite.TestAccs[1].String(), suite.TestAccs[2].String()
+
+	// the admin holds every role
+	res, err := suite.queryClient.DenomRoles(ctx, &types.QueryDenomRolesRequest{Denom: suite.defaultDenom, Address: admin})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Roles, res.Roles)
+
+	for _, tc := range []struct {
+		desc   string
+		msg    *types.MsgGrantRole
+		expErr error
+	}{
+		{
+			desc:   "Fail - Not the admin",
+			msg:    types.NewMsgGrantRole(bridge, suite.defaultDenom, types.RoleMinter, bridge),
+			expErr: types.ErrUnauthorized,
+		},
+		{
+			desc:   "Fail - Unknown role",
+			msg:    types.NewMsgGrantRole(admin, suite.defaultDenom, "admin", bridge),
+			expErr: types.ErrInvalidRole,
+		},
+		{
+			desc:   "Fail - Admin already holds the role",
+			msg:    types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMinter, admin),
+			expErr: types.ErrRoleAlreadyGranted,
+		},
+		{
+			desc: "Success - Grant minter to the bridge",
+			msg:  types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMinter, bridge),
+		},
+		{
+			desc: "Success - Grant metadata to the frontend",
+			msg:  types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMetadata, frontend),
+		},
+		{
+			desc:   "Fail - Role already granted",
+			msg:    types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMinter, bridge),
+			expErr: types.ErrRoleAlreadyGranted,
+		},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			_, err := suite.msgServer.GrantRole(ctx, tc.msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	res, err = suite.queryClient.DenomRoles(ctx, &types.QueryDenomRolesRequest{Denom: suite.defaultDenom, Address: bridge})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{types.RoleMinter}, res.Roles)
+
+	// the bridge mints but can not burn nor set the metadata
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(bridge, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(10), suite.App.AppKeepers.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+	_, err = suite.msgServer.Burn(ctx, types.NewMsgBurn(bridge, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	metadata := banktypes.Metadata{
+		Description: "yeehaw",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: suite.defaultDenom, Exponent: 0}},
+		Base:        suite.defaultDenom,
+		Display:     suite.defaultDenom,
+		Name:        "bitcoin",
+		Symbol:      "BTC",
+	}
+	_, err = suite.msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(bridge, metadata))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the frontend sets the metadata but can not mint
+	_, err = suite.msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(frontend, metadata))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(frontend, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// only the admin grants roles
+	_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(bridge, suite.defaultDenom, types.RoleBurner, bridge))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(bridge, suite.defaultDenom, types.RoleMinter, bridge))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// revoked roles can no longer be used
+	_, err = suite.msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(admin, suite.defaultDenom, types.RoleMinter, bridge))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(admin, suite.defaultDenom, types.RoleMinter, bridge))
+	suite.Require().ErrorIs(err, types.ErrRoleNotGranted)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(bridge, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// roles are kept when the admin does not change
+	_, err = suite.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(admin, suite.defaultDenom, admin))
+	suite.Require().NoError(err)
+	authorityMetadata, err := suite.App.AppKeepers.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DenomAuthorityMetadata{Admin: admin, MetadataSetters: []string{frontend}}, authorityMetadata)
+
+	// roles are revoked when the admin changes
+	_, err = suite.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(admin, suite.defaultDenom, bridge))
+	suite.Require().NoError(err)
+	authorityMetadata, err = suite.App.AppKeepers.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DenomAuthorityMetadata{Admin: bridge}, authorityMetadata)
+	_, err = suite.msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(frontend, metadata))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
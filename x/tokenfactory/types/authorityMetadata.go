@@ -1,9 +1,34 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Roles which the admin of a denom can grant to other addresses. The admin
+// holds every role.
+const (
+	RoleMinter        = "minter"
+	RoleBurner        = "burner"
+	RoleMetadata      = "metadata"
+	RoleForceTransfer = "force_transfer"
+)
+
+// Roles lists the roles which can be granted over a denom
+var Roles = []string{RoleMinter, RoleBurner, RoleMetadata, RoleForceTransfer}
+
+// ValidateRole returns an error if the role is unknown
+func ValidateRole(role string) error {
+	for _, r := range Roles {
+		if r == role {
+			return nil
+		}
+	}
+
+	return ErrInvalidRole.Wrapf("role %s is not one of %v", role, Roles)
+}
+
 func (metadata DenomAuthorityMetadata) Validate() error {
 	if metadata.Admin != "" {
 		_, err := sdk.AccAddressFromBech32(metadata.Admin)
@@ -11,5 +36,94 @@ func (metadata DenomAuthorityMetadata) Validate() error {
 			return err
 		}
 	}
+
+	for _, role := range Roles {
+		seen := make(map[string]struct{})
+		for _, addr := range *metadata.holders(role) {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return err
+			}
+			if _, ok := seen[addr]; ok {
+				return fmt.Errorf("duplicate %s: %s", role, addr)
+			}
+			seen[addr] = struct{}{}
+		}
+	}
 	return nil
 }
+
+// holders returns the addresses granted a role
+func (metadata *DenomAuthorityMetadata) holders(role string) *[]string {
+	switch role {
+	case RoleMinter:
+		return &metadata.Minters
+	case RoleBurner:
+		return &metadata.Burners
+	case RoleMetadata:
+		return &metadata.MetadataSetters
+	case RoleForceTransfer:
+		return &metadata.ForceTransferrers
+	default:
+		return &[]string{}
+	}
+}
+
+// HasRole returns true if the address is the admin of the denom or was granted
+// the role.
+func (metadata DenomAuthorityMetadata) HasRole(role string, addr string) bool {
+	if addr == "" {
+		return false
+	}
+	if addr == metadata.Admin {
+		return true
+	}
+
+	for _, holder := range *metadata.holders(role) {
+		if holder == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRoles returns the roles of an address over the denom
+func (metadata DenomAuthorityMetadata) GetRoles(addr string) []string {
+	roles := []string{}
+	for _, role := range Roles {
+		if metadata.HasRole(role, addr) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// GrantRole grants a role to an address
+func (metadata *DenomAuthorityMetadata) GrantRole(role string, addr string) error {
+	if err := ValidateRole(role); err != nil {
+		return err
+	}
+	if metadata.HasRole(role, addr) {
+		return ErrRoleAlreadyGranted.Wrapf("%s is already %s", addr, role)
+	}
+
+	holders := metadata.holders(role)
+	*holders = append(*holders, addr)
+	return nil
+}
+
+// RevokeRole revokes a role granted to an address
+func (metadata *DenomAuthorityMetadata) RevokeRole(role string, addr string) error {
+	if err := ValidateRole(role); err != nil {
+		return err
+	}
+
+	holders := metadata.holders(role)
+	for i, holder := range *holders {
+		if holder == addr {
+			*holders = append((*holders)[:i], (*holders)[i+1:]...)
+			return nil
+		}
+	}
+
+	return ErrRoleNotGranted.Wrapf("%s is not %s", addr, role)
+}
@@ -25,11 +25,19 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // DenomAuthorityMetadata specifies metadata for addresses that have specific
-// capabilities over a token factory denom. Right now there is only one Admin
-// permission, but is planned to be extended to the future.
+// capabilities over a token factory denom. The admin holds every role and can
+// grant the other roles to other addresses.
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid osmosis address
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// minters can mint the denom
+	Minters []string `protobuf:"bytes,2,rep,name=minters,proto3" json:"minters,omitempty" yaml:"minters"`
+	// burners can burn the denom
+	Burners []string `protobuf:"bytes,3,rep,name=burners,proto3" json:"burners,omitempty" yaml:"burners"`
+	// metadata_setters can set the bank metadata of the denom
+	MetadataSetters []string `protobuf:"bytes,4,rep,name=metadata_setters,json=metadataSetters,proto3" json:"metadata_setters,omitempty" yaml:"metadata_setters"`
+	// force_transferrers can force transfer the denom
+	ForceTransferrers []string `protobuf:"bytes,5,rep,name=force_transferrers,json=forceTransferrers,proto3" json:"force_transferrers,omitempty" yaml:"force_transferrers"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -72,6 +80,34 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetMinters() []string {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetBurners() []string {
+	if m != nil {
+		return m.Burners
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetMetadataSetters() []string {
+	if m != nil {
+		return m.MetadataSetters
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetForceTransferrers() []string {
+	if m != nil {
+		return m.ForceTransferrers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "osmosis.tokenfactory.v1beta1.DenomAuthorityMetadata")
 }
@@ -81,23 +117,30 @@ func init() {
 }
 
 var fileDescriptor_99435de88ae175f7 = []byte{
-	// 243 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca,
-	0x2c, 0xa9, 0xf4, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0x81, 0xea, 0xd2, 0x43, 0xd6, 0xa5, 0x07, 0xd5, 0x25, 0x25, 0x92, 0x9e, 0x9f, 0x9e,
-	0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0xc9, 0x25, 0x83, 0x35, 0xe9, 0x27, 0x25, 0x16,
-	0xa7, 0xc2, 0x2d, 0x48, 0xce, 0xcf, 0xcc, 0x83, 0xc8, 0x2b, 0xb9, 0x71, 0x89, 0xb9, 0xa4, 0xe6,
-	0xe5, 0xe7, 0x3a, 0xa2, 0xdb, 0x29, 0xa4, 0xc6, 0xc5, 0x9a, 0x98, 0x92, 0x9b, 0x99, 0x27, 0xc1,
-	0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf0, 0xe9, 0x9e, 0x3c, 0x4f, 0x65, 0x62, 0x6e, 0x8e, 0x95,
-	0x12, 0x58, 0x58, 0x29, 0x08, 0x22, 0x6d, 0xc5, 0xf2, 0x62, 0x81, 0x3c, 0xa3, 0x93, 0xdf, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x3b, 0x83, 0x1d, 0xe3, 0x9c, 0x9f, 0x57, 0x52, 0x94, 0x98, 0x5c,
-	0x52, 0xac, 0x9f, 0x55, 0x9a, 0x97, 0xaf, 0x5f, 0x81, 0x1a, 0x0a, 0x25, 0x95, 0x05, 0xa9, 0xc5,
-	0x49, 0x6c, 0x60, 0xe7, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x33, 0x00, 0x78, 0xbe, 0x2a,
-	0x01, 0x00, 0x00,
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x3f, 0x4e, 0xe3, 0x40,
+	0x18, 0xc5, 0xe3, 0xfc, 0xd9, 0x55, 0xac, 0xd5, 0x6e, 0xd6, 0x5a, 0xed, 0x66, 0x03, 0xd8, 0x91,
+	0x0b, 0x94, 0x02, 0x79, 0x14, 0x91, 0x2a, 0x1d, 0x09, 0xa2, 0x02, 0x0a, 0x43, 0x45, 0x13, 0x8d,
+	0x9d, 0x49, 0x62, 0xc0, 0xf3, 0x45, 0x33, 0x9f, 0x11, 0xbe, 0x05, 0x47, 0xe0, 0x18, 0x1c, 0x81,
+	0x32, 0x25, 0x95, 0x85, 0x92, 0x86, 0xda, 0x27, 0x40, 0x1e, 0x3b, 0x28, 0x84, 0xce, 0x7a, 0xef,
+	0xf7, 0xde, 0xb3, 0xe6, 0xd3, 0x7b, 0x20, 0x43, 0x90, 0x81, 0x24, 0x08, 0x37, 0x8c, 0x4f, 0xa8,
+	0x8f, 0x20, 0x62, 0x72, 0xd7, 0xf5, 0x18, 0xd2, 0x2e, 0xa1, 0x11, 0xce, 0x40, 0x04, 0x18, 0x9f,
+	0x31, 0xa4, 0x63, 0x8a, 0xd4, 0x99, 0x0b, 0x40, 0x30, 0x76, 0x8b, 0x94, 0xb3, 0x99, 0x72, 0x8a,
+	0x54, 0xeb, 0xcf, 0x14, 0xa6, 0xa0, 0x40, 0x92, 0x7d, 0xe5, 0x99, 0x96, 0xe9, 0xab, 0x10, 0xf1,
+	0xa8, 0x64, 0x1f, 0x03, 0x3e, 0x04, 0x3c, 0xf7, 0xed, 0xa7, 0xb2, 0xfe, 0xf7, 0x98, 0x71, 0x08,
+	0x8f, 0xb6, 0x47, 0x8d, 0x7d, 0xbd, 0x46, 0xc7, 0x61, 0xc0, 0x9b, 0x5a, 0x5b, 0xeb, 0xd4, 0x07,
+	0x8d, 0x34, 0xb1, 0x7e, 0xc4, 0x34, 0xbc, 0xed, 0xdb, 0x4a, 0xb6, 0xdd, 0xdc, 0x36, 0x0e, 0xf4,
+	0xef, 0x61, 0xc0, 0x91, 0x09, 0xd9, 0x2c, 0xb7, 0x2b, 0x9d, 0xfa, 0xc0, 0x48, 0x13, 0xeb, 0x67,
+	0x4e, 0x16, 0x86, 0xed, 0xae, 0x91, 0x8c, 0xf6, 0x22, 0xc1, 0x33, 0xba, 0xb2, 0x4d, 0x17, 0x86,
+	0xed, 0xae, 0x11, 0xe3, 0x44, 0x6f, 0x84, 0xc5, 0xff, 0x8c, 0x24, 0x43, 0x35, 0x52, 0x55, 0xb1,
+	0x9d, 0x34, 0xb1, 0xfe, 0x15, 0x23, 0x5b, 0x84, 0xed, 0xfe, 0x5a, 0x4b, 0x17, 0xb9, 0x62, 0x9c,
+	0xea, 0xc6, 0x04, 0x84, 0xcf, 0x46, 0x28, 0x28, 0x97, 0x13, 0x26, 0x44, 0xd6, 0x54, 0x53, 0x4d,
+	0x7b, 0x69, 0x62, 0xfd, 0xcf, 0x9b, 0xbe, 0x32, 0xb6, 0xfb, 0x5b, 0x89, 0x97, 0x1b, 0x5a, 0xbf,
+	0xfa, 0xf6, 0x68, 0x69, 0x83, 0xf3, 0xe7, 0xa5, 0xa9, 0x2d, 0x96, 0xa6, 0xf6, 0xba, 0x34, 0xb5,
+	0x87, 0x95, 0x59, 0x5a, 0xac, 0xcc, 0xd2, 0xcb, 0xca, 0x2c, 0x5d, 0xf5, 0xa6, 0x01, 0xce, 0x22,
+	0xcf, 0xf1, 0x21, 0x24, 0x43, 0xf5, 0xfe, 0x43, 0xe0, 0x28, 0xa8, 0x8f, 0x92, 0x5c, 0x47, 0x1c,
+	0xc8, 0xfd, 0xe7, 0xc3, 0x63, 0x3c, 0x67, 0xd2, 0xfb, 0xa6, 0x2e, 0x72, 0xf8, 0x3e, 0x00, 0xa4,
+	0x47, 0xa6, 0x74, 0x1d, 0x02, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -122,6 +165,38 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if len(this.Minters) != len(that1.Minters) {
+		return false
+	}
+	for i := range this.Minters {
+		if this.Minters[i] != that1.Minters[i] {
+			return false
+		}
+	}
+	if len(this.Burners) != len(that1.Burners) {
+		return false
+	}
+	for i := range this.Burners {
+		if this.Burners[i] != that1.Burners[i] {
+			return false
+		}
+	}
+	if len(this.MetadataSetters) != len(that1.MetadataSetters) {
+		return false
+	}
+	for i := range this.MetadataSetters {
+		if this.MetadataSetters[i] != that1.MetadataSetters[i] {
+			return false
+		}
+	}
+	if len(this.ForceTransferrers) != len(that1.ForceTransferrers) {
+		return false
+	}
+	for i := range this.ForceTransferrers {
+		if this.ForceTransferrers[i] != that1.ForceTransferrers[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -144,6 +219,42 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ForceTransferrers) > 0 {
+		for iNdEx := len(m.ForceTransferrers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ForceTransferrers[iNdEx])
+			copy(dAtA[i:], m.ForceTransferrers[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.ForceTransferrers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MetadataSetters) > 0 {
+		for iNdEx := len(m.MetadataSetters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MetadataSetters[iNdEx])
+			copy(dAtA[i:], m.MetadataSetters[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.MetadataSetters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Burners) > 0 {
+		for iNdEx := len(m.Burners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Burners[iNdEx])
+			copy(dAtA[i:], m.Burners[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Burners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Minters[iNdEx])
+			copy(dAtA[i:], m.Minters[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Minters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -175,6 +286,30 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorityMetadata(uint64(l))
 	}
+	if len(m.Minters) > 0 {
+		for _, s := range m.Minters {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.Burners) > 0 {
+		for _, s := range m.Burners {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.MetadataSetters) > 0 {
+		for _, s := range m.MetadataSetters {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.ForceTransferrers) > 0 {
+		for _, s := range m.ForceTransferrers {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -245,6 +380,134 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burners = append(m.Burners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataSetters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataSetters = append(m.MetadataSetters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTransferrers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForceTransferrers = append(m.ForceTransferrers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
@@ -29,6 +29,10 @@ const (
 	burnTFDenom          = "osmosis/tokenfactory/burn"
 	forceTransferTFDenom = "osmosis/tokenfactory/force-transfer"
 	changeAdminTFDenom   = "osmosis/tokenfactory/change-admin"
+	grantRoleTFDenom     = "osmosis/tokenfactory/grant-role"
+	revokeRoleTFDenom    = "osmosis/tokenfactory/revoke-role"
+	setMaxSupplyTFDenom  = "osmosis/tokenfactory/set-max-supply"
+	setMintRateTFDenom   = "osmosis/tokenfactory/set-mint-rate-limit"
 	updateTFparams       = "osmosis/tokenfactory/msg-update-params"
 )
 
@@ -56,6 +60,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgBurn{},
 		&MsgForceTransfer{},
 		&MsgChangeAdmin{},
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+		&MsgSetMaxSupply{},
+		&MsgSetMintRateLimit{},
 		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -67,5 +75,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBurn{}, burnTFDenom, nil)
 	cdc.RegisterConcrete(&MsgForceTransfer{}, forceTransferTFDenom, nil)
 	cdc.RegisterConcrete(&MsgChangeAdmin{}, changeAdminTFDenom, nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, grantRoleTFDenom, nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, revokeRoleTFDenom, nil)
+	cdc.RegisterConcrete(&MsgSetMaxSupply{}, setMaxSupplyTFDenom, nil)
+	cdc.RegisterConcrete(&MsgSetMintRateLimit{}, setMintRateTFDenom, nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateTFparams, nil)
 }
@@ -23,7 +23,7 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 	RegisterInterfaces(registry)
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
-	suite.Require().Equal(7, len(impls))
+	suite.Require().Equal(11, len(impls))
 	suite.Require().ElementsMatch([]string{
 		"/osmosis.tokenfactory.v1beta1.MsgCreateDenom",
 		"/osmosis.tokenfactory.v1beta1.MsgMint",
@@ -31,6 +31,10 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 		"/osmosis.tokenfactory.v1beta1.MsgChangeAdmin",
 		"/osmosis.tokenfactory.v1beta1.MsgSetDenomMetadata",
 		"/osmosis.tokenfactory.v1beta1.MsgForceTransfer",
+		"/osmosis.tokenfactory.v1beta1.MsgGrantRole",
+		"/osmosis.tokenfactory.v1beta1.MsgRevokeRole",
+		"/osmosis.tokenfactory.v1beta1.MsgSetMaxSupply",
+		"/osmosis.tokenfactory.v1beta1.MsgSetMintRateLimit",
 		"/osmosis.tokenfactory.v1beta1.MsgUpdateParams",
 	}, impls)
 }
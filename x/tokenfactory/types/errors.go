@@ -21,4 +21,12 @@ var (
 	ErrDenomDoesNotExist        = errorsmod.Register(ModuleName, 10, "denom does not exist")
 	ErrCapabilityNotEnabled     = errorsmod.Register(ModuleName, 11, "this capability is not enabled on chain")
 	ErrModuleAccount            = errorsmod.Register(ModuleName, 12, "interacting with module accounts not allowed")
+	ErrInvalidRole              = errorsmod.Register(ModuleName, 13, "invalid denom role")
+	ErrRoleAlreadyGranted       = errorsmod.Register(ModuleName, 14, "role already granted")
+	ErrRoleNotGranted           = errorsmod.Register(ModuleName, 15, "role not granted")
+	ErrInvalidMaxSupply         = errorsmod.Register(ModuleName, 16, "invalid max supply")
+	ErrMaxSupplyImmutable       = errorsmod.Register(ModuleName, 17, "max supply is immutable")
+	ErrMaxSupplyExceeded        = errorsmod.Register(ModuleName, 18, "max supply exceeded")
+	ErrMintRateLimitExceeded    = errorsmod.Register(ModuleName, 19, "mint rate limit exceeded")
+	ErrInvalidMintRateLimit     = errorsmod.Register(ModuleName, 20, "invalid mint rate limit")
 )
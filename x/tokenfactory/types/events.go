@@ -15,4 +15,9 @@ const (
 	AttributeDenom               = "denom"
 	AttributeNewAdmin            = "new_admin"
 	AttributeDenomMetadata       = "denom_metadata"
+	AttributeRole                = "role"
+	AttributeAddress             = "address"
+	AttributeMaxSupply           = "max_supply"
+	AttributeImmutable           = "immutable"
+	AttributeWindow              = "window"
 )
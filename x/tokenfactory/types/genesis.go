@@ -46,6 +46,16 @@ func (gs GenesisState) Validate() error {
 				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid roles (%s)", err)
+		}
+
+		if denom.MintLimits != nil {
+			if err := denom.MintLimits.Validate(); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "Invalid mint limits (%s)", err)
+			}
+		}
 	}
 
 	return nil
@@ -83,6 +83,8 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	// mint_limits are the limits set on the minting of the denom, if any
+	MintLimits *DenomMintLimits `protobuf:"bytes,3,opt,name=mint_limits,json=mintLimits,proto3" json:"mint_limits,omitempty" yaml:"mint_limits"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +134,13 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetMintLimits() *DenomMintLimits {
+	if m != nil {
+		return m.MintLimits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "osmosis.tokenfactory.v1beta1.GenesisDenom")
@@ -142,31 +151,34 @@ func init() {
 }
 
 var fileDescriptor_5749c3f71850298b = []byte{
-	// 369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4e, 0xf2, 0x40,
-	0x14, 0x85, 0x3b, 0xfc, 0xfc, 0x24, 0x16, 0x34, 0xda, 0x68, 0x82, 0x44, 0x5b, 0x6c, 0x8c, 0x41,
-	0x16, 0x6d, 0x40, 0x56, 0xec, 0x2c, 0x24, 0xae, 0x34, 0xa6, 0xee, 0xdc, 0x90, 0x01, 0xc6, 0x52,
-	0xb5, 0x9d, 0xa6, 0x73, 0x31, 0xf6, 0x05, 0x5c, 0xfb, 0x08, 0x3e, 0x8c, 0x26, 0x2c, 0x59, 0xba,
-	0x22, 0x06, 0x36, 0xae, 0x79, 0x02, 0xc3, 0xcc, 0x44, 0x45, 0x92, 0xee, 0xda, 0x3b, 0xdf, 0x39,
-	0x73, 0xee, 0x1c, 0xb5, 0x4a, 0x59, 0x40, 0x99, 0xcf, 0x6c, 0xa0, 0x77, 0x24, 0xbc, 0xc1, 0x3d,
-	0xa0, 0x71, 0x62, 0x3f, 0xd4, 0xba, 0x04, 0x70, 0xcd, 0xf6, 0x48, 0x48, 0x98, 0xcf, 0xac, 0x28,
-	0xa6, 0x40, 0xb5, 0x3d, 0xc9, 0x5a, 0xbf, 0x59, 0x4b, 0xb2, 0xa5, 0x6d, 0x8f, 0x7a, 0x94, 0x83,
-	0xf6, 0xe2, 0x4b, 0x68, 0x4a, 0x8d, 0x54, 0x7f, 0x3c, 0x84, 0x01, 0x8d, 0x7d, 0x48, 0xce, 0x09,
-	0xe0, 0x3e, 0x06, 0x2c, 0x55, 0xc7, 0xa9, 0xaa, 0x08, 0xc7, 0x38, 0x90, 0xa1, 0xcc, 0x57, 0xa4,
-	0x16, 0xce, 0x44, 0xcc, 0x2b, 0xc0, 0x40, 0x34, 0x47, 0xcd, 0x09, 0xa0, 0x88, 0xca, 0xa8, 0x92,
-	0xaf, 0x1f, 0x5a, 0x69, 0xb1, 0xad, 0x4b, 0xce, 0x3a, 0xd9, 0xd1, 0xc4, 0x50, 0x5c, 0xa9, 0xd4,
-	0x22, 0x75, 0x43, 0x72, 0x9d, 0x3e, 0x09, 0x69, 0xc0, 0x8a, 0x99, 0xf2, 0xbf, 0x4a, 0xbe, 0x5e,
-	0x4d, 0xf7, 0x92, 0x39, 0xda, 0x0b, 0x89, 0xb3, 0xbf, 0x70, 0x9c, 0x4f, 0x8c, 0x9d, 0x04, 0x07,
-	0xf7, 0x4d, 0x73, 0xd9, 0xcf, 0x74, 0xd7, 0xe5, 0xa0, 0x2d, 0xfe, 0xdf, 0x7e, 0xd6, 0xe0, 0x13,
-	0xed, 0x48, 0xfd, 0xcf, 0x51, 0xbe, 0xc5, 0x9a, 0xb3, 0x39, 0x9f, 0x18, 0x05, 0xe1, 0xc4, 0xc7,
-	0xa6, 0x2b, 0x8e, 0xb5, 0x27, 0xa4, 0x6a, 0xdf, 0xcf, 0xd8, 0x09, 0xe4, 0x3b, 0x16, 0x33, 0x7c,
-	0xf7, 0x46, 0x7a, 0x5e, 0x7e, 0xd3, 0xe9, 0xdf, 0x0e, 0x9c, 0x03, 0x99, 0x7c, 0x57, 0xdc, 0xb7,
-	0xea, 0x6e, 0xba, 0x5b, 0x2b, 0xcd, 0x35, 0xb3, 0x9f, 0x2f, 0x06, 0x72, 0x2e, 0x46, 0x53, 0x1d,
-	0x8d, 0xa7, 0x3a, 0xfa, 0x98, 0xea, 0xe8, 0x79, 0xa6, 0x2b, 0xe3, 0x99, 0xae, 0xbc, 0xcf, 0x74,
-	0xe5, 0xba, 0xe1, 0xf9, 0x30, 0x18, 0x76, 0xad, 0x1e, 0x0d, 0xec, 0x16, 0x8f, 0xd5, 0xa2, 0x21,
-	0xc4, 0xb8, 0x07, 0xcc, 0xbe, 0x1d, 0x86, 0xd4, 0x7e, 0x5c, 0x6e, 0x1b, 0x92, 0x88, 0xb0, 0x6e,
-	0x8e, 0xb7, 0x7c, 0xf2, 0x15, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x2e, 0xfa, 0xa4, 0xa8, 0x02, 0x00,
-	0x00,
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xb1, 0x8e, 0xd3, 0x40,
+	0x10, 0x86, 0xbd, 0xb9, 0xe3, 0x24, 0x36, 0x07, 0x82, 0x15, 0x20, 0x13, 0x81, 0x7d, 0x58, 0x08,
+	0x1d, 0x27, 0x9d, 0xad, 0x3b, 0x52, 0xa5, 0xc3, 0x89, 0x44, 0x43, 0x10, 0x32, 0x1d, 0x4d, 0xb4,
+	0x49, 0x36, 0x8e, 0x21, 0xeb, 0xb5, 0xbc, 0x13, 0x84, 0x5f, 0x80, 0x9a, 0x47, 0xe0, 0x05, 0x78,
+	0x0b, 0x8a, 0x94, 0x29, 0xa9, 0x2c, 0x94, 0x34, 0xd4, 0x79, 0x02, 0xe4, 0xdd, 0x55, 0x48, 0x88,
+	0x64, 0xd1, 0x25, 0xe3, 0x6f, 0xfe, 0xf9, 0x67, 0xff, 0xc1, 0x17, 0x42, 0x72, 0x21, 0x13, 0x19,
+	0x80, 0xf8, 0xc8, 0xd2, 0x09, 0x1d, 0x81, 0xc8, 0x8b, 0xe0, 0xd3, 0xd5, 0x90, 0x01, 0xbd, 0x0a,
+	0x62, 0x96, 0x32, 0x99, 0x48, 0x3f, 0xcb, 0x05, 0x08, 0xf2, 0xc8, 0xb0, 0xfe, 0x2e, 0xeb, 0x1b,
+	0xb6, 0x75, 0x2f, 0x16, 0xb1, 0x50, 0x60, 0x50, 0xfd, 0xd2, 0x3d, 0xad, 0x76, 0xad, 0x3e, 0x9d,
+	0xc3, 0x54, 0xe4, 0x09, 0x14, 0x7d, 0x06, 0x74, 0x4c, 0x81, 0x9a, 0xae, 0xcb, 0xda, 0x2e, 0x9e,
+	0xa4, 0xf0, 0x3a, 0xe1, 0x09, 0x18, 0x63, 0xad, 0xe7, 0xb5, 0x78, 0x46, 0x73, 0xca, 0x0d, 0xea,
+	0xfd, 0x40, 0xf8, 0xf4, 0x95, 0xde, 0xea, 0x1d, 0x50, 0x60, 0x24, 0xc4, 0x27, 0x1a, 0xb0, 0xd1,
+	0x19, 0x3a, 0x6f, 0x5e, 0x3f, 0xf5, 0xeb, 0xb6, 0xf4, 0xdf, 0x2a, 0x36, 0x3c, 0x5e, 0x94, 0xae,
+	0x15, 0x99, 0x4e, 0x92, 0xe1, 0xdb, 0x86, 0x1b, 0x8c, 0x59, 0x2a, 0xb8, 0xb4, 0x1b, 0x67, 0x47,
+	0xe7, 0xcd, 0xeb, 0x8b, 0x7a, 0x2d, 0xe3, 0xa3, 0x57, 0xb5, 0x84, 0x8f, 0x2b, 0xc5, 0x4d, 0xe9,
+	0xde, 0x2f, 0x28, 0x9f, 0x75, 0xbc, 0x7d, 0x3d, 0x2f, 0xba, 0x65, 0x0a, 0x3d, 0xfd, 0xff, 0x7b,
+	0x63, 0xbb, 0x86, 0xaa, 0x90, 0x67, 0xf8, 0x86, 0x42, 0xd5, 0x16, 0x37, 0xc3, 0x3b, 0x9b, 0xd2,
+	0x3d, 0xd5, 0x4a, 0xaa, 0xec, 0x45, 0xfa, 0x33, 0xf9, 0x82, 0x30, 0xd9, 0xbe, 0xfa, 0x80, 0x9b,
+	0x67, 0xb7, 0x1b, 0x6a, 0xf7, 0x76, 0xbd, 0x5f, 0x35, 0xe9, 0xe5, 0xbf, 0x91, 0x85, 0x4f, 0x8c,
+	0xf3, 0x87, 0x7a, 0xde, 0xa1, 0xba, 0x17, 0xdd, 0x3d, 0x08, 0x9a, 0x4c, 0x70, 0xb3, 0xca, 0x71,
+	0x30, 0x53, 0x41, 0xda, 0x47, 0xca, 0xc0, 0xe5, 0x7f, 0x18, 0xe8, 0x6f, 0xd3, 0x0f, 0x1f, 0x6c,
+	0x4a, 0x97, 0xe8, 0xa9, 0x3b, 0x5a, 0x5e, 0x84, 0xff, 0x5e, 0x48, 0xe7, 0xf8, 0xf7, 0x37, 0x17,
+	0x85, 0x6f, 0x16, 0x2b, 0x07, 0x2d, 0x57, 0x0e, 0xfa, 0xb5, 0x72, 0xd0, 0xd7, 0xb5, 0x63, 0x2d,
+	0xd7, 0x8e, 0xf5, 0x73, 0xed, 0x58, 0xef, 0xdb, 0x71, 0x02, 0xd3, 0xf9, 0xd0, 0x1f, 0x09, 0x1e,
+	0x74, 0xd5, 0xf4, 0xae, 0x48, 0x21, 0xa7, 0x23, 0x90, 0xc1, 0x87, 0x79, 0x2a, 0x82, 0xcf, 0xfb,
+	0x57, 0x05, 0x45, 0xc6, 0xe4, 0xf0, 0x44, 0x5d, 0xd3, 0x8b, 0x3f, 0x03, 0x00, 0x5a, 0x09, 0x44,
+	0xb6, 0x3f, 0x03, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -194,6 +206,9 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if !this.MintLimits.Equal(that1.MintLimits) {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -263,6 +278,18 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MintLimits != nil {
+		{
+			size, err := m.MintLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -323,6 +350,10 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.MintLimits != nil {
+		l = m.MintLimits.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -543,6 +574,42 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintLimits == nil {
+				m.MintLimits = &DenomMintLimits{}
+			}
+			if err := m.MintLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -72,6 +72,36 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "roles",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:           "cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh",
+							Minters:         []string{"cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8"},
+							MetadataSetters: []string{"cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8"},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate role",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Minters: []string{"cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "invalid admin",
 			genState: &types.GenesisState{
@@ -28,6 +28,7 @@ const KeySeparator = "|"
 
 var (
 	DenomAuthorityMetadataKey = "authoritymetadata"
+	DenomMintLimitsKey        = "mintlimits"
 	DenomsPrefixKey           = "denoms"
 	CreatorPrefixKey          = "creator"
 	AdminPrefixKey            = "admin"
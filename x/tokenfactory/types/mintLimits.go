@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultDenomMintLimits returns limits which do not limit the minting of a
+// denom
+func DefaultDenomMintLimits() DenomMintLimits {
+	return DenomMintLimits{
+		MaxSupply:    math.ZeroInt(),
+		RateLimit:    math.ZeroInt(),
+		WindowMinted: math.ZeroInt(),
+	}
+}
+
+func (limits DenomMintLimits) Validate() error {
+	if limits.MaxSupply.IsNil() || limits.MaxSupply.IsNegative() {
+		return fmt.Errorf("max supply must be non-negative: %s", limits.MaxSupply)
+	}
+
+	if limits.RateLimit.IsNil() || limits.RateLimit.IsNegative() {
+		return fmt.Errorf("rate limit must be non-negative: %s", limits.RateLimit)
+	}
+
+	if limits.RateLimit.IsPositive() && limits.RateLimitWindow <= 0 {
+		return fmt.Errorf("rate limit window must be positive: %s", limits.RateLimitWindow)
+	}
+
+	if limits.WindowMinted.IsNil() || limits.WindowMinted.IsNegative() {
+		return fmt.Errorf("window minted must be non-negative: %s", limits.WindowMinted)
+	}
+
+	return nil
+}
+
+// HasMaxSupply returns true if the supply of the denom is capped
+func (limits DenomMintLimits) HasMaxSupply() bool {
+	return limits.MaxSupply.IsPositive()
+}
+
+// HasRateLimit returns true if the minting of the denom is rate limited
+func (limits DenomMintLimits) HasRateLimit() bool {
+	return limits.RateLimit.IsPositive()
+}
+
+// windowMinted returns the amount minted during the rate limit window at the
+// given time, which is zero once the current window ended.
+func (limits DenomMintLimits) windowMinted(now time.Time) math.Int {
+	if !now.Before(limits.WindowStart.Add(limits.RateLimitWindow)) {
+		return math.ZeroInt()
+	}
+	return limits.WindowMinted
+}
+
+// Mintable returns the amount which can be minted at the given time and
+// supply, and false if the minting of the denom is not limited.
+func (limits DenomMintLimits) Mintable(supply math.Int, now time.Time) (math.Int, bool) {
+	if !limits.HasMaxSupply() && !limits.HasRateLimit() {
+		return math.Int{}, false
+	}
+
+	var mintable math.Int
+	if limits.HasMaxSupply() {
+		mintable = math.MaxInt(limits.MaxSupply.Sub(supply), math.ZeroInt())
+	}
+
+	if limits.HasRateLimit() {
+		remaining := math.MaxInt(limits.RateLimit.Sub(limits.windowMinted(now)), math.ZeroInt())
+		if mintable.IsNil() || remaining.LT(mintable) {
+			mintable = remaining
+		}
+	}
+
+	return mintable, true
+}
+
+// RecordMint adds an amount minted at the given time to the rate limit
+// window, starting a new window once the current one ended.
+func (limits *DenomMintLimits) RecordMint(amount math.Int, now time.Time) {
+	if !limits.HasRateLimit() {
+		return
+	}
+
+	if !now.Before(limits.WindowStart.Add(limits.RateLimitWindow)) {
+		limits.WindowStart = now
+		limits.WindowMinted = math.ZeroInt()
+	}
+	limits.WindowMinted = limits.WindowMinted.Add(amount)
+}
@@ -0,0 +1,625 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/mintLimits.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomMintLimits specifies the limits set by the admin on the minting of a
+// token factory denom.
+type DenomMintLimits struct {
+	// max_supply caps the total supply of the denom, zero for no cap. Once set,
+	// it can only be lowered.
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply" yaml:"max_supply"`
+	// max_supply_immutable forbids any change of the max supply
+	MaxSupplyImmutable bool `protobuf:"varint,2,opt,name=max_supply_immutable,json=maxSupplyImmutable,proto3" json:"max_supply_immutable,omitempty" yaml:"max_supply_immutable"`
+	// rate_limit caps the amount minted during each rate limit window, zero for
+	// no limit
+	RateLimit cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=rate_limit,json=rateLimit,proto3,customtype=cosmossdk.io/math.Int" json:"rate_limit" yaml:"rate_limit"`
+	// rate_limit_window is the duration of a rate limit window
+	RateLimitWindow time.Duration `protobuf:"bytes,4,opt,name=rate_limit_window,json=rateLimitWindow,proto3,stdduration" json:"rate_limit_window" yaml:"rate_limit_window"`
+	// window_start is the start time of the current rate limit window
+	WindowStart time.Time `protobuf:"bytes,5,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start" yaml:"window_start"`
+	// window_minted is the amount minted during the current rate limit window
+	WindowMinted cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=window_minted,json=windowMinted,proto3,customtype=cosmossdk.io/math.Int" json:"window_minted" yaml:"window_minted"`
+}
+
+func (m *DenomMintLimits) Reset()         { *m = DenomMintLimits{} }
+func (m *DenomMintLimits) String() string { return proto.CompactTextString(m) }
+func (*DenomMintLimits) ProtoMessage()    {}
+func (*DenomMintLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44a4d3c24b1ae14d, []int{0}
+}
+func (m *DenomMintLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomMintLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomMintLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomMintLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomMintLimits.Merge(m, src)
+}
+func (m *DenomMintLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomMintLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomMintLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomMintLimits proto.InternalMessageInfo
+
+func (m *DenomMintLimits) GetMaxSupplyImmutable() bool {
+	if m != nil {
+		return m.MaxSupplyImmutable
+	}
+	return false
+}
+
+func (m *DenomMintLimits) GetRateLimitWindow() time.Duration {
+	if m != nil {
+		return m.RateLimitWindow
+	}
+	return 0
+}
+
+func (m *DenomMintLimits) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*DenomMintLimits)(nil), "osmosis.tokenfactory.v1beta1.DenomMintLimits")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/mintLimits.proto", fileDescriptor_44a4d3c24b1ae14d)
+}
+
+var fileDescriptor_44a4d3c24b1ae14d = []byte{
+	// 471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x21, 0x54, 0xc4, 0x05, 0x55, 0x35, 0x41, 0x32, 0x01, 0xf9, 0x22, 0x8b, 0x21, 0x0b,
+	0x77, 0x6a, 0x81, 0xa5, 0xa3, 0xdb, 0xa5, 0x12, 0x45, 0xaa, 0x8b, 0x84, 0xd4, 0x01, 0xeb, 0x9c,
+	0x5c, 0xdd, 0x23, 0x3e, 0x9f, 0xe5, 0x7b, 0xa6, 0xc9, 0xbf, 0xe8, 0xc8, 0xc8, 0xdf, 0xe0, 0x1f,
+	0x74, 0xec, 0x88, 0x18, 0x0c, 0x4a, 0x16, 0xe6, 0xfc, 0x02, 0xe4, 0xb3, 0x13, 0xb7, 0x61, 0x40,
+	0xdd, 0xee, 0xbd, 0xf7, 0x7d, 0xef, 0xfb, 0xee, 0xb3, 0xcf, 0x7c, 0x25, 0x95, 0x90, 0x8a, 0x2b,
+	0x02, 0x72, 0xcc, 0x92, 0x33, 0x3a, 0x04, 0x99, 0x4d, 0xc9, 0x97, 0x9d, 0x90, 0x01, 0xdd, 0x21,
+	0x82, 0x27, 0xf0, 0x8e, 0x0b, 0x0e, 0x0a, 0xa7, 0x99, 0x04, 0x69, 0xbd, 0xa8, 0xe1, 0xf8, 0x26,
+	0x1c, 0xd7, 0xf0, 0x5e, 0x37, 0x92, 0x91, 0xd4, 0x40, 0x52, 0x9e, 0x2a, 0x4e, 0xcf, 0x89, 0xa4,
+	0x8c, 0x62, 0x46, 0x74, 0x15, 0xe6, 0x67, 0x64, 0x94, 0x67, 0x14, 0xb8, 0x4c, 0xea, 0x39, 0x5a,
+	0x9f, 0x03, 0x17, 0x4c, 0x01, 0x15, 0x69, 0x05, 0x70, 0xbf, 0xb7, 0xcd, 0xad, 0x03, 0x96, 0x48,
+	0x71, 0xb4, 0xb2, 0x63, 0x1d, 0x9b, 0xa6, 0xa0, 0x93, 0x40, 0xe5, 0x69, 0x1a, 0x4f, 0x6d, 0xa3,
+	0x6f, 0x0c, 0x3a, 0xde, 0xee, 0x55, 0x81, 0x5a, 0x3f, 0x0b, 0xf4, 0x74, 0xa8, 0x5d, 0xaa, 0xd1,
+	0x18, 0x73, 0x49, 0x04, 0x85, 0x73, 0x7c, 0x98, 0xc0, 0xa2, 0x40, 0xdb, 0x53, 0x2a, 0xe2, 0x3d,
+	0xb7, 0x21, 0xba, 0x7e, 0x47, 0xd0, 0xc9, 0x89, 0x3e, 0x5b, 0xc7, 0x66, 0xb7, 0x99, 0x04, 0x5c,
+	0x88, 0x1c, 0x68, 0x18, 0x33, 0xfb, 0x5e, 0xdf, 0x18, 0x3c, 0xf4, 0xd0, 0xa2, 0x40, 0xcf, 0xd7,
+	0xf9, 0x0d, 0xca, 0xf5, 0xad, 0xd5, 0xa6, 0xc3, 0x65, 0xb3, 0x74, 0x99, 0x51, 0x60, 0x41, 0x5c,
+	0x9a, 0xb6, 0xef, 0xdf, 0xc9, 0x65, 0x43, 0x74, 0xfd, 0x4e, 0x59, 0xe8, 0x9b, 0x5b, 0x63, 0x73,
+	0xbb, 0x99, 0x04, 0x17, 0x3c, 0x19, 0xc9, 0x0b, 0xbb, 0xdd, 0x37, 0x06, 0x9b, 0xbb, 0xcf, 0x70,
+	0x95, 0x24, 0x5e, 0x26, 0x89, 0x0f, 0xea, 0xa4, 0xbd, 0x97, 0xa5, 0xe8, 0xa2, 0x40, 0xf6, 0xfa,
+	0xee, 0x7a, 0x83, 0xfb, 0xf5, 0x17, 0x32, 0xfc, 0xad, 0x95, 0xcc, 0x47, 0xdd, 0xb5, 0x3e, 0x99,
+	0x8f, 0xaa, 0x79, 0xa0, 0x80, 0x66, 0x60, 0x3f, 0xd0, 0x3a, 0xbd, 0x7f, 0x74, 0x3e, 0x2c, 0xbf,
+	0x98, 0x87, 0x6a, 0xa1, 0x27, 0x95, 0xd0, 0x4d, 0xb6, 0x7b, 0x59, 0x6a, 0x6c, 0x56, 0xad, 0x93,
+	0xb2, 0x63, 0x9d, 0x9a, 0x8f, 0x6b, 0x44, 0xf9, 0xa7, 0xb1, 0x91, 0xbd, 0xa1, 0x23, 0x7a, 0xfb,
+	0xbf, 0x88, 0xba, 0xb7, 0xb6, 0x57, 0x5c, 0xd7, 0xaf, 0xbd, 0x1e, 0xe9, 0x72, 0xaf, 0xfd, 0xe7,
+	0x1b, 0x32, 0xbc, 0xf7, 0x57, 0x33, 0xc7, 0xb8, 0x9e, 0x39, 0xc6, 0xef, 0x99, 0x63, 0x5c, 0xce,
+	0x9d, 0xd6, 0xf5, 0xdc, 0x69, 0xfd, 0x98, 0x3b, 0xad, 0xd3, 0x37, 0x11, 0x87, 0xf3, 0x3c, 0xc4,
+	0x43, 0x29, 0xc8, 0xbe, 0xd6, 0xd9, 0x97, 0x09, 0x64, 0x74, 0x08, 0x8a, 0x7c, 0xce, 0x13, 0x49,
+	0x26, 0xb7, 0xdf, 0x04, 0x4c, 0x53, 0xa6, 0xc2, 0x0d, 0x7d, 0xe7, 0xd7, 0x7f, 0x07, 0x00, 0x3c,
+	0xa6, 0xcc, 0x8a, 0x38, 0x03, 0x00, 0x00,
+}
+
+func (this *DenomMintLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomMintLimits)
+	if !ok {
+		that2, ok := that.(DenomMintLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if this.MaxSupplyImmutable != that1.MaxSupplyImmutable {
+		return false
+	}
+	if !this.RateLimit.Equal(that1.RateLimit) {
+		return false
+	}
+	if this.RateLimitWindow != that1.RateLimitWindow {
+		return false
+	}
+	if !this.WindowStart.Equal(that1.WindowStart) {
+		return false
+	}
+	if !this.WindowMinted.Equal(that1.WindowMinted) {
+		return false
+	}
+	return true
+}
+func (m *DenomMintLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomMintLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomMintLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.WindowMinted.Size()
+		i -= size
+		if _, err := m.WindowMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintLimits(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMintLimits(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RateLimitWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RateLimitWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMintLimits(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RateLimit.Size()
+		i -= size
+		if _, err := m.RateLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintLimits(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxSupplyImmutable {
+		i--
+		if m.MaxSupplyImmutable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintLimits(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMintLimits(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMintLimits(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomMintLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMintLimits(uint64(l))
+	if m.MaxSupplyImmutable {
+		n += 2
+	}
+	l = m.RateLimit.Size()
+	n += 1 + l + sovMintLimits(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RateLimitWindow)
+	n += 1 + l + sovMintLimits(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovMintLimits(uint64(l))
+	l = m.WindowMinted.Size()
+	n += 1 + l + sovMintLimits(uint64(l))
+	return n
+}
+
+func sovMintLimits(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMintLimits(x uint64) (n int) {
+	return sovMintLimits(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomMintLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintLimits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomMintLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomMintLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyImmutable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxSupplyImmutable = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RateLimitWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintLimits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMintLimits(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMintLimits
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMintLimits
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMintLimits
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMintLimits
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMintLimits        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMintLimits          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMintLimits = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/CosmosContracts/juno/v26/x/tokenfactory/types"
+)
+
+func TestDenomMintLimitsMintable(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	limits := types.DefaultDenomMintLimits()
+	_, limited := limits.Mintable(math.NewInt(100), start)
+	require.False(t, limited)
+
+	// capped by the max supply
+	limits.MaxSupply = math.NewInt(1_000)
+	mintable, limited := limits.Mintable(math.NewInt(100), start)
+	require.True(t, limited)
+	require.Equal(t, math.NewInt(900), mintable)
+
+	// capped by the rate limit within the window
+	limits.RateLimit = math.NewInt(500)
+	limits.RateLimitWindow = time.Hour
+	limits.WindowStart = start
+	limits.RecordMint(math.NewInt(200), start.Add(time.Minute))
+	mintable, _ = limits.Mintable(math.NewInt(300), start.Add(time.Minute))
+	require.Equal(t, math.NewInt(300), mintable)
+	require.Equal(t, start, limits.WindowStart)
+
+	// the window resets once it ended
+	mintable, _ = limits.Mintable(math.NewInt(800), start.Add(time.Hour))
+	require.Equal(t, math.NewInt(200), mintable)
+	limits.RecordMint(math.NewInt(100), start.Add(time.Hour))
+	require.Equal(t, start.Add(time.Hour), limits.WindowStart)
+	require.Equal(t, math.NewInt(100), limits.WindowMinted)
+	require.NoError(t, limits.Validate())
+
+	// a rate limit needs a window
+	limits.RateLimitWindow = 0
+	require.Error(t, limits.Validate())
+}
+
+func TestMsgSetMintLimits(t *testing.T) {
+	sender := "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8"
+	denom := "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin"
+
+	require.NoError(t, types.NewMsgSetMaxSupply(sender, denom, math.NewInt(1), true).ValidateBasic())
+	require.Error(t, types.NewMsgSetMaxSupply(sender, denom, math.ZeroInt(), false).ValidateBasic())
+	require.Error(t, types.NewMsgSetMaxSupply(sender, "bitcoin", math.NewInt(1), false).ValidateBasic())
+
+	require.NoError(t, types.NewMsgSetMintRateLimit(sender, denom, math.NewInt(1), time.Hour).ValidateBasic())
+	require.NoError(t, types.NewMsgSetMintRateLimit(sender, denom, math.ZeroInt(), 0).ValidateBasic())
+	require.Error(t, types.NewMsgSetMintRateLimit(sender, denom, math.NewInt(1), 0).ValidateBasic())
+	require.Error(t, types.NewMsgSetMintRateLimit(sender, denom, math.NewInt(-1), time.Hour).ValidateBasic())
+}